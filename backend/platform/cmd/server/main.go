@@ -12,8 +12,11 @@ import (
 
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/config"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/grpcclient"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/health"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/profiletrigger"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/server"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
 )
 
 func main() {
@@ -27,27 +30,95 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Connect to inference gRPC server
-	inference, err := grpcclient.New(cfg.Platform.InferenceAddr)
-	if err != nil {
-		slog.Error("failed to connect to inference server", "addr", cfg.Platform.InferenceAddr, "error", err)
+	// Publish spans to an OTLP collector if one is configured
+	if cfg.Platform.OTLPEndpoint != "" {
+		otlpExporter, err := trace.NewOTLPExporter(cfg.Platform.OTLPEndpoint, "good-listener-platform")
+		if err != nil {
+			slog.Error("failed to create otlp exporter", "endpoint", cfg.Platform.OTLPEndpoint, "error", err)
+			os.Exit(1)
+		}
+		defer func() { _ = otlpExporter.Close() }()
+		trace.SetExporter(otlpExporter)
+		slog.Info("otlp span export enabled", "endpoint", cfg.Platform.OTLPEndpoint)
+	}
+
+	// Start continuous profiling if enabled
+	var profiler *profiletrigger.Trigger
+	if cfg.Profiling.Enabled {
+		profiler = profiletrigger.New(profiletrigger.Config{
+			HeapMB:             cfg.Profiling.HeapMB,
+			CPUPct:             cfg.Profiling.CPUPct,
+			Dir:                cfg.Profiling.Dir,
+			SampleInterval:     time.Second,
+			CPUProfileDuration: 3 * time.Second,
+		})
+		profileCtx, profileCancel := context.WithCancel(context.Background())
+		defer profileCancel()
+		if err := profiler.Start(profileCtx); err != nil {
+			slog.Error("failed to start profile trigger", "error", err)
+			os.Exit(1)
+		}
+		trace.SetSlowSpanHook(profiler.OnSlowSpan)
+		slog.Info("continuous profiling enabled", "dir", cfg.Profiling.Dir, "heap_mb", cfg.Profiling.HeapMB, "cpu_pct", cfg.Profiling.CPUPct)
+	}
+
+	// Connect to inference gRPC server, or attach to one already running
+	// out-of-band (e.g. under pdb/py-spy) if GOODLISTENER_INFERENCE_REATTACH is set.
+	var inference *grpcclient.Client
+	readyTimeout := grpcclient.DefaultStartupTimeout
+	if reattachCfg, ok, err := grpcclient.ReattachConfigFromEnv(); err != nil {
+		slog.Error("invalid inference reattach config", "error", err)
 		os.Exit(1)
+	} else if ok {
+		inference, err = grpcclient.NewReattach(reattachCfg)
+		if err != nil {
+			slog.Error("failed to reattach to inference server", "addr", reattachCfg.Addr, "error", err)
+			os.Exit(1)
+		}
+		readyTimeout = grpcclient.DefaultReattachTimeout
+		slog.Info("reattached to inference server", "addr", reattachCfg.Addr, "pid", reattachCfg.PID)
+	} else {
+		inferenceTLSConfig, tlsErr := cfg.Platform.InferenceTLS.Build()
+		if tlsErr != nil {
+			slog.Error("failed to build inference tls config", "error", tlsErr)
+			os.Exit(1)
+		}
+		clientCfg := grpcclient.DefaultConfig()
+		clientCfg.TLS = inferenceTLSConfig
+		inference, err = grpcclient.NewWithConfig(cfg.Platform.InferenceAddr, clientCfg)
+		if err != nil {
+			slog.Error("failed to connect to inference server", "addr", cfg.Platform.InferenceAddr, "error", err)
+			os.Exit(1)
+		}
 	}
 	defer func() { _ = inference.Close() }()
 
 	// Wait for inference server to be ready before starting orchestrator
 	startupCtx, startupCancel := context.WithCancel(context.Background())
 	defer startupCancel()
-	if err := inference.WaitReady(startupCtx, grpcclient.DefaultStartupTimeout); err != nil {
+	if err := inference.WaitReady(startupCtx, readyTimeout); err != nil {
 		slog.Error("inference server not ready", "error", err)
 		os.Exit(1)
 	}
 
 	// Create orchestrator
-	orch := orchestrator.New(inference, cfg)
+	orch := orchestrator.New(inference, cfg, nil, nil)
+
+	// Register readiness checks: audio/screen/memory come from the
+	// orchestrator's own subsystems, inference connectivity reuses the
+	// circuit-breaker-aware CheckHealth, and config revalidates in case a
+	// hot-reloaded Store ever serves this process a bad value.
+	orch.RegisterHealthChecks(health.Default())
+	health.Register("inference_llm", func(ctx context.Context) error {
+		_, err := inference.CheckHealth(ctx)
+		return err
+	})
+	health.Register("config", func(ctx context.Context) error {
+		return cfg.Validate()
+	})
 
 	// Create HTTP/WebSocket server
-	srv := server.New(orch, cfg)
+	srv := server.New(orch, cfg, profiler)
 
 	// Start orchestrator in background
 	ctx, cancel := context.WithCancel(context.Background())
@@ -60,17 +131,29 @@ func main() {
 	}()
 
 	// Start HTTP server
+	httpTLSConfig, err := cfg.Platform.HTTPTLS.Build()
+	if err != nil {
+		slog.Error("failed to build http tls config", "error", err)
+		os.Exit(1)
+	}
 	httpServer := &http.Server{
 		Addr:         cfg.Platform.HTTPAddr,
 		Handler:      srv.Handler(),
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
+		TLSConfig:    httpTLSConfig,
 	}
 
 	go func() {
-		slog.Info("platform server starting", "http", cfg.Platform.HTTPAddr, "inference", cfg.Platform.InferenceAddr)
-		if err := httpServer.ListenAndServe(); err != http.ErrServerClosed {
-			slog.Error("http server error", "error", err)
+		slog.Info("platform server starting", "http", cfg.Platform.HTTPAddr, "inference", cfg.Platform.InferenceAddr, "tls", httpTLSConfig != nil)
+		var serveErr error
+		if httpTLSConfig != nil {
+			serveErr = httpServer.ListenAndServeTLS("", "")
+		} else {
+			serveErr = httpServer.ListenAndServe()
+		}
+		if serveErr != http.ErrServerClosed {
+			slog.Error("http server error", "error", serveErr)
 		}
 	}()
 
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/normalize"
 	"github.com/gordonklaus/portaudio"
 )
 
@@ -15,19 +16,35 @@ type Chunk struct {
 	Data      []float32
 	DeviceID  string
 	Source    string // "user" or "system"
+	Speaker   string // already-known speaker label (e.g. from a Mumble bridge); "" when unknown
 	Timestamp int64
 }
 
 // Capturer captures audio from devices with backpressure.
 type Capturer struct {
-	devices      []*deviceCapture
-	outCh        chan Chunk
-	sampleRate   int
-	framesPerBuf int
-	mu           sync.Mutex
-	running      bool
-	systemAudio  bool
-	excludedDevs []string
+	devices            []*deviceCapture
+	sysCapture         systemCapturer
+	outCh              chan Chunk // raw chunks from devices and the system capturer
+	pubCh              chan Chunk // normalized chunks exposed via Output()
+	norm               *normalize.Normalizer
+	stopCh             chan struct{}
+	sampleRate         int
+	framesPerBuf       int
+	mu                 sync.Mutex
+	running            bool
+	systemAudio        bool
+	systemAudioBackend string
+	excludedDevs       []string
+}
+
+// systemCapturer captures system/loopback audio through a native OS backend
+// (e.g. WASAPI on Windows), bypassing the need for a virtual loopback
+// device like BlackHole or VB-Cable. newSystemCapturer returns nil on
+// platforms with no native backend, in which case Start falls back to
+// scanning portaudio devices for a virtual loopback match.
+type systemCapturer interface {
+	start(ctx context.Context, out chan<- Chunk) error
+	stop()
 }
 
 type deviceCapture struct {
@@ -36,23 +53,35 @@ type deviceCapture struct {
 	stopOnce sync.Once
 }
 
-// NewCapturer creates a new audio capturer.
-func NewCapturer(sampleRate, bufferSize int, captureSystemAudio bool, excludedDevices []string) (*Capturer, error) {
+// NewCapturer creates a new audio capturer. systemAudioBackend selects how
+// source=="system" audio is captured: "auto" (the default - prefer a native
+// loopback backend, e.g. WASAPI on Windows, falling back to scanning
+// portaudio devices for a virtual loopback match), "native" (native loopback
+// only, still falling back to device scanning if the native backend fails
+// to activate), or "device" (skip native loopback entirely and only scan
+// portaudio devices, e.g. Stereo Mix/BlackHole/VB-Cable).
+func NewCapturer(sampleRate, bufferSize int, captureSystemAudio bool, systemAudioBackend string, excludedDevices []string) (*Capturer, error) {
 	if err := portaudio.Initialize(); err != nil {
 		return nil, err
 	}
 
 	return &Capturer{
-		outCh:        make(chan Chunk, bufferSize),
-		sampleRate:   sampleRate,
-		framesPerBuf: 1024, // ~23ms at 44100Hz
-		systemAudio:  captureSystemAudio,
-		excludedDevs: excludedDevices,
+		outCh:              make(chan Chunk, bufferSize),
+		pubCh:              make(chan Chunk, bufferSize),
+		norm:               normalize.New(),
+		sampleRate:         sampleRate,
+		framesPerBuf:       1024, // ~23ms at 44100Hz
+		systemAudio:        captureSystemAudio,
+		systemAudioBackend: systemAudioBackend,
+		excludedDevs:       excludedDevices,
 	}, nil
 }
 
-// Output returns the channel for receiving audio chunks.
-func (c *Capturer) Output() <-chan Chunk { return c.outCh }
+// Output returns the channel for receiving normalized audio chunks.
+func (c *Capturer) Output() <-chan Chunk { return c.pubCh }
+
+// Stats returns the current per-device normalization gain in dB, keyed by DeviceID.
+func (c *Capturer) Stats() map[string]float64 { return c.norm.Stats() }
 
 // Start begins capturing audio from available devices.
 func (c *Capturer) Start(ctx context.Context) error {
@@ -62,6 +91,7 @@ func (c *Capturer) Start(ctx context.Context) error {
 		return nil
 	}
 	c.running = true
+	c.stopCh = make(chan struct{})
 	c.mu.Unlock()
 
 	devices, err := portaudio.Devices()
@@ -69,6 +99,28 @@ func (c *Capturer) Start(ctx context.Context) error {
 		return err
 	}
 
+	go c.normalizeLoop()
+
+	// Prefer a native loopback backend over scanning for a virtual
+	// loopback device; it needs no extra software installed by the user.
+	// "device" opts out of native loopback entirely, e.g. to force a
+	// Stereo Mix/BlackHole/VB-Cable device even when a native backend is
+	// available.
+	nativeSystemAudio := false
+	if c.systemAudio && c.systemAudioBackend != "device" {
+		if sc := newSystemCapturer(c.sampleRate, c.excludedDevs); sc != nil {
+			if err := sc.start(ctx, c.outCh); err != nil {
+				slog.Warn("failed to start native system audio capture", "error", err)
+			} else {
+				c.mu.Lock()
+				c.sysCapture = sc
+				c.mu.Unlock()
+				slog.Info("started native system audio capture", "source", "system")
+				nativeSystemAudio = true
+			}
+		}
+	}
+
 	// Collect candidates by source type, pick best user mic
 	var userMic *portaudio.DeviceInfo
 	var systemDevs []*portaudio.DeviceInfo
@@ -84,7 +136,7 @@ func (c *Capturer) Start(ctx context.Context) error {
 		}
 
 		if source == "system" {
-			if c.systemAudio {
+			if c.systemAudio && !nativeSystemAudio {
 				systemDevs = append(systemDevs, dev)
 			}
 		} else if source == "user" {
@@ -116,6 +168,30 @@ func (c *Capturer) Start(ctx context.Context) error {
 	return nil
 }
 
+// normalizeLoop drains raw chunks from devices and the system capturer,
+// applies per-device gain in place, and forwards them to Output(). This is
+// the single choke point all chunks pass through regardless of which
+// backend produced them.
+func (c *Capturer) normalizeLoop() {
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case chunk := <-c.outCh:
+			gain := c.norm.Apply(chunk.DeviceID, chunk.Data, chunk.Timestamp)
+			slog.Debug("normalized audio chunk", "device", chunk.DeviceID, "gain_db", gain)
+
+			select {
+			case c.pubCh <- chunk:
+			case <-c.stopCh:
+				return
+			default:
+				slog.Debug("normalized audio buffer full, dropping chunk", "device", chunk.DeviceID)
+			}
+		}
+	}
+}
+
 func (c *Capturer) classifyDevice(name string) string {
 	systemKeywords := []string{"blackhole", "vb-cable", "loopback", "monitor", "soundflower"}
 	for _, kw := range systemKeywords {
@@ -131,6 +207,16 @@ func (c *Capturer) classifyDevice(name string) string {
 		}
 	}
 
+	// Not a real portaudio device: a label for a network-ingested stream
+	// (e.g. the whip package's per-session device IDs), so the orchestrator
+	// can still tell these apart from "user"/"system" when labeling speakers.
+	remoteKeywords := []string{"whip", "webrtc", "remote"}
+	for _, kw := range remoteKeywords {
+		if containsIgnoreCase(name, kw) {
+			return "remote"
+		}
+	}
+
 	return ""
 }
 
@@ -240,6 +326,14 @@ func (c *Capturer) Stop() {
 		d.stop()
 	}
 	c.devices = nil
+	if c.sysCapture != nil {
+		c.sysCapture.stop()
+		c.sysCapture = nil
+	}
+	if c.stopCh != nil {
+		close(c.stopCh)
+		c.stopCh = nil
+	}
 	c.running = false
 	_ = portaudio.Terminate()
 }
@@ -0,0 +1,9 @@
+//go:build !windows
+
+package audio
+
+// newSystemCapturer returns nil; native loopback capture is only
+// implemented for Windows via WASAPI. Other platforms fall back to the
+// virtual loopback device (BlackHole, VB-Cable) picked up by the
+// portaudio device scan in Start.
+func newSystemCapturer(sampleRate int, excludedDevices []string) systemCapturer { return nil }
@@ -2,6 +2,8 @@ package audio
 
 import (
 	"testing"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/normalize"
 )
 
 func TestClassifyDevice(t *testing.T) {
@@ -27,6 +29,10 @@ func TestClassifyDevice(t *testing.T) {
 		{"input", "Line Input", "user"},
 		{"built-in", "Built-in Input", "user"},
 
+		// Network-ingested streams (see internal/capture/whip)
+		{"whip session", "whip-a1b2c3", "remote"},
+		{"webrtc", "WebRTC Ingest", "remote"},
+
 		// Unknown devices
 		{"speakers", "External Speakers", ""},
 		{"hdmi", "HDMI Output", ""},
@@ -117,6 +123,29 @@ func TestChunkChannel(t *testing.T) {
 	}
 }
 
+func TestNewSystemCapturerStubOnNonWindows(t *testing.T) {
+	// newSystemCapturer has no native backend outside Windows; Start must
+	// fall back to scanning for a virtual loopback device in that case.
+	if sc := newSystemCapturer(16000, nil); sc != nil {
+		t.Error("newSystemCapturer should return nil without a native backend")
+	}
+}
+
+func TestCapturerStatsReportsNormalizerGain(t *testing.T) {
+	c := &Capturer{norm: normalize.New()}
+
+	samples := make([]float32, 256)
+	for i := range samples {
+		samples[i] = 0.01
+	}
+	c.norm.Apply("test-device", samples, 0)
+
+	stats := c.Stats()
+	if _, ok := stats["test-device"]; !ok {
+		t.Errorf("Stats() = %v, want entry for \"test-device\"", stats)
+	}
+}
+
 func TestCapturerSystemAudioFlag(t *testing.T) {
 	// Test that systemAudio flag is respected
 	c := &Capturer{systemAudio: false}
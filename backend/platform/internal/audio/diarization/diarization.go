@@ -0,0 +1,155 @@
+// Package diarization assigns stable per-source speaker labels to completed
+// speech segments. Each segment is reduced to a fixed-size embedding vector
+// by the inference server (see Embedder); a Tracker then clusters embeddings
+// online with cosine similarity so the same voice keeps the same label
+// ("Speaker 1", "Speaker 2", ...) across a session, without needing a
+// full offline diarization pass.
+package diarization
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// DefaultThreshold is the cosine similarity above which a segment is
+// assigned to an existing speaker cluster rather than starting a new one.
+const DefaultThreshold = 0.75
+
+// DefaultMaxSpeakers caps how many distinct clusters a single source may
+// accumulate before new segments are folded into the nearest existing one.
+const DefaultMaxSpeakers = 8
+
+// Embedder extracts a speaker embedding from an audio segment.
+type Embedder interface {
+	Embed(ctx context.Context, audio []byte, sampleRate int32) ([]float32, error)
+}
+
+// Config tunes a Tracker's clustering behavior.
+type Config struct {
+	Threshold   float64 // cosine similarity required to join an existing cluster; 0 uses DefaultThreshold
+	MaxSpeakers int     // per-source cluster cap; 0 uses DefaultMaxSpeakers
+}
+
+type cluster struct {
+	label    string
+	centroid []float32
+	count    int
+}
+
+// Tracker maintains a set of speaker clusters per source. The zero value is
+// not usable; construct with New.
+type Tracker struct {
+	embed Embedder
+	cfg   Config
+
+	mu       sync.Mutex
+	clusters map[string][]*cluster
+}
+
+// New creates a Tracker that queries embed for each segment it's asked to
+// identify.
+func New(embed Embedder, cfg Config) *Tracker {
+	if cfg.Threshold == 0 {
+		cfg.Threshold = DefaultThreshold
+	}
+	if cfg.MaxSpeakers == 0 {
+		cfg.MaxSpeakers = DefaultMaxSpeakers
+	}
+	return &Tracker{
+		embed:    embed,
+		cfg:      cfg,
+		clusters: make(map[string][]*cluster),
+	}
+}
+
+// Identify returns a stable speaker label for a completed segment from
+// source. A segment whose embedding is close enough (by cosine similarity)
+// to a known cluster joins that cluster and its centroid is updated toward
+// the new embedding; otherwise a new cluster is started, labeled
+// "Speaker N", unless source has already reached its MaxSpeakers cap, in
+// which case the segment joins its nearest existing cluster regardless of
+// similarity.
+func (t *Tracker) Identify(ctx context.Context, source string, audio []byte, sampleRate int32) (string, error) {
+	embedding, err := t.embed.Embed(ctx, audio, sampleRate)
+	if err != nil {
+		return "", fmt.Errorf("diarization: embed segment: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cs := t.clusters[source]
+	bestIdx, bestSim := nearest(cs, embedding)
+
+	if bestIdx >= 0 && (bestSim >= t.cfg.Threshold || len(cs) >= t.cfg.MaxSpeakers) {
+		cs[bestIdx].update(embedding)
+		return cs[bestIdx].label, nil
+	}
+
+	c := &cluster{label: fmt.Sprintf("Speaker %d", len(cs)+1), centroid: embedding, count: 1}
+	t.clusters[source] = append(cs, c)
+	return c.label, nil
+}
+
+// Enroll seeds (or re-centers, if already enrolled) a named speaker cluster
+// for source from a known-good sample, e.g. a short voice-enrollment clip
+// recorded once at setup. Enrolled clusters are returned by Identify using
+// name instead of an auto-assigned "Speaker N" label.
+func (t *Tracker) Enroll(ctx context.Context, source, name string, audio []byte, sampleRate int32) error {
+	embedding, err := t.embed.Embed(ctx, audio, sampleRate)
+	if err != nil {
+		return fmt.Errorf("diarization: embed enrollment sample: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, c := range t.clusters[source] {
+		if c.label == name {
+			c.update(embedding)
+			return nil
+		}
+	}
+	t.clusters[source] = append(t.clusters[source], &cluster{label: name, centroid: embedding, count: 1})
+	return nil
+}
+
+// update folds embedding into the cluster's centroid as a running mean.
+func (c *cluster) update(embedding []float32) {
+	c.count++
+	for i, v := range embedding {
+		c.centroid[i] += (v - c.centroid[i]) / float32(c.count)
+	}
+}
+
+// nearest returns the index of the cluster whose centroid is most similar
+// to embedding, and that similarity. Returns (-1, 0) for an empty set.
+func nearest(cs []*cluster, embedding []float32) (int, float64) {
+	bestIdx, bestSim := -1, -1.0
+	for i, c := range cs {
+		sim := cosineSimilarity(c.centroid, embedding)
+		if sim > bestSim {
+			bestIdx, bestSim = i, sim
+		}
+	}
+	return bestIdx, bestSim
+}
+
+func cosineSimilarity(a, b []float32) float64 {
+	var dot, normA, normB float64
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
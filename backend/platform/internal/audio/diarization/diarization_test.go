@@ -0,0 +1,113 @@
+package diarization
+
+import (
+	"context"
+	"testing"
+)
+
+// fakeEmbedder returns a preset embedding per call, in order, cycling the
+// last one once exhausted.
+type fakeEmbedder struct {
+	embeddings [][]float32
+	calls      int
+}
+
+func (f *fakeEmbedder) Embed(ctx context.Context, audio []byte, sampleRate int32) ([]float32, error) {
+	i := f.calls
+	if i >= len(f.embeddings) {
+		i = len(f.embeddings) - 1
+	}
+	f.calls++
+	return f.embeddings[i], nil
+}
+
+func TestIdentifyAssignsSameLabelToSimilarEmbeddings(t *testing.T) {
+	embed := &fakeEmbedder{embeddings: [][]float32{
+		{1, 0, 0},
+		{0.99, 0.01, 0},
+	}}
+	tr := New(embed, Config{})
+
+	first, err := tr.Identify(context.Background(), "user", nil, 16000)
+	if err != nil {
+		t.Fatalf("Identify: %v", err)
+	}
+	second, err := tr.Identify(context.Background(), "user", nil, 16000)
+	if err != nil {
+		t.Fatalf("Identify: %v", err)
+	}
+	if first != second {
+		t.Errorf("labels = %q, %q, want the same speaker for near-identical embeddings", first, second)
+	}
+}
+
+func TestIdentifyAssignsDistinctLabelsForDissimilarEmbeddings(t *testing.T) {
+	embed := &fakeEmbedder{embeddings: [][]float32{
+		{1, 0, 0},
+		{0, 1, 0},
+	}}
+	tr := New(embed, Config{})
+
+	first, _ := tr.Identify(context.Background(), "user", nil, 16000)
+	second, _ := tr.Identify(context.Background(), "user", nil, 16000)
+	if first == second {
+		t.Errorf("both segments labeled %q, want distinct speakers for orthogonal embeddings", first)
+	}
+}
+
+func TestIdentifyCapsClustersAtMaxSpeakers(t *testing.T) {
+	embed := &fakeEmbedder{embeddings: [][]float32{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}}
+	tr := New(embed, Config{MaxSpeakers: 2})
+
+	tr.Identify(context.Background(), "user", nil, 16000)
+	tr.Identify(context.Background(), "user", nil, 16000)
+	third, err := tr.Identify(context.Background(), "user", nil, 16000)
+	if err != nil {
+		t.Fatalf("Identify: %v", err)
+	}
+
+	labels := tr.clusters["user"]
+	if len(labels) != 2 {
+		t.Fatalf("len(clusters) = %d, want 2 (capped)", len(labels))
+	}
+	if third != "Speaker 1" && third != "Speaker 2" {
+		t.Errorf("third segment label = %q, want it folded into an existing cluster", third)
+	}
+}
+
+func TestIdentifySeparatesSources(t *testing.T) {
+	embed := &fakeEmbedder{embeddings: [][]float32{
+		{1, 0, 0},
+		{1, 0, 0},
+	}}
+	tr := New(embed, Config{})
+
+	userLabel, _ := tr.Identify(context.Background(), "user", nil, 16000)
+	systemLabel, _ := tr.Identify(context.Background(), "system", nil, 16000)
+	if userLabel != "Speaker 1" || systemLabel != "Speaker 1" {
+		t.Errorf("got user=%q system=%q, want independent \"Speaker 1\" clusters per source", userLabel, systemLabel)
+	}
+}
+
+func TestEnrollAssignsNamedLabel(t *testing.T) {
+	embed := &fakeEmbedder{embeddings: [][]float32{
+		{1, 0, 0},
+		{0.99, 0.01, 0},
+	}}
+	tr := New(embed, Config{})
+
+	if err := tr.Enroll(context.Background(), "user", "Alice", nil, 16000); err != nil {
+		t.Fatalf("Enroll: %v", err)
+	}
+	label, err := tr.Identify(context.Background(), "user", nil, 16000)
+	if err != nil {
+		t.Fatalf("Identify: %v", err)
+	}
+	if label != "Alice" {
+		t.Errorf("label = %q, want enrolled name %q", label, "Alice")
+	}
+}
@@ -0,0 +1,106 @@
+package diarization
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// registryFileName is the snapshot file Save writes and Load reads back,
+// rooted at the directory a caller passes in (typically cfg.Audio.RegistryDir).
+const registryFileName = "speaker_registry.json"
+
+// clusterSnapshot is cluster's on-disk shape.
+type clusterSnapshot struct {
+	Label    string    `json:"label"`
+	Centroid []float32 `json:"centroid"`
+	Count    int       `json:"count"`
+}
+
+// Save writes every source's clusters to dir/speaker_registry.json as JSON,
+// creating dir if needed, so labels assigned this session are still in use
+// after a restart (see Load).
+func (t *Tracker) Save(dir string) error {
+	t.mu.Lock()
+	snapshot := make(map[string][]clusterSnapshot, len(t.clusters))
+	for source, cs := range t.clusters {
+		records := make([]clusterSnapshot, len(cs))
+		for i, c := range cs {
+			records[i] = clusterSnapshot{Label: c.label, Centroid: c.centroid, Count: c.count}
+		}
+		snapshot[source] = records
+	}
+	t.mu.Unlock()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("diarization: creating registry dir %s: %w", dir, err)
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("diarization: encoding speaker registry: %w", err)
+	}
+
+	path := filepath.Join(dir, registryFileName)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("diarization: writing speaker registry %s: %w", path, err)
+	}
+	return nil
+}
+
+// Load restores clusters previously written by Save, replacing whatever
+// this Tracker currently holds. A missing file is not an error (first run
+// in dir); it simply leaves the Tracker's starting state untouched.
+func (t *Tracker) Load(dir string) error {
+	path := filepath.Join(dir, registryFileName)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("diarization: reading speaker registry %s: %w", path, err)
+	}
+
+	var snapshot map[string][]clusterSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("diarization: decoding speaker registry %s: %w", path, err)
+	}
+
+	clusters := make(map[string][]*cluster, len(snapshot))
+	for source, records := range snapshot {
+		cs := make([]*cluster, len(records))
+		for i, r := range records {
+			cs[i] = &cluster{label: r.Label, centroid: r.Centroid, count: r.Count}
+		}
+		clusters[source] = cs
+	}
+
+	t.mu.Lock()
+	t.clusters = clusters
+	t.mu.Unlock()
+	return nil
+}
+
+// RenameSpeaker relabels every cluster currently labeled oldLabel (across
+// all sources) to newLabel, e.g. so a user can correct an auto-assigned
+// "Speaker N" label to a real name after the fact. Returns an error if
+// oldLabel isn't assigned to any cluster.
+func (t *Tracker) RenameSpeaker(oldLabel, newLabel string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	found := false
+	for _, cs := range t.clusters {
+		for _, c := range cs {
+			if c.label == oldLabel {
+				c.label = newLabel
+				found = true
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("diarization: no speaker labeled %q", oldLabel)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package diarization
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSaveLoadRoundTripsClusters(t *testing.T) {
+	dir := t.TempDir()
+
+	embed := &fakeEmbedder{embeddings: [][]float32{{1, 0, 0}, {0, 1, 0}}}
+	tr := New(embed, Config{})
+	tr.Identify(context.Background(), "user", nil, 16000)
+	tr.Identify(context.Background(), "system", nil, 16000)
+
+	if err := tr.Save(dir); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	restored := New(&fakeEmbedder{}, Config{})
+	if err := restored.Load(dir); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if len(restored.clusters["user"]) != 1 || restored.clusters["user"][0].label != "Speaker 1" {
+		t.Errorf("restored user clusters = %+v, want one cluster labeled Speaker 1", restored.clusters["user"])
+	}
+	if len(restored.clusters["system"]) != 1 || restored.clusters["system"][0].label != "Speaker 1" {
+		t.Errorf("restored system clusters = %+v, want one cluster labeled Speaker 1", restored.clusters["system"])
+	}
+}
+
+func TestLoadMissingFileIsNotAnError(t *testing.T) {
+	tr := New(&fakeEmbedder{}, Config{})
+	if err := tr.Load(t.TempDir()); err != nil {
+		t.Errorf("Load on empty dir: %v, want nil (first run)", err)
+	}
+}
+
+func TestRenameSpeakerRelabelsMatchingClusters(t *testing.T) {
+	embed := &fakeEmbedder{embeddings: [][]float32{{1, 0, 0}}}
+	tr := New(embed, Config{})
+	tr.Identify(context.Background(), "user", nil, 16000)
+
+	if err := tr.RenameSpeaker("Speaker 1", "Alice"); err != nil {
+		t.Fatalf("RenameSpeaker: %v", err)
+	}
+
+	label, _ := tr.Identify(context.Background(), "user", nil, 16000)
+	if label != "Alice" {
+		t.Errorf("label after rename = %q, want %q", label, "Alice")
+	}
+}
+
+func TestRenameSpeakerUnknownLabelErrors(t *testing.T) {
+	tr := New(&fakeEmbedder{}, Config{})
+	if err := tr.RenameSpeaker("Speaker 1", "Alice"); err == nil {
+		t.Error("RenameSpeaker on unknown label: got nil error, want one")
+	}
+}
@@ -0,0 +1,45 @@
+package mumble
+
+// resampler linearly interpolates mono PCM from srcRate to dstRate,
+// carrying the fractional source position across calls so that one
+// resampler per user can be fed consecutive Opus-decoded frames without an
+// audible click at each buffer boundary.
+type resampler struct {
+	srcRate int
+	dstRate int
+	carry   float64
+}
+
+func newResampler(srcRate, dstRate int) *resampler {
+	return &resampler{srcRate: srcRate, dstRate: dstRate}
+}
+
+// convert turns 16-bit PCM at r.srcRate into mono float32 at r.dstRate.
+func (r *resampler) convert(in []int16) []float32 {
+	mono := make([]float32, len(in))
+	for i, s := range in {
+		mono[i] = float32(s) / 32768.0
+	}
+	if r.srcRate == r.dstRate || r.dstRate == 0 {
+		return mono
+	}
+	return r.resample(mono)
+}
+
+func (r *resampler) resample(in []float32) []float32 {
+	if len(in) < 2 {
+		return nil
+	}
+
+	ratio := float64(r.srcRate) / float64(r.dstRate)
+	var out []float32
+	pos := r.carry
+	for pos < float64(len(in)-1) {
+		i := int(pos)
+		frac := float32(pos - float64(i))
+		out = append(out, in[i]*(1-frac)+in[i+1]*frac)
+		pos += ratio
+	}
+	r.carry = pos - float64(len(in)-1)
+	return out
+}
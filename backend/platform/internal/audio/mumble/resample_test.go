@@ -0,0 +1,44 @@
+package mumble
+
+import "testing"
+
+func TestResamplerPassthroughAtEqualRates(t *testing.T) {
+	r := newResampler(48000, 48000)
+	in := []int16{0, 16384, -16384, 32767}
+	out := r.convert(in)
+
+	if len(out) != len(in) {
+		t.Fatalf("len(out) = %d, want %d", len(out), len(in))
+	}
+	if out[1] <= 0 || out[2] >= 0 {
+		t.Errorf("out = %v, want sign to follow input", out)
+	}
+}
+
+func TestResamplerDownsamplesHalfRate(t *testing.T) {
+	r := newResampler(48000, 16000)
+	in := make([]int16, 300)
+	for i := range in {
+		in[i] = 1000
+	}
+
+	out := r.convert(in)
+	wantLen := len(in) / 3
+	if out == nil || len(out) < wantLen-1 || len(out) > wantLen+1 {
+		t.Errorf("len(out) = %d, want approximately %d", len(out), wantLen)
+	}
+}
+
+func TestResamplerCarriesFractionAcrossCalls(t *testing.T) {
+	r := newResampler(48000, 44100)
+	in := make([]int16, 10)
+	for i := range in {
+		in[i] = int16(i * 100)
+	}
+
+	first := r.convert(in)
+	second := r.convert(in)
+	if len(first) == 0 || len(second) == 0 {
+		t.Fatal("expected both calls to produce output")
+	}
+}
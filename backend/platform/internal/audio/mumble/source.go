@@ -0,0 +1,150 @@
+// Package mumble implements a Mumble voice client as a capture.AudioSource,
+// so the app can join a Mumble channel as a bot and passively transcribe
+// every other participant without any of them running the native capturer -
+// similar to how matterbridge uses gumble to bridge Mumble voice.
+package mumble
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"layeh.com/gumble/gumble"
+	"layeh.com/gumble/gumbleutil"
+	_ "layeh.com/gumble/opus" // registers the Opus codec gumble needs to decode incoming voice
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+// Config configures a connection to a Mumble server.
+type Config struct {
+	Server     string // host:port, e.g. "mumble.example.com:64738"
+	Username   string // bot username
+	Channel    string // channel path to join after connecting, e.g. "Root/Meeting"; "" stays in the root channel
+	Cert       string // path to a PEM client certificate, for servers that require certificate auth
+	SampleRate int    // target sample rate for emitted Frames
+}
+
+// Source joins a Mumble server as a bot and emits every other participant's
+// decoded voice as Frames, one Frame.Source per user, so distinct speakers
+// keep independent VAD state and transcript routing through the same
+// AudioSource pipeline every other capture backend uses. The bot itself is
+// always self-muted: it only listens.
+type Source struct {
+	cfg     Config
+	breaker *resilience.Breaker
+
+	cancel   context.CancelFunc
+	stopOnce sync.Once
+}
+
+// NewSource returns a Source that connects to cfg.Server once Start is
+// called.
+func NewSource(cfg Config) *Source {
+	return &Source{cfg: cfg, breaker: resilience.New(resilience.DefaultConfig())}
+}
+
+// Start connects to the server in the background and begins routing
+// participant audio into Frames. It never blocks on connectivity: a server
+// that's unreachable keeps retrying behind the circuit breaker without
+// affecting the returned channel or other sources.
+func (s *Source) Start(ctx context.Context) (<-chan capture.Frame, error) {
+	runCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	out := make(chan capture.Frame)
+	go s.run(runCtx, out)
+	return out, nil
+}
+
+// Stop cancels the background connection loop.
+func (s *Source) Stop() {
+	s.stopOnce.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+		}
+	})
+}
+
+// Info identifies this as the mumble backend, labeled by the server it
+// connects to.
+func (s *Source) Info() capture.SourceInfo {
+	return capture.SourceInfo{Backend: "mumble", Name: s.cfg.Server}
+}
+
+func (s *Source) run(ctx context.Context, out chan<- capture.Frame) {
+	defer close(out)
+	for ctx.Err() == nil {
+		err := s.breaker.Execute(func() error { return s.connectOnce(ctx, out) })
+		switch {
+		case ctx.Err() != nil:
+			return
+		case errors.Is(err, resilience.ErrOpen):
+			slog.Debug("mumble breaker open, backing off", "server", s.cfg.Server)
+		case errors.Is(err, resilience.ErrPermanent):
+			slog.Warn("mumble connection failed permanently, not retrying", "server", s.cfg.Server, "error", err)
+			return
+		case err != nil:
+			slog.Warn("mumble connection ended, reconnecting", "server", s.cfg.Server, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// connectOnce dials the server, joins the configured channel, and routes
+// every participant's audio until the connection drops or ctx is canceled.
+func (s *Source) connectOnce(ctx context.Context, out chan<- capture.Frame) error {
+	config := gumble.NewConfig()
+	config.Username = s.cfg.Username
+
+	tlsConfig := &tls.Config{}
+	if s.cfg.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(s.cfg.Cert, s.cfg.Cert)
+		if err != nil {
+			return fmt.Errorf("%w: load client certificate %q: %v", resilience.ErrPermanent, s.cfg.Cert, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	disconnected := make(chan struct{})
+	config.Attach(gumbleutil.Listener{
+		Disconnect: func(*gumble.DisconnectEvent) { close(disconnected) },
+	})
+	config.AttachAudio(&streamRouter{sampleRate: s.cfg.SampleRate, out: out})
+
+	client, err := gumble.DialWithDialer(new(net.Dialer), s.cfg.Server, config, tlsConfig)
+	if err != nil {
+		return fmt.Errorf("connect to %q: %w", s.cfg.Server, err)
+	}
+	defer client.Disconnect()
+
+	// The bot only listens; it never transmits its own audio.
+	client.Self.SetSelfMuted(true)
+
+	if s.cfg.Channel != "" {
+		if channel := client.Channels.Find(strings.Split(s.cfg.Channel, "/")...); channel != nil {
+			client.Self.Move(channel)
+		} else {
+			slog.Warn("mumble channel not found, staying in root", "channel", s.cfg.Channel)
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-disconnected:
+		return fmt.Errorf("disconnected from %q", s.cfg.Server)
+	}
+}
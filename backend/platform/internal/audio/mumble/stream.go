@@ -0,0 +1,44 @@
+package mumble
+
+import (
+	"fmt"
+	"time"
+
+	"layeh.com/gumble/gumble"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+)
+
+// streamRouter implements gumble.AudioListener, turning each participant's
+// decoded voice stream into Frames tagged with that user's name as Speaker,
+// so the pipeline can label them without running diarization. Each user
+// gets its own Frame.Source (and therefore its own VAD state and transcript
+// routing key), the same "reuse Source as the per-device key" convention
+// internal/capture.RTSPSource and whip.Source already follow.
+type streamRouter struct {
+	sampleRate int
+	out        chan<- capture.Frame
+}
+
+// OnAudioStream implements gumble.AudioListener. A muted, self-muted, or
+// suppressed user can't transmit, so its stream is skipped without spawning
+// a decode goroutine.
+func (r *streamRouter) OnAudioStream(e *gumble.AudioStreamEvent) {
+	if e.User.Muted || e.User.SelfMuted || e.User.Suppressed {
+		return
+	}
+
+	deviceID := fmt.Sprintf("user:%d", e.User.Session)
+	speaker := e.User.Name
+	resamp := newResampler(gumble.AudioSampleRate, r.sampleRate)
+
+	go func() {
+		for packet := range e.C {
+			pcm := resamp.convert(packet.AudioBuffer)
+			if len(pcm) == 0 {
+				continue
+			}
+			r.out <- capture.Frame{Audio: pcm, Source: deviceID, Speaker: speaker, Timestamp: time.Now().UnixNano()}
+		}
+	}()
+}
@@ -0,0 +1,57 @@
+package normalize
+
+import "math"
+
+// K-weighting pre-filter constants from ITU-R BS.1770: a high-shelf boost
+// modeling head diffraction, and a high-pass removing subsonic content that
+// shouldn't count toward perceived loudness.
+const (
+	shelfCutoffHz = 1500.0
+	shelfGainDB   = 4.0
+	hpCutoffHz    = 38.0
+)
+
+// kWeightFilter approximates BS.1770's two-stage K-weighting pre-filter
+// with one-pole IIR stages instead of the standard's RBJ biquads, since
+// this package only needs a perceptual loudness estimate for gain control,
+// not a certified R128 meter. State persists across calls so filtering
+// stays continuous between chunks from the same device.
+type kWeightFilter struct {
+	sampleRate float64
+	shelfLP    float64 // one-pole lowpass sidechain feeding the shelf stage
+	hpPrevIn   float64
+	hpPrevOut  float64
+}
+
+func newKWeightFilter(sampleRate int) *kWeightFilter {
+	if sampleRate <= 0 {
+		sampleRate = 16000
+	}
+	return &kWeightFilter{sampleRate: float64(sampleRate)}
+}
+
+// apply filters samples in place.
+func (k *kWeightFilter) apply(samples []float32) {
+	shelfAlpha := 2 * math.Pi * shelfCutoffHz / k.sampleRate
+	shelfCoeff := shelfAlpha / (shelfAlpha + 1)
+	shelfGain := math.Pow(10, shelfGainDB/20) - 1
+
+	hpRC := 1 / (2 * math.Pi * hpCutoffHz)
+	dt := 1 / k.sampleRate
+	hpCoeff := hpRC / (hpRC + dt)
+
+	for i, s := range samples {
+		x := float64(s)
+
+		// Stage 1: high-shelf boost, derived from a lowpass sidechain.
+		k.shelfLP += shelfCoeff * (x - k.shelfLP)
+		shelved := x + shelfGain*(x-k.shelfLP)
+
+		// Stage 2: high-pass to remove subsonic content.
+		hpOut := hpCoeff * (k.hpPrevOut + shelved - k.hpPrevIn)
+		k.hpPrevIn = shelved
+		k.hpPrevOut = hpOut
+
+		samples[i] = float32(hpOut)
+	}
+}
@@ -0,0 +1,182 @@
+// Package normalize applies per-device loudness normalization to captured
+// audio chunks before they reach the VAD, so a quiet built-in mic and a hot
+// loopback feed produce comparable speech-detection thresholds.
+//
+// It estimates integrated loudness as block-averaged RMS in dBFS over a
+// rolling window, which approximates (but doesn't implement) full EBU R128
+// K-weighted gating — close enough to drive a gain estimate without pulling
+// in a loudness-metering dependency.
+package normalize
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// TargetLUFS is the loudness level chunks are normalized toward by default.
+const TargetLUFS = -23.0
+
+// MaxGainDB clamps how far a device's gain can be pushed in either
+// direction, so a near-silent device isn't amplified into pure noise.
+const MaxGainDB = 12.0
+
+// Window is how far back the rolling loudness estimate looks before
+// resetting, per device.
+const Window = 10 * time.Second
+
+// softKneeFactor is how much of the gap between the current and
+// newly-measured gain is closed per chunk under Mode EBUR128, so gain
+// doesn't jump discontinuously between chunks. Mode RMS applies the
+// measured gain directly, matching this package's original behavior.
+const softKneeFactor = 0.25
+
+// Mode selects how a chunk's loudness is estimated before computing gain.
+type Mode string
+
+const (
+	// ModeOff disables normalization; Apply is a no-op returning 0.
+	ModeOff Mode = "off"
+	// ModeRMS estimates loudness as block-averaged RMS in dBFS (the
+	// package's original behavior).
+	ModeRMS Mode = "rms"
+	// ModeEBUR128 pre-filters samples with a K-weighting approximation
+	// (ITU-R BS.1770 stage-1 high-shelf +4dB @ 1.5kHz, stage-2 high-pass
+	// @ 38Hz) before the same RMS estimate, and smooths gain changes with
+	// a soft knee. It approximates, but does not implement, full EBU R128
+	// loudness gating.
+	ModeEBUR128 Mode = "ebur128"
+)
+
+type deviceState struct {
+	mu          sync.Mutex
+	windowStart time.Time
+	sumSq       float64
+	count       int64
+	gainDB      float64
+	kw          *kWeightFilter
+}
+
+// Normalizer tracks a rolling loudness estimate and gain per DeviceID.
+// The zero value is not usable; construct with New or NewWithConfig.
+type Normalizer struct {
+	mu         sync.Mutex
+	devices    map[string]*deviceState
+	targetLUFS float64
+	mode       Mode
+	sampleRate int
+}
+
+// New creates a Normalizer using block RMS loudness estimation targeting
+// TargetLUFS, the package's original behavior.
+func New() *Normalizer {
+	return NewWithConfig(TargetLUFS, ModeRMS, 0)
+}
+
+// NewWithConfig creates a Normalizer targeting targetLUFS using mode.
+// sampleRate is only used by ModeEBUR128's K-weighting pre-filter; pass 0
+// for ModeOff/ModeRMS.
+func NewWithConfig(targetLUFS float64, mode Mode, sampleRate int) *Normalizer {
+	if mode == "" {
+		mode = ModeRMS
+	}
+	return &Normalizer{
+		devices:    make(map[string]*deviceState),
+		targetLUFS: targetLUFS,
+		mode:       mode,
+		sampleRate: sampleRate,
+	}
+}
+
+// Apply normalizes samples in place toward the configured target loudness
+// based on deviceID's rolling loudness estimate as of timestamp
+// (audio.Chunk.Timestamp, UnixNano), and returns the gain applied in dB.
+// With ModeOff it leaves samples untouched and returns 0.
+func (n *Normalizer) Apply(deviceID string, samples []float32, timestamp int64) float64 {
+	if n.mode == ModeOff {
+		return 0
+	}
+
+	state := n.stateFor(deviceID)
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	now := time.Unix(0, timestamp)
+	if state.windowStart.IsZero() || now.Sub(state.windowStart) > Window {
+		state.windowStart = now
+		state.sumSq = 0
+		state.count = 0
+	}
+
+	measured := samples
+	if n.mode == ModeEBUR128 {
+		if state.kw == nil {
+			state.kw = newKWeightFilter(n.sampleRate)
+		}
+		measured = append([]float32(nil), samples...)
+		state.kw.apply(measured)
+	}
+
+	for _, s := range measured {
+		state.sumSq += float64(s) * float64(s)
+	}
+	state.count += int64(len(measured))
+
+	if state.count > 0 && state.sumSq > 0 {
+		rms := math.Sqrt(state.sumSq / float64(state.count))
+		loudnessDB := 20 * math.Log10(rms)
+		targetGain := clampGain(n.targetLUFS - loudnessDB)
+		if n.mode == ModeEBUR128 {
+			state.gainDB += softKneeFactor * (targetGain - state.gainDB)
+		} else {
+			state.gainDB = targetGain
+		}
+	}
+
+	gain := dbToLinear(state.gainDB)
+	for i := range samples {
+		samples[i] *= float32(gain)
+	}
+	return state.gainDB
+}
+
+// Stats returns the current gain, in dB, applied to each device seen so far.
+func (n *Normalizer) Stats() map[string]float64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	out := make(map[string]float64, len(n.devices))
+	for id, state := range n.devices {
+		state.mu.Lock()
+		out[id] = state.gainDB
+		state.mu.Unlock()
+	}
+	return out
+}
+
+func (n *Normalizer) stateFor(deviceID string) *deviceState {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	state, ok := n.devices[deviceID]
+	if !ok {
+		state = &deviceState{}
+		n.devices[deviceID] = state
+	}
+	return state
+}
+
+func clampGain(db float64) float64 {
+	if db > MaxGainDB {
+		return MaxGainDB
+	}
+	if db < -MaxGainDB {
+		return -MaxGainDB
+	}
+	return db
+}
+
+func dbToLinear(db float64) float64 {
+	return math.Pow(10, db/20)
+}
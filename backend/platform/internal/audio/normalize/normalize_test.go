@@ -0,0 +1,154 @@
+package normalize
+
+import (
+	"math"
+	"testing"
+)
+
+// sineWave generates n samples of a full-scale sine at the given dBFS level.
+func sineWave(n int, dBFS float64) []float32 {
+	amplitude := math.Pow(10, dBFS/20)
+	out := make([]float32, n)
+	for i := range out {
+		out[i] = float32(amplitude * math.Sin(2*math.Pi*float64(i)/32))
+	}
+	return out
+}
+
+func rmsDB(samples []float32) float64 {
+	var sumSq float64
+	for _, s := range samples {
+		sumSq += float64(s) * float64(s)
+	}
+	rms := math.Sqrt(sumSq / float64(len(samples)))
+	if rms == 0 {
+		return math.Inf(-1)
+	}
+	return 20 * math.Log10(rms)
+}
+
+func TestApplyConvergesQuietSignalTowardTarget(t *testing.T) {
+	n := New()
+	const chunkSize = 1024
+	var lastDB float64
+
+	// A sine's RMS sits ~3dB below its peak amplitude, and reaching
+	// TargetLUFS from here needs more gain than MaxGainDB allows, so the
+	// achievable target is the raw level plus the clamped gain, not TargetLUFS.
+	rawDB := rmsDB(sineWave(chunkSize, -40))
+	want := rawDB + clampGain(TargetLUFS-rawDB)
+	for i := 0; i < 10; i++ {
+		chunk := sineWave(chunkSize, -40)
+		n.Apply("mic", chunk, int64(i)*1e6)
+		lastDB = rmsDB(chunk)
+	}
+
+	if diff := math.Abs(lastDB - want); diff > 2 {
+		t.Errorf("emitted RMS = %.2f dB, want within 2dB of clamped target %.2f", lastDB, want)
+	}
+}
+
+func TestApplyConvergesLoudSignalTowardTarget(t *testing.T) {
+	n := New()
+	const chunkSize = 1024
+	var lastDB float64
+
+	for i := 0; i < 10; i++ {
+		chunk := sineWave(chunkSize, -6)
+		n.Apply("loopback", chunk, int64(i)*1e6)
+		lastDB = rmsDB(chunk)
+	}
+
+	if diff := math.Abs(lastDB - TargetLUFS); diff > 2 {
+		t.Errorf("emitted RMS = %.2f dB, want within 2dB of target %.2f", lastDB, TargetLUFS)
+	}
+}
+
+func TestApplyClampsGainForNearSilence(t *testing.T) {
+	n := New()
+	chunk := sineWave(1024, -80)
+	gain := n.Apply("mic", chunk, 0)
+
+	if gain > MaxGainDB || gain < -MaxGainDB {
+		t.Errorf("gain = %.2f dB, want within +/-%.2f dB", gain, MaxGainDB)
+	}
+}
+
+func TestStatsReportsPerDeviceGain(t *testing.T) {
+	n := New()
+	n.Apply("mic", sineWave(1024, -40), 0)
+	n.Apply("loopback", sineWave(1024, -6), 0)
+
+	stats := n.Stats()
+	if len(stats) != 2 {
+		t.Fatalf("len(stats) = %d, want 2", len(stats))
+	}
+	if _, ok := stats["mic"]; !ok {
+		t.Error("stats missing \"mic\"")
+	}
+	if _, ok := stats["loopback"]; !ok {
+		t.Error("stats missing \"loopback\"")
+	}
+}
+
+func TestApplyResetsEstimateAfterWindow(t *testing.T) {
+	n := New()
+	n.Apply("mic", sineWave(1024, -6), 0)
+	firstGain := n.Stats()["mic"]
+
+	n.Apply("mic", sineWave(1024, -40), int64(2*Window))
+	secondGain := n.Stats()["mic"]
+
+	if math.Abs(firstGain-secondGain) < 1 {
+		t.Errorf("expected gain to shift after window reset: first=%.2f second=%.2f", firstGain, secondGain)
+	}
+}
+
+func TestApplyModeOffLeavesSamplesUnchanged(t *testing.T) {
+	n := NewWithConfig(TargetLUFS, ModeOff, 16000)
+	chunk := sineWave(1024, -40)
+	want := append([]float32(nil), chunk...)
+
+	gain := n.Apply("mic", chunk, 0)
+
+	if gain != 0 {
+		t.Errorf("gain = %.2f, want 0", gain)
+	}
+	for i := range chunk {
+		if chunk[i] != want[i] {
+			t.Fatalf("sample %d = %v, want unchanged %v", i, chunk[i], want[i])
+		}
+	}
+}
+
+func TestApplyModeEBUR128SmoothsGainAcrossChunks(t *testing.T) {
+	n := NewWithConfig(TargetLUFS, ModeEBUR128, 16000)
+	var gains []float64
+	for i := 0; i < 5; i++ {
+		chunk := sineWave(1024, -6)
+		gains = append(gains, n.Apply("loopback", chunk, int64(i)*1e6))
+	}
+
+	for i := 1; i < len(gains); i++ {
+		if gains[i] == gains[0] {
+			t.Fatalf("expected gain to change gradually under the soft knee, got flat sequence %v", gains)
+		}
+	}
+	if math.Abs(gains[len(gains)-1]) > MaxGainDB {
+		t.Errorf("final gain = %.2f, want within +/-%.2f dB", gains[len(gains)-1], MaxGainDB)
+	}
+}
+
+func TestApplyModeEBUR128ConvergesTowardTarget(t *testing.T) {
+	n := NewWithConfig(TargetLUFS, ModeEBUR128, 16000)
+	var lastDB float64
+	for i := 0; i < 40; i++ {
+		chunk := sineWave(1024, -6)
+		n.Apply("loopback", chunk, int64(i)*1e6)
+		lastDB = rmsDB(chunk)
+	}
+
+	if diff := math.Abs(lastDB - TargetLUFS); diff > 3 {
+		t.Errorf("emitted RMS = %.2f dB, want within 3dB of target %.2f after convergence", lastDB, TargetLUFS)
+	}
+}
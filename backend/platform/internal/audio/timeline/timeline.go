@@ -0,0 +1,113 @@
+// Package timeline buffers recently captured audio chunks in a bounded ring
+// so downstream consumers can pull pre-roll audio once speech is detected,
+// or replay the exact window an auto-answer question came from.
+package timeline
+
+import (
+	"sync"
+	"time"
+
+	audiocap "github.com/GriffinCanCode/good-listener/backend/platform/internal/audio"
+)
+
+// Chunk is an alias of audio.Chunk so callers can feed capture output
+// straight into a Timeline without a conversion step.
+type Chunk = audiocap.Chunk
+
+// DefaultWindow is how far back a Timeline retains audio by default.
+const DefaultWindow = 30 * time.Second
+
+// DefaultCapacity bounds memory use regardless of chunk rate. At ~23ms
+// chunks (1024 frames @ 44.1kHz) DefaultWindow is roughly 1300 chunks, so
+// this leaves headroom without growing unbounded if a device emits smaller
+// or more frequent chunks.
+const DefaultCapacity = 4096
+
+// Timeline is a bounded ring buffer of timestamped audio chunks.
+type Timeline struct {
+	mu     sync.Mutex
+	window time.Duration
+	buf    []Chunk
+	start  int // index of the oldest chunk
+	size   int // number of valid chunks currently buffered
+}
+
+// New creates a Timeline retaining window of audio, bounded to capacity
+// chunks. A zero window or capacity falls back to the package defaults.
+func New(window time.Duration, capacity int) *Timeline {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Timeline{window: window, buf: make([]Chunk, capacity)}
+}
+
+// Add appends a chunk, evicting whatever has aged out of the retention
+// window relative to it.
+func (t *Timeline) Add(c Chunk) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	idx := (t.start + t.size) % len(t.buf)
+	if t.size == len(t.buf) {
+		t.buf[idx] = c
+		t.start = (t.start + 1) % len(t.buf)
+	} else {
+		t.buf[idx] = c
+		t.size++
+	}
+
+	t.evictOlderThan(c.Timestamp)
+}
+
+// evictOlderThan drops chunks whose timestamp falls outside the retention
+// window relative to now. Must be called with mu held.
+func (t *Timeline) evictOlderThan(now int64) {
+	cutoff := now - t.window.Nanoseconds()
+	for t.size > 0 && t.buf[t.start].Timestamp < cutoff {
+		t.start = (t.start + 1) % len(t.buf)
+		t.size--
+	}
+}
+
+// Snapshot returns every buffered chunk whose timestamp falls within
+// [from, to], oldest first.
+func (t *Timeline) Snapshot(from, to time.Time) []Chunk {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fromNanos, toNanos := from.UnixNano(), to.UnixNano()
+	out := make([]Chunk, 0, t.size)
+	for i := 0; i < t.size; i++ {
+		c := t.buf[(t.start+i)%len(t.buf)]
+		if c.Timestamp >= fromNanos && c.Timestamp <= toNanos {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// PreRoll returns the last d of buffered chunks captured on deviceID, oldest
+// first, for prepending to a speech segment so words at its leading edge
+// aren't clipped by VAD onset latency.
+func (t *Timeline) PreRoll(d time.Duration, deviceID string) []Chunk {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.size == 0 {
+		return nil
+	}
+
+	latest := t.buf[(t.start+t.size-1)%len(t.buf)].Timestamp
+	cutoff := latest - d.Nanoseconds()
+
+	out := make([]Chunk, 0, t.size)
+	for i := 0; i < t.size; i++ {
+		c := t.buf[(t.start+i)%len(t.buf)]
+		if c.DeviceID == deviceID && c.Timestamp >= cutoff {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+package timeline
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func chunkAt(t time.Time, deviceID string) Chunk {
+	return Chunk{Data: []float32{1, 2, 3}, DeviceID: deviceID, Source: "user", Timestamp: t.UnixNano()}
+}
+
+func TestSnapshotReturnsChunksWithinRange(t *testing.T) {
+	base := time.Unix(1000, 0)
+	tl := New(30*time.Second, 8)
+
+	for i := 0; i < 5; i++ {
+		tl.Add(chunkAt(base.Add(time.Duration(i)*time.Second), "mic"))
+	}
+
+	got := tl.Snapshot(base.Add(time.Second), base.Add(3*time.Second))
+	if len(got) != 3 {
+		t.Fatalf("Snapshot len = %d, want 3", len(got))
+	}
+	for i, c := range got {
+		want := base.Add(time.Duration(i+1) * time.Second).UnixNano()
+		if c.Timestamp != want {
+			t.Errorf("got[%d].Timestamp = %d, want %d", i, c.Timestamp, want)
+		}
+	}
+}
+
+func TestAddEvictsChunksOutsideWindow(t *testing.T) {
+	base := time.Unix(1000, 0)
+	tl := New(2*time.Second, 8)
+
+	tl.Add(chunkAt(base, "mic"))
+	tl.Add(chunkAt(base.Add(5*time.Second), "mic"))
+
+	got := tl.Snapshot(base, base.Add(10*time.Second))
+	if len(got) != 1 {
+		t.Fatalf("Snapshot len = %d, want 1 (old chunk should have aged out)", len(got))
+	}
+	if got[0].Timestamp != base.Add(5*time.Second).UnixNano() {
+		t.Errorf("surviving chunk has wrong timestamp")
+	}
+}
+
+func TestAddWrapsAroundRingWithoutCorruption(t *testing.T) {
+	base := time.Unix(1000, 0)
+	capacity := 4
+	tl := New(time.Hour, capacity) // window large enough that only capacity forces eviction
+
+	const total = 10
+	for i := 0; i < total; i++ {
+		tl.Add(chunkAt(base.Add(time.Duration(i)*time.Millisecond), "mic"))
+	}
+
+	got := tl.Snapshot(base, base.Add(time.Hour))
+	if len(got) != capacity {
+		t.Fatalf("Snapshot len = %d, want %d after wrap-around", len(got), capacity)
+	}
+	for i, c := range got {
+		wantIdx := total - capacity + i
+		want := base.Add(time.Duration(wantIdx) * time.Millisecond).UnixNano()
+		if c.Timestamp != want {
+			t.Errorf("got[%d].Timestamp = %d, want %d (ring buffer corrupted on wrap)", i, c.Timestamp, want)
+		}
+	}
+}
+
+func TestPreRollReturnsOnlyMatchingDeviceWithinDuration(t *testing.T) {
+	base := time.Unix(1000, 0)
+	tl := New(30*time.Second, 16)
+
+	for i := 0; i < 5; i++ {
+		tl.Add(chunkAt(base.Add(time.Duration(i)*time.Second), "mic"))
+		tl.Add(chunkAt(base.Add(time.Duration(i)*time.Second), "system"))
+	}
+
+	got := tl.PreRoll(2*time.Second, "mic")
+	for _, c := range got {
+		if c.DeviceID != "mic" {
+			t.Errorf("PreRoll returned chunk from device %q, want only mic", c.DeviceID)
+		}
+	}
+	if len(got) != 3 {
+		t.Fatalf("PreRoll len = %d, want 3 (last 2s inclusive of boundary)", len(got))
+	}
+}
+
+func TestConcurrentAddAndSnapshotIsSafe(t *testing.T) {
+	tl := New(30*time.Second, 64)
+	base := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tl.Add(chunkAt(base.Add(time.Duration(i)*time.Millisecond), "mic"))
+		}(i)
+	}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = tl.Snapshot(base.Add(-time.Minute), base.Add(time.Minute))
+			_ = tl.PreRoll(time.Second, "mic")
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,364 @@
+//go:build windows
+
+package audio
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/moutend/go-wca/pkg/wca"
+)
+
+// eventTimeoutMs bounds how long the capture loop waits on the WASAPI
+// event handle before re-checking ctx.Done.
+const eventTimeoutMs = 200
+
+// waveFormatIEEEFloat and waveFormatExtensible are WAVEFORMATEX.WFormatTag
+// values; go-wca doesn't export them under those names.
+const (
+	waveFormatIEEEFloat  = 0x0003
+	waveFormatExtensible = 0xFFFE
+)
+
+// waveFormatExtensibleTail mirrors the fields WAVEFORMATEXTENSIBLE appends
+// immediately after WAVEFORMATEX when WFormatTag is waveFormatExtensible.
+type waveFormatExtensibleTail struct {
+	wValidBitsPerSample uint16
+	dwChannelMask       uint32
+	subFormat           ole.GUID
+}
+
+// subFormatIEEEFloat is KSDATAFORMAT_SUBTYPE_IEEE_FLOAT.
+var subFormatIEEEFloat = ole.GUID{Data1: 0x00000003, Data2: 0x0000, Data3: 0x0010, Data4: [8]byte{0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71}}
+
+func isFloatFormat(wfx *wca.WAVEFORMATEX) bool {
+	switch wfx.WFormatTag {
+	case waveFormatIEEEFloat:
+		return true
+	case waveFormatExtensible:
+		tail := (*waveFormatExtensibleTail)(unsafe.Pointer(uintptr(unsafe.Pointer(wfx)) + unsafe.Sizeof(*wfx)))
+		return tail.subFormat == subFormatIEEEFloat
+	default:
+		return false
+	}
+}
+
+// wasapiLoopback captures a render endpoint via WASAPI loopback, so system
+// audio can be captured without a virtual device like BlackHole.
+type wasapiLoopback struct {
+	sampleRate   int
+	excludedDevs []string
+
+	client        *wca.IAudioClient
+	captureClient *wca.IAudioCaptureClient
+	eventHandle   uintptr
+	cancel        context.CancelFunc
+	stopOnce      sync.Once
+}
+
+func newSystemCapturer(sampleRate int, excludedDevices []string) systemCapturer {
+	return &wasapiLoopback{sampleRate: sampleRate, excludedDevs: excludedDevices}
+}
+
+// start activates loopback capture on the default render endpoint and runs
+// the capture loop in the background until the context is canceled or stop
+// is called.
+func (w *wasapiLoopback) start(ctx context.Context, out chan<- Chunk) error {
+	if err := ole.CoInitializeEx(0, ole.COINIT_MULTITHREADED); err != nil {
+		return fmt.Errorf("wasapi: CoInitializeEx: %w", err)
+	}
+
+	var enumerator *wca.IMMDeviceEnumerator
+	if err := wca.CoCreateInstance(wca.CLSID_MMDeviceEnumerator, 0, wca.CLSCTX_ALL, wca.IID_IMMDeviceEnumerator, &enumerator); err != nil {
+		return fmt.Errorf("wasapi: create device enumerator: %w", err)
+	}
+	defer enumerator.Release()
+
+	device, err := pickRenderEndpoint(enumerator, w.excludedDevs)
+	if err != nil {
+		return fmt.Errorf("wasapi: pick render endpoint: %w", err)
+	}
+	defer device.Release()
+
+	var client *wca.IAudioClient
+	if err := device.Activate(wca.IID_IAudioClient, wca.CLSCTX_ALL, nil, &client); err != nil {
+		return fmt.Errorf("wasapi: activate audio client: %w", err)
+	}
+
+	var mixFormat *wca.WAVEFORMATEX
+	if err := client.GetMixFormat(&mixFormat); err != nil {
+		client.Release()
+		return fmt.Errorf("wasapi: get mix format: %w", err)
+	}
+
+	const refTimesPerSec = wca.REFERENCE_TIME(10_000_000) // 1s in 100ns units
+	if err := client.Initialize(wca.AUDCLNT_SHAREMODE_SHARED, wca.AUDCLNT_STREAMFLAGS_LOOPBACK|wca.AUDCLNT_STREAMFLAGS_EVENTCALLBACK, refTimesPerSec, 0, mixFormat, nil); err != nil {
+		client.Release()
+		return fmt.Errorf("wasapi: initialize audio client: %w", err)
+	}
+
+	eventHandle := wca.CreateEventExA(0, 0, 0, 0x1F0003) // EVENT_ALL_ACCESS
+	if eventHandle == 0 {
+		client.Release()
+		return fmt.Errorf("wasapi: create event handle failed")
+	}
+	if err := client.SetEventHandle(eventHandle); err != nil {
+		client.Release()
+		return fmt.Errorf("wasapi: set event handle: %w", err)
+	}
+
+	var captureClient *wca.IAudioCaptureClient
+	if err := client.GetService(wca.IID_IAudioCaptureClient, &captureClient); err != nil {
+		client.Release()
+		return fmt.Errorf("wasapi: get capture service: %w", err)
+	}
+
+	if err := client.Start(); err != nil {
+		captureClient.Release()
+		client.Release()
+		return fmt.Errorf("wasapi: start: %w", err)
+	}
+
+	w.client = client
+	w.captureClient = captureClient
+	w.eventHandle = eventHandle
+
+	runCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+
+	go w.captureLoop(runCtx, newMixConverter(mixFormat, w.sampleRate), out)
+
+	return nil
+}
+
+// pickRenderEndpoint returns the default render endpoint unless its friendly
+// name matches excludedDevs, in which case it falls back to the first
+// active render endpoint that isn't excluded. Every IMMDevice returned by
+// the enumerator other than the chosen one is released before returning.
+func pickRenderEndpoint(enumerator *wca.IMMDeviceEnumerator, excludedDevs []string) (*wca.IMMDevice, error) {
+	var defaultDevice *wca.IMMDevice
+	if err := enumerator.GetDefaultAudioEndpoint(wca.ERender, wca.EConsole, &defaultDevice); err != nil {
+		return nil, fmt.Errorf("get default render endpoint: %w", err)
+	}
+
+	name, err := endpointFriendlyName(defaultDevice)
+	if err == nil && !isExcludedName(name, excludedDevs) {
+		return defaultDevice, nil
+	}
+	defaultDevice.Release()
+	slog.Info("default render endpoint excluded, scanning active endpoints", "device", name)
+
+	var collection *wca.IMMDeviceCollection
+	if err := enumerator.EnumAudioEndpoints(wca.ERender, wca.DEVICE_STATE_ACTIVE, &collection); err != nil {
+		return nil, fmt.Errorf("enumerate render endpoints: %w", err)
+	}
+	defer collection.Release()
+
+	var count uint32
+	if err := collection.GetCount(&count); err != nil {
+		return nil, fmt.Errorf("count render endpoints: %w", err)
+	}
+
+	for i := uint32(0); i < count; i++ {
+		var dev *wca.IMMDevice
+		if err := collection.Item(i, &dev); err != nil {
+			continue
+		}
+		name, err := endpointFriendlyName(dev)
+		if err == nil && !isExcludedName(name, excludedDevs) {
+			return dev, nil
+		}
+		dev.Release()
+	}
+
+	return nil, fmt.Errorf("no active render endpoint is unexcluded")
+}
+
+// endpointFriendlyName reads PKEY_Device_FriendlyName from dev's property store.
+func endpointFriendlyName(dev *wca.IMMDevice) (string, error) {
+	var ps *wca.IPropertyStore
+	if err := dev.OpenPropertyStore(wca.STGM_READ, &ps); err != nil {
+		return "", err
+	}
+	defer ps.Release()
+
+	var pv wca.PROPVARIANT
+	if err := ps.GetValue(&wca.PKEY_Device_FriendlyName, &pv); err != nil {
+		return "", err
+	}
+	return pv.String(), nil
+}
+
+func isExcludedName(name string, excludedDevs []string) bool {
+	for _, ex := range excludedDevs {
+		if containsIgnoreCase(name, ex) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *wasapiLoopback) captureLoop(ctx context.Context, conv *mixConverter, out chan<- Chunk) {
+	defer w.stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if wca.WaitForSingleObject(w.eventHandle, eventTimeoutMs) != 0 { // not WAIT_OBJECT_0
+			continue
+		}
+
+		var packetLen uint32
+		if err := w.captureClient.GetNextPacketSize(&packetLen); err != nil {
+			slog.Debug("wasapi loopback packet size error", "error", err)
+			return
+		}
+
+		for packetLen != 0 {
+			var data *byte
+			var framesAvailable, flags uint32
+			if err := w.captureClient.GetBuffer(&data, &framesAvailable, &flags, nil, nil); err != nil {
+				slog.Debug("wasapi loopback get buffer error", "error", err)
+				return
+			}
+
+			raw := unsafe.Slice(data, int(framesAvailable)*conv.frameSize())
+			if samples := conv.convert(raw); len(samples) > 0 {
+				chunk := Chunk{
+					Data:      samples,
+					DeviceID:  "wasapi-loopback",
+					Source:    "system",
+					Timestamp: time.Now().UnixNano(),
+				}
+				select {
+				case out <- chunk:
+				default:
+					slog.Debug("audio buffer full, dropping chunk", "device", chunk.DeviceID)
+				}
+			}
+
+			if err := w.captureClient.ReleaseBuffer(framesAvailable); err != nil {
+				slog.Debug("wasapi loopback release buffer error", "error", err)
+				return
+			}
+			if err := w.captureClient.GetNextPacketSize(&packetLen); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (w *wasapiLoopback) stop() {
+	w.stopOnce.Do(func() {
+		if w.cancel != nil {
+			w.cancel()
+		}
+		if w.client != nil {
+			_ = w.client.Stop()
+			w.client.Release()
+		}
+		if w.captureClient != nil {
+			w.captureClient.Release()
+		}
+		if w.eventHandle != 0 {
+			_ = wca.CloseHandle(w.eventHandle)
+		}
+		ole.CoUninitialize()
+	})
+}
+
+// mixConverter downmixes and resamples captured frames from the endpoint's
+// mix format to mono float32 at a target sample rate.
+type mixConverter struct {
+	channels       int
+	bytesPerSample int
+	float          bool
+	srcRate        int
+	dstRate        int
+	carry          float64 // fractional resample position carried across buffers
+}
+
+func newMixConverter(wfx *wca.WAVEFORMATEX, dstRate int) *mixConverter {
+	return &mixConverter{
+		channels:       int(wfx.NChannels),
+		bytesPerSample: int(wfx.WBitsPerSample) / 8,
+		float:          isFloatFormat(wfx),
+		srcRate:        int(wfx.NSamplesPerSec),
+		dstRate:        dstRate,
+	}
+}
+
+func (m *mixConverter) frameSize() int { return m.bytesPerSample * m.channels }
+
+// convert decodes raw interleaved frames into mono float32 samples.
+func (m *mixConverter) convert(raw []byte) []float32 {
+	frameSize := m.frameSize()
+	if frameSize == 0 || len(raw) < frameSize {
+		return nil
+	}
+
+	frames := len(raw) / frameSize
+	mono := make([]float32, frames)
+	for i := 0; i < frames; i++ {
+		var sum float32
+		base := i * frameSize
+		for ch := 0; ch < m.channels; ch++ {
+			off := base + ch*m.bytesPerSample
+			sum += m.decodeSample(raw[off : off+m.bytesPerSample])
+		}
+		mono[i] = sum / float32(m.channels)
+	}
+
+	if m.srcRate == m.dstRate || m.dstRate == 0 {
+		return mono
+	}
+	return m.resample(mono)
+}
+
+func (m *mixConverter) decodeSample(b []byte) float32 {
+	if m.float {
+		if len(b) < 4 {
+			return 0
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(b))
+	}
+	switch len(b) {
+	case 2:
+		return float32(int16(binary.LittleEndian.Uint16(b))) / 32768.0
+	case 4:
+		return float32(int32(binary.LittleEndian.Uint32(b))) / 2147483648.0
+	default:
+		return 0
+	}
+}
+
+// resample linearly interpolates from srcRate to dstRate, carrying the
+// fractional source position across calls so buffer boundaries don't
+// introduce audible clicks.
+func (m *mixConverter) resample(in []float32) []float32 {
+	if len(in) < 2 {
+		return nil
+	}
+
+	ratio := float64(m.srcRate) / float64(m.dstRate)
+	var out []float32
+	pos := m.carry
+	for pos < float64(len(in)-1) {
+		i := int(pos)
+		frac := float32(pos - float64(i))
+		out = append(out, in[i]*(1-frac)+in[i+1]*frac)
+		pos += ratio
+	}
+	m.carry = pos - float64(len(in)-1)
+	return out
+}
@@ -0,0 +1,55 @@
+package broadcast
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+)
+
+// amfValue holds the one AMF0 type this client actually sends: strings.
+// Kept as a struct (rather than encoding directly) so encodeAMF0Object's
+// map literals stay readable at the call site.
+type amfValue struct {
+	str string
+}
+
+func encodeAMF0Number(n float64) string {
+	buf := make([]byte, 9)
+	buf[0] = 0x00 // AMF0 number marker
+	binary.BigEndian.PutUint64(buf[1:], math.Float64bits(n))
+	return string(buf)
+}
+
+func encodeAMF0String(s string) string {
+	buf := make([]byte, 3+len(s))
+	buf[0] = 0x02 // AMF0 string marker
+	binary.BigEndian.PutUint16(buf[1:3], uint16(len(s)))
+	copy(buf[3:], s)
+	return string(buf)
+}
+
+func encodeAMF0Null() string {
+	return string([]byte{0x05})
+}
+
+// encodeAMF0Object encodes a flat string-valued AMF0 object, sorting keys
+// for deterministic output (useful for tests; RTMP peers don't care about
+// key order).
+func encodeAMF0Object(fields map[string]amfValue) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := []byte{0x03} // AMF0 object marker
+	for _, k := range keys {
+		nameLen := make([]byte, 2)
+		binary.BigEndian.PutUint16(nameLen, uint16(len(k)))
+		out = append(out, nameLen...)
+		out = append(out, k...)
+		out = append(out, encodeAMF0String(fields[k].str)...)
+	}
+	out = append(out, 0x00, 0x00, 0x09) // empty name + object-end marker
+	return string(out)
+}
@@ -0,0 +1,152 @@
+// Package broadcast tees the orchestrator's mixed audio and live
+// transcript captions to an outbound RTMP or WHIP endpoint, so a session
+// can be restreamed while it's being recorded.
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+// Pipeline streams audio and caption data to a single outbound destination.
+// Write calls are made from one goroutine at a time.
+type Pipeline interface {
+	WriteAudio(samples []float32) error
+	WriteCaption(text, source string) error
+	Close() error
+}
+
+// PipelineFunc dials url and returns a Pipeline ready to stream to it.
+// Swapping this lets Manager start with a pure-Go muxer today and move to
+// a GStreamer-backed implementation later without touching callers.
+type PipelineFunc func(url string) (Pipeline, error)
+
+// Manager holds a single swappable broadcast Pipeline behind a mutex.
+// Write failures (a dropped RTMP connection, a WHIP renegotiation) are
+// retried with backoff behind a circuit breaker, so a flapping outbound
+// connection never blocks or stops local capture.
+type Manager struct {
+	pipelineFn PipelineFunc
+	breaker    *resilience.Breaker
+
+	mu       sync.Mutex
+	pipeline Pipeline
+	url      string
+	cancel   context.CancelFunc
+}
+
+// NewManager creates a Manager that dials pipelines via pipelineFn.
+func NewManager(pipelineFn PipelineFunc) *Manager {
+	return &Manager{
+		pipelineFn: pipelineFn,
+		breaker:    resilience.New(resilience.SlowConfig()),
+	}
+}
+
+// Start begins streaming to url. Returns an error if a broadcast is
+// already active; call Stop first to switch destinations.
+func (m *Manager) Start(url string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pipeline != nil {
+		return fmt.Errorf("broadcast: already streaming to %s", m.url)
+	}
+
+	pipeline, err := m.pipelineFn(url)
+	if err != nil {
+		return fmt.Errorf("broadcast: dial %s: %w", url, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.pipeline = pipeline
+	m.url = url
+	m.cancel = cancel
+	go m.reconnectLoop(ctx, url)
+
+	slog.Info("broadcast started", "url", url)
+	return nil
+}
+
+// Stop ends the active broadcast, if any.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pipeline == nil {
+		return
+	}
+	m.cancel()
+	_ = m.pipeline.Close()
+	m.pipeline = nil
+	m.url = ""
+	slog.Info("broadcast stopped")
+}
+
+// IsActive reports whether a broadcast is currently running.
+func (m *Manager) IsActive() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.pipeline != nil
+}
+
+// PushAudio tees a mixed audio chunk to the active pipeline. It is a no-op
+// if no broadcast is active. Write failures are recorded on the breaker
+// and trigger the reconnect loop rather than being returned to the caller,
+// so a struggling outbound connection never backs up audio processing.
+func (m *Manager) PushAudio(samples []float32) {
+	m.withPipeline(func(p Pipeline) error { return p.WriteAudio(samples) })
+}
+
+// PushCaption tees a transcript line to the active pipeline, labeled by
+// its source ("user" or "system"). No-op if no broadcast is active.
+func (m *Manager) PushCaption(text, source string) {
+	m.withPipeline(func(p Pipeline) error { return p.WriteCaption(text, source) })
+}
+
+func (m *Manager) withPipeline(fn func(Pipeline) error) {
+	m.mu.Lock()
+	pipeline := m.pipeline
+	m.mu.Unlock()
+	if pipeline == nil {
+		return
+	}
+
+	if err := m.breaker.Execute(func() error { return fn(pipeline) }); err != nil && !errors.Is(err, resilience.ErrOpen) {
+		slog.Warn("broadcast write failed", "error", err)
+	}
+}
+
+// reconnectLoop redials the pipeline with backoff whenever the breaker
+// trips, keeping the same url until Stop cancels ctx.
+func (m *Manager) reconnectLoop(ctx context.Context, url string) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resilience.DefaultResetTimeout):
+		}
+
+		if m.breaker.State() != resilience.Open {
+			continue
+		}
+
+		pipeline, err := m.pipelineFn(url)
+		if err != nil {
+			slog.Warn("broadcast reconnect failed", "url", url, "error", err)
+			continue
+		}
+
+		m.mu.Lock()
+		if m.pipeline != nil {
+			_ = m.pipeline.Close()
+		}
+		m.pipeline = pipeline
+		m.mu.Unlock()
+		slog.Info("broadcast reconnected", "url", url)
+	}
+}
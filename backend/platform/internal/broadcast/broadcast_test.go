@@ -0,0 +1,109 @@
+package broadcast
+
+import (
+	"sync"
+	"testing"
+)
+
+type fakePipeline struct {
+	mu       sync.Mutex
+	audio    [][]float32
+	captions []string
+	closed   bool
+}
+
+func (f *fakePipeline) WriteAudio(samples []float32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.audio = append(f.audio, samples)
+	return nil
+}
+
+func (f *fakePipeline) WriteCaption(text, source string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.captions = append(f.captions, source+": "+text)
+	return nil
+}
+
+func (f *fakePipeline) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func newFakePipelineFn(p *fakePipeline) PipelineFunc {
+	return func(url string) (Pipeline, error) { return p, nil }
+}
+
+func TestManagerStartStopActive(t *testing.T) {
+	p := &fakePipeline{}
+	m := NewManager(newFakePipelineFn(p))
+
+	if m.IsActive() {
+		t.Fatal("IsActive() = true before Start")
+	}
+	if err := m.Start("rtmp://localhost/live/test"); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if !m.IsActive() {
+		t.Fatal("IsActive() = false after Start")
+	}
+
+	m.Stop()
+	if m.IsActive() {
+		t.Fatal("IsActive() = true after Stop")
+	}
+	if !p.closed {
+		t.Error("pipeline was not closed on Stop")
+	}
+}
+
+func TestManagerStartTwiceErrors(t *testing.T) {
+	m := NewManager(newFakePipelineFn(&fakePipeline{}))
+	defer m.Stop()
+
+	if err := m.Start("rtmp://localhost/live/a"); err != nil {
+		t.Fatalf("first Start: %v", err)
+	}
+	if err := m.Start("rtmp://localhost/live/b"); err == nil {
+		t.Fatal("expected error starting a second broadcast while one is active")
+	}
+}
+
+func TestManagerPushAudioAndCaption(t *testing.T) {
+	p := &fakePipeline{}
+	m := NewManager(newFakePipelineFn(p))
+	defer m.Stop()
+
+	if err := m.Start("rtmp://localhost/live/test"); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	m.PushAudio([]float32{0.1, 0.2})
+	m.PushCaption("hello", "user")
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.audio) != 1 {
+		t.Errorf("len(audio) = %d, want 1", len(p.audio))
+	}
+	if len(p.captions) != 1 || p.captions[0] != "user: hello" {
+		t.Errorf("captions = %v, want [\"user: hello\"]", p.captions)
+	}
+}
+
+func TestManagerPushAudioNoopWhenInactive(t *testing.T) {
+	p := &fakePipeline{}
+	m := NewManager(newFakePipelineFn(p))
+
+	m.PushAudio([]float32{0.1})
+	m.PushCaption("hello", "user")
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.audio) != 0 || len(p.captions) != 0 {
+		t.Error("expected no writes while no broadcast is active")
+	}
+}
@@ -0,0 +1,207 @@
+package broadcast
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+// NewFLVPipeline dials an rtmp:// url and returns a Pipeline that muxes
+// audio and caption data as FLV tags over a minimal RTMP connection. It
+// speaks the plain (non-digest) handshake and a bare connect/createStream/
+// publish command sequence, which most self-hosted RTMP ingests (nginx-rtmp,
+// MediaMTX) accept; CDNs that require the complex handshake are not yet
+// supported. Audio is carried as linear PCM16 (FLV sound format 3); video
+// is never sent, so captions are carried as onTextData script-data tags
+// rather than burned into frames, leaving that to a future GStreamer-backed
+// Pipeline.
+type flvPipeline struct {
+	conn       net.Conn
+	sampleRate int
+}
+
+const flvDefaultSampleRate = 16000
+
+// NewFLVPipeline implements PipelineFunc.
+func NewFLVPipeline(rawURL string) (Pipeline, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("broadcast: parse %q: %w", rawURL, err)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "1935")
+	}
+
+	conn, err := net.DialTimeout("tcp", host, RTMPDialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("broadcast: dial %s: %w", host, err)
+	}
+
+	p := &flvPipeline{conn: conn, sampleRate: flvDefaultSampleRate}
+	if err := p.handshake(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("broadcast: rtmp handshake: %w", err)
+	}
+
+	streamKey := streamKeyFromPath(u.Path)
+	if err := p.publish(streamKey); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("broadcast: rtmp publish %q: %w", streamKey, err)
+	}
+
+	return p, nil
+}
+
+// RTMPDialTimeout bounds the initial TCP connect.
+const RTMPDialTimeout = 5 * time.Second
+
+// handshake performs the plain RTMP handshake: C0+C1 out, S0+S1+S2 in, C2 out.
+func (p *flvPipeline) handshake() error {
+	c1 := make([]byte, 1536)
+	if _, err := rand.Read(c1[8:]); err != nil {
+		return err
+	}
+	if _, err := p.conn.Write(append([]byte{0x03}, c1...)); err != nil {
+		return err
+	}
+
+	s0s1s2 := make([]byte, 1+1536+1536)
+	if _, err := readFull(p.conn, s0s1s2); err != nil {
+		return err
+	}
+	s1 := s0s1s2[1 : 1+1536]
+
+	c2 := make([]byte, 1536)
+	copy(c2, s1)
+	binary.BigEndian.PutUint32(c2[4:8], 0) // echo timestamp as 0; peers don't validate it in the plain handshake
+	_, err := p.conn.Write(c2)
+	return err
+}
+
+func readFull(conn net.Conn, buf []byte) (int, error) {
+	total := 0
+	for total < len(buf) {
+		n, err := conn.Read(buf[total:])
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// publish sends the minimal connect -> createStream -> publish command
+// sequence as AMF0-encoded RTMP command messages on chunk stream 3.
+func (p *flvPipeline) publish(streamKey string) error {
+	connect := encodeAMF0String("connect") + encodeAMF0Number(1) + encodeAMF0Object(map[string]amfValue{
+		"app": {str: "live"},
+	})
+	if err := p.writeChunk(chunkTypeCommand, connect); err != nil {
+		return err
+	}
+
+	createStream := encodeAMF0String("createStream") + encodeAMF0Number(2) + encodeAMF0Null()
+	if err := p.writeChunk(chunkTypeCommand, createStream); err != nil {
+		return err
+	}
+
+	publish := encodeAMF0String("publish") + encodeAMF0Number(3) + encodeAMF0Null() +
+		encodeAMF0String(streamKey) + encodeAMF0String("live")
+	if err := p.writeChunk(chunkTypeCommand, publish); err != nil {
+		return err
+	}
+
+	return p.writeFLVHeader()
+}
+
+// chunk message type IDs used by this minimal client.
+const (
+	chunkTypeCommand = 20 // AMF0 command message
+	chunkTypeAudio   = 8
+	chunkTypeData    = 18 // AMF0 data message (script data)
+)
+
+// writeChunk wraps payload in a single RTMP Type 0 chunk header on chunk
+// stream ID 3, the conventional stream used for commands and media in
+// simple RTMP clients.
+func (p *flvPipeline) writeChunk(messageTypeID byte, payload string) error {
+	header := make([]byte, 12)
+	header[0] = 0x03 // fmt 0, chunk stream id 3
+	putUint24BE(header[1:4], 0)
+	putUint24BE(header[4:7], uint32(len(payload)))
+	header[7] = messageTypeID
+	binary.LittleEndian.PutUint32(header[8:12], 0) // message stream id
+
+	if _, err := p.conn.Write(header); err != nil {
+		return err
+	}
+	_, err := p.conn.Write([]byte(payload))
+	return err
+}
+
+func putUint24BE(b []byte, v uint32) {
+	b[0] = byte(v >> 16)
+	b[1] = byte(v >> 8)
+	b[2] = byte(v)
+}
+
+func (p *flvPipeline) writeFLVHeader() error {
+	// "FLV" signature, version 1, audio-only flag, header size 9, no previous-tag-size.
+	header := []byte{'F', 'L', 'V', 0x01, 0x04, 0x00, 0x00, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00}
+	_, err := p.conn.Write(header)
+	return err
+}
+
+// WriteAudio sends samples as a linear PCM16 FLV audio tag.
+func (p *flvPipeline) WriteAudio(samples []float32) error {
+	body := make([]byte, 1+len(samples)*2)
+	body[0] = flvAudioTagHeader(p.sampleRate)
+	for i, s := range samples {
+		binary.LittleEndian.PutUint16(body[1+i*2:], uint16(int16(s*32767)))
+	}
+	return p.writeChunk(chunkTypeAudio, string(body))
+}
+
+// flvAudioTagHeader packs FLV's SoundFormat(4)/SoundRate(2)/SoundSize(1)/
+// SoundType(1) bits for 16-bit mono linear PCM at sampleRate.
+func flvAudioTagHeader(sampleRate int) byte {
+	const soundFormatLinearPCM = 3
+	rateBits := byte(0) // FLV only encodes 4 discrete rates; callers resample to one of them upstream
+	switch {
+	case sampleRate >= 44100:
+		rateBits = 3
+	case sampleRate >= 22050:
+		rateBits = 2
+	case sampleRate >= 11025:
+		rateBits = 1
+	}
+	const soundSize16Bit = 1
+	const soundTypeMono = 0
+	return soundFormatLinearPCM<<4 | rateBits<<2 | soundSize16Bit<<1 | soundTypeMono
+}
+
+// WriteCaption sends text as an onTextData AMF0 script-data tag, the FLV
+// convention players use to render subtitle overlays without a video track.
+func (p *flvPipeline) WriteCaption(text, source string) error {
+	payload := encodeAMF0String("onTextData") + encodeAMF0Object(map[string]amfValue{
+		"text":   {str: text},
+		"source": {str: source},
+	})
+	return p.writeChunk(chunkTypeData, payload)
+}
+
+// Close closes the underlying RTMP connection.
+func (p *flvPipeline) Close() error {
+	return p.conn.Close()
+}
+
+func streamKeyFromPath(path string) string {
+	for len(path) > 0 && path[0] == '/' {
+		path = path[1:]
+	}
+	return path
+}
@@ -0,0 +1,46 @@
+package capture
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AudioSourceConfig configures any AudioSource implementation.
+type AudioSourceConfig struct {
+	SampleRate         int
+	BufferSize         int
+	CaptureSystemAudio bool
+	SystemAudioBackend string // "auto" (native loopback falling back to device scanning), "native", or "device"; "" behaves as "auto"
+	ExcludedDevices    []string
+	GStreamerPipeline  string // launch string for the "gstreamer" backend, must end in "appsink name=sink"
+}
+
+// NewAudioSource builds the named audio backend. Unknown names return an error.
+func NewAudioSource(backend string, cfg AudioSourceConfig) (AudioSource, error) {
+	switch backend {
+	case "", "portaudio":
+		return newPortAudioSource(cfg)
+	case "malgo":
+		return newMalgoSource(cfg)
+	case "gstreamer":
+		return newGStreamerSource(cfg)
+	default:
+		return nil, fmt.Errorf("capture: unknown audio backend %q", backend)
+	}
+}
+
+// NewRemoteAudioSources builds one RTSPSource per entry in urls, each with
+// its own circuit breaker so a flapping feed only affects itself. Entries
+// may be a bare RTSP URL (labeled "system") or "label=rtsp://..." to tag
+// frames from that source with a custom label.
+func NewRemoteAudioSources(urls []string, sampleRate int) []AudioSource {
+	sources := make([]AudioSource, 0, len(urls))
+	for _, u := range urls {
+		label, rtspURL := "system", u
+		if i := strings.IndexByte(u, '='); i >= 0 {
+			label, rtspURL = u[:i], u[i+1:]
+		}
+		sources = append(sources, newRTSPSource(rtspURL, label, sampleRate))
+	}
+	return sources
+}
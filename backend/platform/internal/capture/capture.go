@@ -0,0 +1,41 @@
+// Package capture defines backend-agnostic audio and screen capture sources.
+// Each source type has a small interface with multiple implementations
+// selected by name, so a new platform or library can be added without
+// touching the orchestrator pipeline, and the pipeline can be exercised in
+// tests against an in-memory fake instead of real hardware.
+package capture
+
+import "context"
+
+// Frame is a single unit of captured data. Audio sources populate Audio;
+// screen sources populate Image. Source identifies where the frame came
+// from (a device name, "user"/"system", or a display index). Speaker is
+// optional: sources that already know who's talking (e.g. a Mumble bridge
+// keyed by username) set it so the pipeline can skip diarization.
+type Frame struct {
+	Audio     []float32
+	Image     []byte
+	Source    string
+	Speaker   string
+	Timestamp int64
+}
+
+// SourceInfo describes a capture source for logging and diagnostics.
+type SourceInfo struct {
+	Backend string
+	Name    string
+}
+
+// AudioSource captures audio frames from one or more input devices.
+type AudioSource interface {
+	Start(ctx context.Context) (<-chan Frame, error)
+	Stop()
+	Info() SourceInfo
+}
+
+// ScreenSource captures periodic screenshot frames.
+type ScreenSource interface {
+	Start(ctx context.Context) (<-chan Frame, error)
+	Stop()
+	Info() SourceInfo
+}
@@ -0,0 +1,288 @@
+package capture
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewAudioSourceUnknownBackend(t *testing.T) {
+	if _, err := NewAudioSource("nonexistent", AudioSourceConfig{}); err == nil {
+		t.Fatal("expected error for unknown audio backend")
+	}
+}
+
+func TestNewScreenSourceUnknownBackend(t *testing.T) {
+	if _, err := NewScreenSource("nonexistent", ScreenSourceConfig{}); err == nil {
+		t.Fatal("expected error for unknown screen backend")
+	}
+}
+
+func TestFakeAudioSourceReplaysFrames(t *testing.T) {
+	want := []Frame{
+		{Audio: []float32{0.1, 0.2}, Source: "user", Timestamp: 1},
+		{Audio: []float32{0.3, 0.4}, Source: "user", Timestamp: 2},
+	}
+	src := NewFakeAudioSource(want...)
+	defer src.Stop()
+
+	out, err := src.Start(context.Background())
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	for i, expect := range want {
+		select {
+		case got := <-out:
+			if got.Timestamp != expect.Timestamp {
+				t.Errorf("frame %d timestamp = %d, want %d", i, got.Timestamp, expect.Timestamp)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for frame %d", i)
+		}
+	}
+}
+
+func TestFakeAudioSourceStopClosesChannel(t *testing.T) {
+	src := NewFakeAudioSource(Frame{Timestamp: 1})
+	out, err := src.Start(context.Background())
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	<-out // drain the one seeded frame
+
+	src.Stop()
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected channel to be closed after Stop")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for channel to close")
+	}
+}
+
+func TestNewRemoteAudioSourcesParsesLabels(t *testing.T) {
+	sources := NewRemoteAudioSources([]string{
+		"rtsp://cam.local/audio",
+		"conference-room=rtsp://cam2.local/audio",
+	}, 16000)
+
+	if len(sources) != 2 {
+		t.Fatalf("len(sources) = %d, want 2", len(sources))
+	}
+	if got := sources[0].Info().Name; got != "system" {
+		t.Errorf("unlabeled source Name = %q, want %q", got, "system")
+	}
+	if got := sources[1].Info().Name; got != "conference-room" {
+		t.Errorf("labeled source Name = %q, want %q", got, "conference-room")
+	}
+}
+
+func TestCombineAudioMergesFrames(t *testing.T) {
+	a := NewFakeAudioSource(Frame{Source: "a", Timestamp: 1})
+	b := NewFakeAudioSource(Frame{Source: "b", Timestamp: 2})
+	combined := CombineAudio(a, b)
+	defer combined.Stop()
+
+	out, err := combined.Start(context.Background())
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case f := <-out:
+			seen[f.Source] = true
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for merged frame")
+		}
+	}
+	if !seen["a"] || !seen["b"] {
+		t.Errorf("seen = %v, want both a and b", seen)
+	}
+}
+
+func TestCombineAudioStopStopsMembers(t *testing.T) {
+	a := NewFakeAudioSource()
+	combined := CombineAudio(a)
+
+	out, err := combined.Start(context.Background())
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	combined.Stop()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected merged channel to close after Stop")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for channel to close")
+	}
+}
+
+func TestFakeScreenSourceReplaysFrames(t *testing.T) {
+	want := Frame{Image: []byte("jpeg-bytes"), Timestamp: 42}
+	src := NewFakeScreenSource(want)
+	defer src.Stop()
+
+	out, err := src.Start(context.Background())
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	select {
+	case got := <-out:
+		if string(got.Image) != string(want.Image) {
+			t.Errorf("Image = %q, want %q", got.Image, want.Image)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for frame")
+	}
+}
+
+func TestFakeSourceInfoReportsFakeBackend(t *testing.T) {
+	src := NewFakeAudioSource()
+	if info := src.Info(); info.Backend != "fake" {
+		t.Errorf("Info().Backend = %q, want fake", info.Backend)
+	}
+}
+
+func TestFakeAudioSourceContextCancelClosesChannel(t *testing.T) {
+	src := NewFakeAudioSource()
+	ctx, cancel := context.WithCancel(context.Background())
+	out, err := src.Start(ctx)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected channel to be closed after context cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for channel to close")
+	}
+}
+
+func TestCombineScreenMergesFrames(t *testing.T) {
+	a := NewFakeScreenSource(Frame{Image: []byte("a"), Timestamp: 1})
+	b := NewFakeScreenSource(Frame{Image: []byte("b"), Timestamp: 2})
+	combined := CombineScreen(a, b)
+	defer combined.Stop()
+
+	out, err := combined.Start(context.Background())
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case f := <-out:
+			seen[string(f.Image)] = true
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for merged frame")
+		}
+	}
+	if !seen["a"] || !seen["b"] {
+		t.Errorf("seen = %v, want both a and b", seen)
+	}
+}
+
+// writeTestWAV writes a minimal 16-bit mono PCM WAV file for readWAV tests.
+func writeTestWAV(t *testing.T, samples []int16, sampleRate int) string {
+	t.Helper()
+	dataBytes := make([]byte, len(samples)*2)
+	for i, s := range samples {
+		binary.LittleEndian.PutUint16(dataBytes[i*2:], uint16(s))
+	}
+
+	var buf []byte
+	buf = append(buf, []byte("RIFF")...)
+	buf = append(buf, make([]byte, 4)...) // riff size, filled below
+	buf = append(buf, []byte("WAVE")...)
+
+	buf = append(buf, []byte("fmt ")...)
+	buf = binary.LittleEndian.AppendUint32(buf, 16)
+	buf = binary.LittleEndian.AppendUint16(buf, 1) // PCM
+	buf = binary.LittleEndian.AppendUint16(buf, 1) // mono
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(sampleRate))
+	byteRate := uint32(sampleRate * 2)
+	buf = binary.LittleEndian.AppendUint32(buf, byteRate)
+	buf = binary.LittleEndian.AppendUint16(buf, 2)  // block align
+	buf = binary.LittleEndian.AppendUint16(buf, 16) // bits per sample
+
+	buf = append(buf, []byte("data")...)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(dataBytes)))
+	buf = append(buf, dataBytes...)
+
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(buf)-8))
+
+	path := filepath.Join(t.TempDir(), "test.wav")
+	if err := os.WriteFile(path, buf, 0o644); err != nil {
+		t.Fatalf("write test wav: %v", err)
+	}
+	return path
+}
+
+func TestReadWAVDecodesSamples(t *testing.T) {
+	path := writeTestWAV(t, []int16{0, 16384, -16384, 32767}, 16000)
+
+	samples, sampleRate, err := readWAV(path)
+	if err != nil {
+		t.Fatalf("readWAV: %v", err)
+	}
+	if sampleRate != 16000 {
+		t.Errorf("sampleRate = %d, want 16000", sampleRate)
+	}
+	if len(samples) != 4 {
+		t.Fatalf("len(samples) = %d, want 4", len(samples))
+	}
+	if samples[0] != 0 {
+		t.Errorf("samples[0] = %v, want 0", samples[0])
+	}
+	if samples[1] <= 0 {
+		t.Errorf("samples[1] = %v, want > 0", samples[1])
+	}
+}
+
+func TestNewFileAudioSourceReplaysSamples(t *testing.T) {
+	path := writeTestWAV(t, []int16{0, 16384, -16384, 32767}, 16000)
+
+	src, err := NewFileAudioSource(path, "recording", 2)
+	if err != nil {
+		t.Fatalf("NewFileAudioSource: %v", err)
+	}
+	defer src.Stop()
+
+	out, err := src.Start(context.Background())
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	var total int
+	for frame := range out {
+		total += len(frame.Audio)
+		if frame.Source != "recording" {
+			t.Errorf("frame.Source = %q, want %q", frame.Source, "recording")
+		}
+	}
+	if total != 4 {
+		t.Errorf("total samples received = %d, want 4", total)
+	}
+}
+
+func TestNewFileAudioSourceMissingFile(t *testing.T) {
+	if _, err := NewFileAudioSource(filepath.Join(t.TempDir(), "missing.wav"), "x", 1); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
@@ -0,0 +1,70 @@
+package capture
+
+import "context"
+
+// fakeSource replays a fixed set of Frames and never touches real hardware;
+// it backs both FakeAudioSource and FakeScreenSource.
+type fakeSource struct {
+	backend string
+	frames  []Frame
+	out     chan Frame
+	stopped chan struct{}
+}
+
+func newFakeSource(backend string, frames []Frame) *fakeSource {
+	return &fakeSource{backend: backend, frames: frames}
+}
+
+func (f *fakeSource) Start(ctx context.Context) (<-chan Frame, error) {
+	f.out = make(chan Frame, len(f.frames))
+	f.stopped = make(chan struct{})
+	for _, frame := range f.frames {
+		f.out <- frame
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-f.stopped:
+		}
+		close(f.out)
+	}()
+
+	return f.out, nil
+}
+
+func (f *fakeSource) Stop() {
+	select {
+	case <-f.stopped:
+	default:
+		close(f.stopped)
+	}
+}
+
+func (f *fakeSource) Info() SourceInfo {
+	return SourceInfo{Backend: f.backend, Name: f.backend}
+}
+
+// FakeAudioSource is an in-memory AudioSource for tests: it replays the
+// Frames it was constructed with, then blocks until Stop or context
+// cancellation.
+type FakeAudioSource struct {
+	*fakeSource
+}
+
+// NewFakeAudioSource returns a FakeAudioSource that replays frames in order.
+func NewFakeAudioSource(frames ...Frame) *FakeAudioSource {
+	return &FakeAudioSource{fakeSource: newFakeSource("fake", frames)}
+}
+
+// FakeScreenSource is an in-memory ScreenSource for tests: it replays the
+// Frames it was constructed with, then blocks until Stop or context
+// cancellation.
+type FakeScreenSource struct {
+	*fakeSource
+}
+
+// NewFakeScreenSource returns a FakeScreenSource that replays frames in order.
+func NewFakeScreenSource(frames ...Frame) *FakeScreenSource {
+	return &FakeScreenSource{fakeSource: newFakeSource("fake", frames)}
+}
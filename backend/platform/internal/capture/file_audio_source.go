@@ -0,0 +1,161 @@
+package capture
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"time"
+)
+
+// FileAudioSource replays PCM audio decoded from a 16-bit WAV file as
+// Frames, chunked and paced to roughly match real-time playback. It exists
+// for deterministic test replay of recorded meetings/calls without touching
+// real hardware or a live RTSP feed.
+//
+// Only 16-bit PCM WAV is supported; multi-channel files are downmixed to
+// mono by averaging channels. MP4/AAC file replay is not implemented here:
+// it would need a container demuxer and AAC decoder this repo doesn't
+// otherwise depend on, so it's left for a future pass (see the similar
+// "AAC tracks are not yet supported" note on RTSPSource).
+type FileAudioSource struct {
+	path       string
+	label      string
+	chunkSize  int
+	samples    []float32
+	sampleRate int
+	stopped    chan struct{}
+}
+
+// NewFileAudioSource decodes the WAV file at path and returns an AudioSource
+// that replays it as Frames labeled with label, chunkSize samples at a time.
+func NewFileAudioSource(path, label string, chunkSize int) (*FileAudioSource, error) {
+	samples, sampleRate, err := readWAV(path)
+	if err != nil {
+		return nil, fmt.Errorf("capture: read wav %s: %w", path, err)
+	}
+	return &FileAudioSource{path: path, label: label, chunkSize: chunkSize, samples: samples, sampleRate: sampleRate}, nil
+}
+
+// Start begins replaying the decoded samples as Frames, pacing delivery to
+// roughly match the file's sample rate.
+func (f *FileAudioSource) Start(ctx context.Context) (<-chan Frame, error) {
+	f.stopped = make(chan struct{})
+	out := make(chan Frame)
+
+	interval := time.Duration(float64(f.chunkSize) / float64(f.sampleRate) * float64(time.Second))
+
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for i := 0; i < len(f.samples); i += f.chunkSize {
+			end := i + f.chunkSize
+			if end > len(f.samples) {
+				end = len(f.samples)
+			}
+			frame := Frame{Audio: f.samples[i:end], Source: f.label, Timestamp: time.Now().UnixNano()}
+
+			select {
+			case out <- frame:
+			case <-ctx.Done():
+				return
+			case <-f.stopped:
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			case <-f.stopped:
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Stop ends playback before the file is fully replayed.
+func (f *FileAudioSource) Stop() {
+	if f.stopped == nil {
+		return
+	}
+	select {
+	case <-f.stopped:
+	default:
+		close(f.stopped)
+	}
+}
+
+// Info identifies this as the file backend and names the source file.
+func (f *FileAudioSource) Info() SourceInfo {
+	return SourceInfo{Backend: "file", Name: f.path}
+}
+
+// readWAV parses a canonical RIFF/WAVE file into mono float32 samples in
+// [-1, 1] and its sample rate.
+func readWAV(path string) ([]float32, int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return nil, 0, fmt.Errorf("not a RIFF/WAVE file")
+	}
+
+	var (
+		sampleRate    int
+		channels      int
+		bitsPerSample int
+		pcm           []byte
+	)
+
+	for offset := 12; offset+8 <= len(data); {
+		chunkID := string(data[offset : offset+4])
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4 : offset+8]))
+		body := offset + 8
+		if chunkSize < 0 || body+chunkSize > len(data) {
+			break
+		}
+
+		switch chunkID {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, 0, fmt.Errorf("fmt chunk too short")
+			}
+			channels = int(binary.LittleEndian.Uint16(data[body+2 : body+4]))
+			sampleRate = int(binary.LittleEndian.Uint32(data[body+4 : body+8]))
+			bitsPerSample = int(binary.LittleEndian.Uint16(data[body+14 : body+16]))
+		case "data":
+			pcm = data[body : body+chunkSize]
+		}
+
+		offset = body + chunkSize
+		if chunkSize%2 == 1 { // chunks are word-aligned
+			offset++
+		}
+	}
+
+	if bitsPerSample != 16 {
+		return nil, 0, fmt.Errorf("only 16-bit PCM WAV is supported, got %d-bit", bitsPerSample)
+	}
+	if channels < 1 {
+		return nil, 0, fmt.Errorf("invalid channel count %d", channels)
+	}
+
+	frameCount := len(pcm) / (2 * channels)
+	samples := make([]float32, frameCount)
+	for i := 0; i < frameCount; i++ {
+		var sum int32
+		for c := 0; c < channels; c++ {
+			idx := (i*channels + c) * 2
+			sum += int32(int16(binary.LittleEndian.Uint16(pcm[idx : idx+2])))
+		}
+		samples[i] = float32(sum) / float32(channels) / 32768.0
+	}
+
+	return samples, sampleRate, nil
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+
+package capture
+
+import "fmt"
+
+// newGStreamerSource returns an error; the gstreamer backend depends on
+// PulseAudio/PipeWire pipeline elements that are only meaningful on Linux.
+func newGStreamerSource(cfg AudioSourceConfig) (AudioSource, error) {
+	return nil, fmt.Errorf("capture: gstreamer backend is only supported on linux")
+}
@@ -0,0 +1,125 @@
+//go:build linux
+
+package icy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/tinyzimmer/go-gst/gst"
+	"github.com/tinyzimmer/go-gst/gst/app"
+)
+
+const (
+	icyAppSrcName  = "src"
+	icyAppSinkName = "sink"
+)
+
+// decoder runs a GStreamer pipeline that decodes a pushed MP3/AAC byte
+// stream to mono F32LE PCM, mirroring capture.GStreamerSource's
+// appsink-pulling convention but driven by an appsrc instead of a live
+// capture device, since ICY audio arrives as plain bytes read off an HTTP
+// response rather than from a GStreamer source element.
+type decoder struct {
+	pipeline *gst.Pipeline
+	src      *app.Source
+	sink     *app.Sink
+}
+
+// newDecoder builds and starts a pipeline decoding contentType
+// ("audio/mpeg" or "audio/aac") to mono PCM at sampleRate.
+func newDecoder(contentType string, sampleRate int) (*decoder, error) {
+	gst.Init(nil)
+
+	decodeChain, err := decodeChainFor(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineStr := fmt.Sprintf(
+		"appsrc name=%s format=time is-live=true ! %s ! audioconvert ! audioresample ! "+
+			"audio/x-raw,format=F32LE,channels=1,rate=%d ! appsink name=%s",
+		icyAppSrcName, decodeChain, sampleRate, icyAppSinkName,
+	)
+
+	pipeline, err := gst.NewPipelineFromString(pipelineStr)
+	if err != nil {
+		return nil, fmt.Errorf("icy: parse decode pipeline: %w", err)
+	}
+
+	srcElem, err := pipeline.GetElementByName(icyAppSrcName)
+	if err != nil {
+		return nil, fmt.Errorf("icy: decode pipeline has no element named %q: %w", icyAppSrcName, err)
+	}
+	sinkElem, err := pipeline.GetElementByName(icyAppSinkName)
+	if err != nil {
+		return nil, fmt.Errorf("icy: decode pipeline has no element named %q: %w", icyAppSinkName, err)
+	}
+
+	if err := pipeline.Start(); err != nil {
+		return nil, fmt.Errorf("icy: start decode pipeline: %w", err)
+	}
+
+	return &decoder{
+		pipeline: pipeline,
+		src:      app.SrcFromElement(srcElem),
+		sink:     app.SinkFromElement(sinkElem),
+	}, nil
+}
+
+// decodeChainFor returns the GStreamer elements that parse and decode raw
+// bytes of contentType into an unparsed PCM buffer, ahead of the shared
+// audioconvert/audioresample/caps tail every content type feeds into.
+func decodeChainFor(contentType string) (string, error) {
+	switch contentType {
+	case "audio/mpeg", "audio/mp3":
+		return "mpegaudioparse ! mpg123audiodec", nil
+	case "audio/aac", "audio/aacp":
+		return "aacparse ! avdec_aac", nil
+	default:
+		return "", fmt.Errorf("icy: unsupported content type %q", contentType)
+	}
+}
+
+// push writes a chunk of encoded audio bytes into the pipeline.
+func (d *decoder) push(data []byte) error {
+	buf := gst.NewBufferFromBytes(data)
+	if ret := d.src.PushBuffer(buf); ret != gst.FlowOK {
+		return fmt.Errorf("icy: push buffer: %v", ret)
+	}
+	return nil
+}
+
+// eos signals end-of-stream so the pipeline flushes any buffered audio
+// still waiting on pull.
+func (d *decoder) eos() {
+	d.src.EndStream()
+}
+
+// pull blocks for up to timeout for the next decoded PCM window, returning
+// nil if none arrived (end-of-stream or timeout).
+func (d *decoder) pull(timeout time.Duration) []float32 {
+	sample := d.sink.TryPullSample(timeout)
+	if sample == nil {
+		return nil
+	}
+	return bytesToFloat32(sample.GetBuffer().Bytes())
+}
+
+// close tears down the pipeline and releases its resources.
+func (d *decoder) close() {
+	_ = d.pipeline.SetState(gst.StateNull)
+}
+
+// bytesToFloat32 decodes a little-endian F32LE buffer, the format the
+// appsink is expected to be negotiated to via the pipeline's caps filter.
+func bytesToFloat32(b []byte) []float32 {
+	out := make([]float32, len(b)/4)
+	for i := range out {
+		bits := binary.LittleEndian.Uint32(b[i*4 : i*4+4])
+		out[i] = math.Float32frombits(bits)
+	}
+	return out
+}
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package icy
+
+import (
+	"fmt"
+	"time"
+)
+
+// decoder is a stub on non-Linux platforms; see decode.go. ICY decoding
+// depends on the same GStreamer pipeline elements as capture.GStreamerSource,
+// which are only meaningful on Linux.
+type decoder struct{}
+
+func newDecoder(contentType string, sampleRate int) (*decoder, error) {
+	return nil, fmt.Errorf("icy: stream decoding is only supported on linux")
+}
+
+func (d *decoder) push(data []byte) error               { return fmt.Errorf("icy: decoder unavailable") }
+func (d *decoder) eos()                                 {}
+func (d *decoder) pull(timeout time.Duration) []float32 { return nil }
+func (d *decoder) close()                               {}
@@ -0,0 +1,98 @@
+package icy
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// metadataReader wraps an ICY/Shoutcast audio body, stripping the metadata
+// blocks the server interleaves every metaint bytes of audio: one length
+// byte (in units of 16 bytes), then that many bytes of
+// "StreamTitle='...';..." key-value metadata. Read returns only the audio
+// bytes; onTitle, when non-nil, is called once per StreamTitle value that
+// differs from the last one seen, so callers aren't spammed by a server
+// that repeats the same title every block.
+type metadataReader struct {
+	r       *bufio.Reader
+	metaint int
+	onTitle func(title string)
+
+	remaining int // audio bytes left before the next metadata block
+	lastTitle string
+}
+
+// newMetadataReader wraps r, an ICY stream body, using metaint (the value
+// of the icy-metaint response header). metaint <= 0 means the server isn't
+// sending interleaved metadata, so Read passes bytes through unchanged.
+func newMetadataReader(r io.Reader, metaint int, onTitle func(title string)) *metadataReader {
+	return &metadataReader{r: bufio.NewReader(r), metaint: metaint, onTitle: onTitle, remaining: metaint}
+}
+
+// Read implements io.Reader, returning only audio bytes.
+func (m *metadataReader) Read(p []byte) (int, error) {
+	if m.metaint <= 0 {
+		return m.r.Read(p)
+	}
+
+	if m.remaining == 0 {
+		if err := m.readMetadataBlock(); err != nil {
+			return 0, err
+		}
+		m.remaining = m.metaint
+	}
+
+	if len(p) > m.remaining {
+		p = p[:m.remaining]
+	}
+	n, err := m.r.Read(p)
+	m.remaining -= n
+	return n, err
+}
+
+// readMetadataBlock reads one length-prefixed metadata block and, if it
+// carries a new StreamTitle, reports it via onTitle.
+func (m *metadataReader) readMetadataBlock() error {
+	lenByte, err := m.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	n := int(lenByte) * 16
+	if n == 0 {
+		return nil
+	}
+
+	block := make([]byte, n)
+	if _, err := io.ReadFull(m.r, block); err != nil {
+		return err
+	}
+
+	title := parseStreamTitle(block)
+	if title != "" && title != m.lastTitle {
+		m.lastTitle = title
+		if m.onTitle != nil {
+			m.onTitle(title)
+		}
+	}
+	return nil
+}
+
+// parseStreamTitle extracts the value of StreamTitle='...' from a raw ICY
+// metadata block, which is NUL-padded out to a multiple of 16 bytes.
+// Returns "" if the block has no StreamTitle key.
+func parseStreamTitle(block []byte) string {
+	block = bytes.TrimRight(block, "\x00")
+
+	const key = "StreamTitle='"
+	start := bytes.Index(block, []byte(key))
+	if start < 0 {
+		return ""
+	}
+	start += len(key)
+
+	end := bytes.Index(block[start:], []byte("';"))
+	if end < 0 {
+		return ""
+	}
+	return string(block[start : start+end])
+}
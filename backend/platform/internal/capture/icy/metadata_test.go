@@ -0,0 +1,123 @@
+package icy
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+// icyBlock builds one length-prefixed ICY metadata block for raw.
+func icyBlock(raw string) []byte {
+	n := (len(raw) + 15) / 16 * 16
+	padded := append([]byte(raw), make([]byte, n-len(raw))...)
+	return append([]byte{byte(n / 16)}, padded...)
+}
+
+func TestMetadataReaderStripsInterleavedBlocks(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString("AUDIO1")
+	buf.Write(icyBlock("StreamTitle='Song One';"))
+	buf.WriteString("AUDIO2")
+	buf.Write(icyBlock("StreamTitle='Song Two';"))
+	buf.WriteString("AUDIO3")
+
+	var titles []string
+	r := newMetadataReader(&buf, 6, func(title string) { titles = append(titles, title) })
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != "AUDIO1AUDIO2AUDIO3" {
+		t.Errorf("audio = %q, want %q", got, "AUDIO1AUDIO2AUDIO3")
+	}
+	if want := []string{"Song One", "Song Two"}; !equalStrings(titles, want) {
+		t.Errorf("titles = %v, want %v", titles, want)
+	}
+}
+
+func TestMetadataReaderSkipsUnchangedTitle(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString("AUDIO1")
+	buf.Write(icyBlock("StreamTitle='Same Song';"))
+	buf.WriteString("AUDIO2")
+	buf.Write(icyBlock("StreamTitle='Same Song';"))
+	buf.WriteString("AUDIO3")
+
+	var titles []string
+	r := newMetadataReader(&buf, 6, func(title string) { titles = append(titles, title) })
+
+	if _, err := io.ReadAll(r); err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if want := []string{"Same Song"}; !equalStrings(titles, want) {
+		t.Errorf("titles = %v, want %v (no duplicate callback for an unchanged title)", titles, want)
+	}
+}
+
+func TestMetadataReaderZeroLengthBlock(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString("AUDIO1")
+	buf.WriteByte(0) // zero-length block: no metadata this interval
+	buf.WriteString("AUDIO2")
+
+	called := false
+	r := newMetadataReader(&buf, 6, func(title string) { called = true })
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != "AUDIO1AUDIO2" {
+		t.Errorf("audio = %q, want %q", got, "AUDIO1AUDIO2")
+	}
+	if called {
+		t.Error("onTitle should not be called for a zero-length metadata block")
+	}
+}
+
+func TestMetadataReaderPassthroughWithoutMetaint(t *testing.T) {
+	r := newMetadataReader(bytes.NewReader([]byte("plain audio bytes")), 0, func(string) {
+		t.Error("onTitle should never be called when metaint is 0")
+	})
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != "plain audio bytes" {
+		t.Errorf("audio = %q, want passthrough", got)
+	}
+}
+
+func TestParseStreamTitle(t *testing.T) {
+	cases := []struct {
+		name  string
+		block []byte
+		want  string
+	}{
+		{"basic", []byte("StreamTitle='Artist - Track';"), "Artist - Track"},
+		{"with trailing fields", []byte("StreamTitle='Show Name';StreamUrl='http://example.com';"), "Show Name"},
+		{"no key", []byte("NotMetadata"), ""},
+		{"nul padded", append([]byte("StreamTitle='Padded';"), make([]byte, 10)...), "Padded"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := parseStreamTitle(c.block); got != c.want {
+				t.Errorf("parseStreamTitle(%q) = %q, want %q", c.block, got, c.want)
+			}
+		})
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
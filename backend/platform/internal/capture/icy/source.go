@@ -0,0 +1,112 @@
+// Package icy implements an ICY/Shoutcast HTTP audio source: it connects to
+// a remote internet-radio-style stream, decodes its MP3/AAC payload to mono
+// PCM, and reports in-band track metadata (StreamTitle) as it changes, so a
+// station can be transcribed the same way a local microphone is.
+package icy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+)
+
+const streamFrameBuffer = 32 // buffered frames per Source before a slow consumer stalls senders
+
+// MetaHandler is called with a stream's url and its latest StreamTitle
+// whenever that stream's in-band metadata changes.
+type MetaHandler func(url, title string)
+
+// Source pulls zero or more ICY/Shoutcast streams and emits their decoded
+// audio as capture.Frames, so it can be combined with local/RTSP/WHIP
+// sources through the AudioSource interface. Streams are added and removed
+// at runtime via Add/Remove (e.g. from a REST handler), the way
+// whip.Source accepts sessions after Start has already returned its
+// channel: each stream gets its own device ID (its url), so downstream
+// VAD/diarization keeps them separate.
+type Source struct {
+	sampleRate int
+	onMeta     MetaHandler
+
+	mu       sync.Mutex
+	ctx      context.Context
+	streams  map[string]*stream
+	out      chan capture.Frame
+	stopOnce sync.Once
+}
+
+// NewSource returns a Source ready to decode streams at sampleRate (mono).
+// onMeta may be nil to ignore in-band metadata.
+func NewSource(sampleRate int, onMeta MetaHandler) *Source {
+	return &Source{
+		sampleRate: sampleRate,
+		onMeta:     onMeta,
+		streams:    make(map[string]*stream),
+		out:        make(chan capture.Frame, streamFrameBuffer),
+	}
+}
+
+// Start returns the channel every current and future stream's audio is
+// published to. No stream is connected until Add is called, so the
+// returned channel may sit idle until then.
+func (s *Source) Start(ctx context.Context) (<-chan capture.Frame, error) {
+	s.mu.Lock()
+	s.ctx = ctx
+	s.mu.Unlock()
+	return s.out, nil
+}
+
+// Stop disconnects every active stream and closes the output channel.
+func (s *Source) Stop() {
+	s.stopOnce.Do(func() {
+		s.mu.Lock()
+		for url, st := range s.streams {
+			st.close()
+			delete(s.streams, url)
+		}
+		s.mu.Unlock()
+		close(s.out)
+	})
+}
+
+// Info identifies this as the icy backend.
+func (s *Source) Info() capture.SourceInfo {
+	return capture.SourceInfo{Backend: "icy", Name: "icy"}
+}
+
+// Add connects to url in the background and begins publishing its decoded
+// audio. It returns an error if url is already connected or Start hasn't
+// run yet.
+func (s *Source) Add(url string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ctx == nil {
+		return fmt.Errorf("icy: source not started")
+	}
+	if _, ok := s.streams[url]; ok {
+		return fmt.Errorf("icy: %q is already connected", url)
+	}
+
+	st := newStream(url, s.sampleRate, s.onMeta)
+	s.streams[url] = st
+	st.start(s.ctx, s.out)
+	return nil
+}
+
+// Remove disconnects url. It returns an error if url isn't connected.
+func (s *Source) Remove(url string) error {
+	s.mu.Lock()
+	st, ok := s.streams[url]
+	if ok {
+		delete(s.streams, url)
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("icy: %q is not connected", url)
+	}
+	st.close()
+	return nil
+}
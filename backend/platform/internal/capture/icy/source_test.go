@@ -0,0 +1,48 @@
+package icy
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAddBeforeStartFails(t *testing.T) {
+	s := NewSource(16000, nil)
+	if err := s.Add("http://127.0.0.1:1/stream"); err == nil {
+		t.Error("Add before Start should fail")
+	}
+}
+
+func TestAddRejectsDuplicateURL(t *testing.T) {
+	s := NewSource(16000, nil)
+	defer s.Stop()
+	if _, err := s.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	const url = "http://127.0.0.1:1/stream" // nothing listens here: the reconnect loop fails fast and backs off
+	if err := s.Add(url); err != nil {
+		t.Fatalf("first Add: %v", err)
+	}
+	if err := s.Add(url); err == nil {
+		t.Error("Add should reject a URL that's already connected")
+	}
+}
+
+func TestRemoveUnknownURLFails(t *testing.T) {
+	s := NewSource(16000, nil)
+	defer s.Stop()
+	if _, err := s.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	if err := s.Remove("http://127.0.0.1:1/does-not-exist"); err == nil {
+		t.Error("Remove should fail for a URL that was never added")
+	}
+}
+
+func TestInfoIdentifiesBackend(t *testing.T) {
+	s := NewSource(16000, nil)
+	if got := s.Info(); got.Backend != "icy" {
+		t.Errorf("Backend = %q, want icy", got.Backend)
+	}
+}
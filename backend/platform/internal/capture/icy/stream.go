@@ -0,0 +1,172 @@
+package icy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+const (
+	icyPushBufferSize = 4096 // bytes read off the HTTP response per pumpAudio iteration
+	icyPullTimeout    = 500 * time.Millisecond
+)
+
+// stream manages one ICY connection: a reconnect loop (mirroring
+// capture.RTSPSource's run/streamOnce) that dials url, strips interleaved
+// metadata, decodes the remaining MP3/AAC bytes to PCM, and publishes
+// Frames tagged with url as Source, so downstream VAD/diarization keeps
+// each station separate the way it does for RTSP and WHIP sources.
+type stream struct {
+	url        string
+	sampleRate int
+	onMeta     MetaHandler
+	breaker    *resilience.Breaker
+
+	cancel   context.CancelFunc
+	stopOnce sync.Once
+}
+
+func newStream(url string, sampleRate int, onMeta MetaHandler) *stream {
+	return &stream{
+		url:        url,
+		sampleRate: sampleRate,
+		onMeta:     onMeta,
+		breaker:    resilience.New(resilience.DefaultConfig()),
+	}
+}
+
+// start connects in the background and begins decoding audio into out.
+func (s *stream) start(ctx context.Context, out chan<- capture.Frame) {
+	runCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	go s.run(runCtx, out)
+}
+
+// close cancels the background connection loop. Safe to call more than once.
+func (s *stream) close() {
+	s.stopOnce.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+		}
+	})
+}
+
+func (s *stream) run(ctx context.Context, out chan<- capture.Frame) {
+	for ctx.Err() == nil {
+		err := s.breaker.Execute(func() error { return s.streamOnce(ctx, out) })
+		switch {
+		case ctx.Err() != nil:
+			return
+		case errors.Is(err, resilience.ErrOpen):
+			slog.Debug("icy source breaker open, backing off", "url", s.url)
+		case errors.Is(err, resilience.ErrPermanent):
+			slog.Warn("icy source failed permanently, not retrying", "url", s.url, "error", err)
+			return
+		case err != nil:
+			slog.Warn("icy stream ended, reconnecting", "url", s.url, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// streamOnce connects, negotiates ICY metadata, and decodes audio into out
+// until the connection drops or ctx is canceled.
+func (s *stream) streamOnce(ctx context.Context, out chan<- capture.Frame) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return fmt.Errorf("%w: parse icy url %q: %v", resilience.ErrPermanent, s.url, err)
+	}
+	req.Header.Set("Icy-MetaData", "1")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("connect to %q: %w", s.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %q returned status %d", resilience.ErrPermanent, s.url, resp.StatusCode)
+	}
+
+	dec, err := newDecoder(resp.Header.Get("Content-Type"), s.sampleRate)
+	if err != nil {
+		return fmt.Errorf("%w: %v", resilience.ErrPermanent, err)
+	}
+	defer dec.close()
+
+	metaint, _ := strconv.Atoi(resp.Header.Get("icy-metaint"))
+	body := newMetadataReader(resp.Body, metaint, func(title string) {
+		if s.onMeta != nil {
+			s.onMeta(s.url, title)
+		}
+	})
+
+	pumpDone := make(chan error, 1)
+	go func() { pumpDone <- pumpAudio(ctx, body, dec) }()
+
+	for {
+		select {
+		case err := <-pumpDone:
+			for pcm := dec.pull(icyPullTimeout); pcm != nil; pcm = dec.pull(icyPullTimeout) {
+				s.emit(ctx, out, pcm)
+			}
+			return err
+		default:
+		}
+
+		if pcm := dec.pull(icyPullTimeout); pcm != nil {
+			s.emit(ctx, out, pcm)
+		}
+	}
+}
+
+// pumpAudio copies body's audio bytes into dec until EOF, a read error, or
+// ctx is done, then signals end-of-stream so the decoder flushes whatever
+// it still has buffered.
+func pumpAudio(ctx context.Context, body io.Reader, dec *decoder) error {
+	buf := make([]byte, icyPushBufferSize)
+	for {
+		if ctx.Err() != nil {
+			dec.eos()
+			return nil
+		}
+
+		n, err := body.Read(buf)
+		if n > 0 {
+			if pushErr := dec.push(buf[:n]); pushErr != nil {
+				return pushErr
+			}
+		}
+		if err != nil {
+			dec.eos()
+			if err == io.EOF {
+				return io.EOF
+			}
+			return err
+		}
+	}
+}
+
+// emit publishes pcm as a Frame tagged with s.url, the way RTSPSource and
+// whip.session tag frames with their own source label.
+func (s *stream) emit(ctx context.Context, out chan<- capture.Frame, pcm []float32) {
+	frame := capture.Frame{Audio: pcm, Source: s.url, Timestamp: time.Now().UnixNano()}
+	select {
+	case out <- frame:
+	case <-ctx.Done():
+	}
+}
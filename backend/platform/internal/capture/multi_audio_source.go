@@ -0,0 +1,71 @@
+package capture
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// multiAudioSource fans Frames from several AudioSources into a single
+// channel, so the orchestrator can treat any mix of local and remote
+// sources as one AudioSource.
+type multiAudioSource struct {
+	sources []AudioSource
+}
+
+// CombineAudio composes several AudioSources into one. Starting it starts
+// every member; stopping it stops every member.
+func CombineAudio(sources ...AudioSource) AudioSource {
+	return &multiAudioSource{sources: sources}
+}
+
+// Start starts every member source and merges their Frame channels. If a
+// member fails to start, the sources already started are stopped and the
+// error is returned.
+func (m *multiAudioSource) Start(ctx context.Context) (<-chan Frame, error) {
+	out := make(chan Frame)
+	var wg sync.WaitGroup
+
+	started := make([]AudioSource, 0, len(m.sources))
+	for _, s := range m.sources {
+		ch, err := s.Start(ctx)
+		if err != nil {
+			for _, d := range started {
+				d.Stop()
+			}
+			return nil, fmt.Errorf("capture: start %s: %w", s.Info().Name, err)
+		}
+
+		started = append(started, s)
+		wg.Add(1)
+		go func(ch <-chan Frame) {
+			defer wg.Done()
+			for frame := range ch {
+				select {
+				case out <- frame:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out, nil
+}
+
+// Stop stops every member source.
+func (m *multiAudioSource) Stop() {
+	for _, s := range m.sources {
+		s.Stop()
+	}
+}
+
+// Info summarizes the composed sources for logging and diagnostics.
+func (m *multiAudioSource) Info() SourceInfo {
+	return SourceInfo{Backend: "multi", Name: fmt.Sprintf("%d sources", len(m.sources))}
+}
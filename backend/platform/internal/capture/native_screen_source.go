@@ -0,0 +1,70 @@
+package capture
+
+import (
+	"context"
+	"time"
+
+	screencap "github.com/GriffinCanCode/good-listener/backend/platform/internal/screen"
+)
+
+// NativeScreenSource wraps the existing per-OS Capturer (the screencapture
+// CLI on macOS, X11/Wayland on Linux, DXGI/GDI on Windows), reusing its
+// hash-based change detection so unchanged frames aren't forwarded.
+type NativeScreenSource struct {
+	cfg    ScreenSourceConfig
+	cap    screencap.Capturer
+	cancel context.CancelFunc
+}
+
+func newNativeScreenSource(cfg ScreenSourceConfig) *NativeScreenSource {
+	return &NativeScreenSource{cfg: cfg, cap: screencap.New()}
+}
+
+// Start begins polling the native capturer on a ticker and forwards frames
+// whenever the screen content changes.
+func (n *NativeScreenSource) Start(ctx context.Context) (<-chan Frame, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	n.cancel = cancel
+
+	rate := n.cfg.CaptureRate
+	if rate <= 0 {
+		rate = 1.0
+	}
+
+	out := make(chan Frame)
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				data, changed := n.cap.Capture()
+				if !changed {
+					continue
+				}
+				select {
+				case out <- Frame{Image: data, Timestamp: time.Now().UnixNano()}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// Stop cancels the polling loop and closes the underlying capturer.
+func (n *NativeScreenSource) Stop() {
+	if n.cancel != nil {
+		n.cancel()
+	}
+	n.cap.Close()
+}
+
+// Info identifies this as the native backend.
+func (n *NativeScreenSource) Info() SourceInfo {
+	return SourceInfo{Backend: "native", Name: "native"}
+}
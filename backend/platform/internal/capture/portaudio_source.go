@@ -0,0 +1,46 @@
+package capture
+
+import (
+	"context"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio"
+)
+
+// PortAudioSource adapts the existing PortAudio-backed Capturer, which
+// already selects CoreAudio/WASAPI/ALSA through PortAudio's host API, to the
+// AudioSource interface.
+type PortAudioSource struct {
+	cap *audio.Capturer
+}
+
+func newPortAudioSource(cfg AudioSourceConfig) (*PortAudioSource, error) {
+	cap, err := audio.NewCapturer(cfg.SampleRate, cfg.BufferSize, cfg.CaptureSystemAudio, cfg.SystemAudioBackend, cfg.ExcludedDevices)
+	if err != nil {
+		return nil, err
+	}
+	return &PortAudioSource{cap: cap}, nil
+}
+
+// Start begins capture and relays chunks from the underlying Capturer as Frames.
+func (p *PortAudioSource) Start(ctx context.Context) (<-chan Frame, error) {
+	if err := p.cap.Start(ctx); err != nil {
+		return nil, err
+	}
+
+	out := make(chan Frame)
+	go func() {
+		defer close(out)
+		for chunk := range p.cap.Output() {
+			out <- Frame{Audio: chunk.Data, Source: chunk.Source, Timestamp: chunk.Timestamp}
+		}
+	}()
+	return out, nil
+}
+
+// Stop stops all devices and releases PortAudio.
+func (p *PortAudioSource) Stop() { p.cap.Stop() }
+
+// Info identifies this as the portaudio backend.
+func (p *PortAudioSource) Info() SourceInfo {
+	return SourceInfo{Backend: "portaudio", Name: "PortAudio"}
+}
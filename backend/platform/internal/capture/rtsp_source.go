@@ -0,0 +1,171 @@
+package capture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+	"github.com/bluenviron/gortsplib/v4"
+	"github.com/bluenviron/gortsplib/v4/pkg/base"
+	"github.com/bluenviron/gortsplib/v4/pkg/format"
+	"github.com/hraban/opus"
+	"github.com/pion/rtp"
+)
+
+// RTSPSource pulls Opus-encoded audio from a remote RTSP stream (a
+// networked camera, room mic, or similar feed) and decodes it to mono
+// PCM float32, so it can be composed alongside local devices through the
+// AudioSource interface. Each source owns its own circuit breaker, so a
+// flapping connection backs off independently instead of disrupting
+// other sources.
+//
+// AAC tracks are not yet supported: a stream without an Opus track
+// returns a permanent error from Start's background connect loop.
+type RTSPSource struct {
+	url        string
+	label      string
+	sampleRate int
+	breaker    *resilience.Breaker
+
+	cancel   context.CancelFunc
+	stopOnce sync.Once
+}
+
+func newRTSPSource(url, label string, sampleRate int) *RTSPSource {
+	return &RTSPSource{
+		url:        url,
+		label:      label,
+		sampleRate: sampleRate,
+		breaker:    resilience.New(resilience.DefaultConfig()),
+	}
+}
+
+// Start connects to the stream in the background and begins decoding its
+// Opus audio track into Frames. It never blocks on remote connectivity:
+// a stream that's unreachable keeps retrying behind the circuit breaker
+// without affecting the returned channel or other sources.
+func (r *RTSPSource) Start(ctx context.Context) (<-chan Frame, error) {
+	runCtx, cancel := context.WithCancel(ctx)
+	r.cancel = cancel
+
+	out := make(chan Frame)
+	go r.run(runCtx, out)
+	return out, nil
+}
+
+// Stop cancels the background connection loop.
+func (r *RTSPSource) Stop() {
+	r.stopOnce.Do(func() {
+		if r.cancel != nil {
+			r.cancel()
+		}
+	})
+}
+
+// Info identifies this as the rtsp backend, labeled by its configured name.
+func (r *RTSPSource) Info() SourceInfo {
+	return SourceInfo{Backend: "rtsp", Name: r.label}
+}
+
+func (r *RTSPSource) run(ctx context.Context, out chan<- Frame) {
+	defer close(out)
+	for ctx.Err() == nil {
+		err := r.breaker.Execute(func() error { return r.streamOnce(ctx, out) })
+		switch {
+		case ctx.Err() != nil:
+			return
+		case errors.Is(err, resilience.ErrOpen):
+			slog.Debug("rtsp source breaker open, backing off", "source", r.label)
+		case errors.Is(err, resilience.ErrPermanent):
+			slog.Warn("rtsp source failed permanently, not retrying", "source", r.label, "error", err)
+			return
+		case err != nil:
+			slog.Warn("rtsp stream ended, reconnecting", "source", r.label, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// streamOnce connects, finds the Opus track, and streams decoded frames
+// until the connection drops or ctx is canceled.
+func (r *RTSPSource) streamOnce(ctx context.Context, out chan<- Frame) error {
+	u, err := base.ParseURL(r.url)
+	if err != nil {
+		return fmt.Errorf("%w: parse rtsp url %q: %v", resilience.ErrPermanent, r.url, err)
+	}
+
+	client := &gortsplib.Client{}
+	if err := client.Start(u.Scheme, u.Host); err != nil {
+		return fmt.Errorf("connect to %q: %w", r.url, err)
+	}
+	defer client.Close()
+
+	desc, _, err := client.Describe(u)
+	if err != nil {
+		return fmt.Errorf("describe %q: %w", r.url, err)
+	}
+
+	var forma *format.Opus
+	medi := desc.FindFormat(&forma)
+	if medi == nil {
+		return fmt.Errorf("%w: %q has no Opus audio track (AAC decoding is not yet supported)", resilience.ErrPermanent, r.url)
+	}
+
+	rtpDec, err := forma.CreateDecoder()
+	if err != nil {
+		return fmt.Errorf("%w: create rtp depacketizer: %v", resilience.ErrPermanent, err)
+	}
+
+	opusDec, err := opus.NewDecoder(r.sampleRate, 1)
+	if err != nil {
+		return fmt.Errorf("%w: create opus decoder: %v", resilience.ErrPermanent, err)
+	}
+
+	if _, err := client.Setup(desc.BaseURL, medi, 0, 0); err != nil {
+		return fmt.Errorf("setup %q: %w", r.url, err)
+	}
+
+	pcm := make([]float32, r.sampleRate/4) // scratch buffer, big enough for the largest Opus frame (120ms)
+	client.OnPacketRTP(medi, forma, func(pkt *rtp.Packet) {
+		au, err := rtpDec.Decode(pkt)
+		if err != nil {
+			slog.Debug("rtsp rtp decode error", "source", r.label, "error", err)
+			return
+		}
+
+		n, err := opusDec.DecodeFloat32(au, pcm)
+		if err != nil {
+			slog.Debug("rtsp opus decode error", "source", r.label, "error", err)
+			return
+		}
+
+		frame := Frame{Audio: append([]float32(nil), pcm[:n]...), Source: r.label, Timestamp: time.Now().UnixNano()}
+		select {
+		case out <- frame:
+		case <-ctx.Done():
+		}
+	})
+
+	if _, err := client.Play(nil); err != nil {
+		return fmt.Errorf("play %q: %w", r.url, err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- client.Wait() }()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-done:
+		return err
+	}
+}
@@ -0,0 +1,20 @@
+package capture
+
+import "fmt"
+
+// ScreenSourceConfig configures any ScreenSource implementation.
+type ScreenSourceConfig struct {
+	CaptureRate float64 // Hz; defaults to 1.0 when <= 0
+}
+
+// NewScreenSource builds the named screen backend. Unknown names return an error.
+func NewScreenSource(backend string, cfg ScreenSourceConfig) (ScreenSource, error) {
+	switch backend {
+	case "", "native":
+		return newNativeScreenSource(cfg), nil
+	case "screenshot":
+		return newScreenshotSource(cfg), nil
+	default:
+		return nil, fmt.Errorf("capture: unknown screen backend %q", backend)
+	}
+}
@@ -0,0 +1,93 @@
+package capture
+
+import (
+	"bytes"
+	"context"
+	"image/jpeg"
+	"log/slog"
+	"time"
+
+	"github.com/kbinani/screenshot"
+)
+
+// screenshotJPEGQuality matches the quality the native backend's shared
+// encodeJPEG helper uses.
+const screenshotJPEGQuality = 85
+
+// ScreenshotSource captures the primary display directly via
+// kbinani/screenshot (X11/GDI/Quartz), independent of the orchestrator's
+// native package - useful where the native backend's extra integrations
+// (Wayland portal negotiation, DXGI, the screencapture CLI) aren't wanted.
+type ScreenshotSource struct {
+	cfg    ScreenSourceConfig
+	cancel context.CancelFunc
+}
+
+func newScreenshotSource(cfg ScreenSourceConfig) *ScreenshotSource {
+	return &ScreenshotSource{cfg: cfg}
+}
+
+// Start polls the primary display on a ticker and forwards JPEG-encoded frames.
+func (s *ScreenshotSource) Start(ctx context.Context) (<-chan Frame, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	rate := s.cfg.CaptureRate
+	if rate <= 0 {
+		rate = 1.0
+	}
+
+	out := make(chan Frame)
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				frame, ok := s.captureFrame()
+				if !ok {
+					continue
+				}
+				select {
+				case out <- frame:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (s *ScreenshotSource) captureFrame() (Frame, bool) {
+	if screenshot.NumActiveDisplays() == 0 {
+		slog.Debug("screenshot backend: no active displays")
+		return Frame{}, false
+	}
+	img, err := screenshot.CaptureDisplay(0)
+	if err != nil {
+		slog.Debug("screenshot backend: capture failed", "error", err)
+		return Frame{}, false
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: screenshotJPEGQuality}); err != nil {
+		slog.Debug("screenshot backend: jpeg encode failed", "error", err)
+		return Frame{}, false
+	}
+	return Frame{Image: buf.Bytes(), Timestamp: time.Now().UnixNano()}, true
+}
+
+// Stop cancels the polling loop.
+func (s *ScreenshotSource) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+// Info identifies this as the screenshot backend.
+func (s *ScreenshotSource) Info() SourceInfo {
+	return SourceInfo{Backend: "screenshot", Name: "screenshot"}
+}
@@ -0,0 +1,88 @@
+package whip
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+const (
+	whipSDPContentType = "application/sdp"
+	whipMaxOfferBytes  = 1 << 20 // 1MiB is generous for an SDP offer
+	whipBasePath       = "/whip" // must match where Handler is mounted in server.Server.Handler
+)
+
+// Handler returns the HTTP handler for the WHIP ingest endpoint. It expects
+// to be routed both a "POST /whip" pattern (create a session) and a
+// "DELETE /whip/{id}" pattern (tear one down), e.g.:
+//
+//	mux.Handle("POST /whip", whipSrc.Handler())
+//	mux.Handle("DELETE /whip/{id}", whipSrc.Handler())
+func (s *Source) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			s.handleCreate(w, r)
+		case http.MethodDelete:
+			s.handleDelete(w, r.PathValue("id"))
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// handleCreate negotiates a new session from the SDP offer in the request
+// body and responds with the SDP answer, per draft-ietf-wish-whip: 201
+// Created, Content-Type: application/sdp, and a Location header naming the
+// resource DELETE should target.
+func (s *Source) handleCreate(w http.ResponseWriter, r *http.Request) {
+	if ct := r.Header.Get("Content-Type"); ct != "" && ct != whipSDPContentType {
+		http.Error(w, "unsupported content type, want "+whipSDPContentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	offer, err := io.ReadAll(io.LimitReader(r.Body, whipMaxOfferBytes))
+	if err != nil {
+		http.Error(w, "failed to read offer", http.StatusBadRequest)
+		return
+	}
+
+	id, err := newSessionID()
+	if err != nil {
+		http.Error(w, "failed to allocate session", http.StatusInternalServerError)
+		return
+	}
+
+	sess, answer, err := newSession(id, string(offer), s.sampleRate, s.out)
+	if err != nil {
+		slog.Warn("whip: failed to negotiate session", "error", err)
+		http.Error(w, "failed to negotiate session: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.sessions[id] = sess
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", whipSDPContentType)
+	w.Header().Set("Location", whipBasePath+"/"+id)
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte(answer))
+}
+
+// handleDelete tears down the session named by id, per draft-ietf-wish-whip.
+func (s *Source) handleDelete(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	sess, ok := s.sessions[id]
+	if ok {
+		delete(s.sessions, id)
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "unknown session", http.StatusNotFound)
+		return
+	}
+	sess.close()
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,64 @@
+package whip
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleCreateRejectsBadOffer(t *testing.T) {
+	s := NewSource(16000)
+	defer s.Stop()
+
+	req := httptest.NewRequest("POST", whipBasePath, strings.NewReader("not an sdp offer"))
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, req)
+
+	if w.Code != 400 {
+		t.Fatalf("status = %d, want 400 for a malformed offer", w.Code)
+	}
+	if len(s.sessions) != 0 {
+		t.Errorf("sessions = %d, want 0 after a failed negotiation", len(s.sessions))
+	}
+}
+
+func TestHandleCreateRejectsWrongContentType(t *testing.T) {
+	s := NewSource(16000)
+	defer s.Stop()
+
+	req := httptest.NewRequest("POST", whipBasePath, strings.NewReader("v=0"))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, req)
+
+	if w.Code != 415 {
+		t.Fatalf("status = %d, want 415 for an unsupported content type", w.Code)
+	}
+}
+
+func TestHandleDeleteUnknownSession(t *testing.T) {
+	s := NewSource(16000)
+	defer s.Stop()
+
+	req := httptest.NewRequest("DELETE", whipBasePath+"/does-not-exist", nil)
+	req.SetPathValue("id", "does-not-exist")
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, req)
+
+	if w.Code != 404 {
+		t.Fatalf("status = %d, want 404 for an unknown session", w.Code)
+	}
+}
+
+func TestHandleUnsupportedMethod(t *testing.T) {
+	s := NewSource(16000)
+	defer s.Stop()
+
+	req := httptest.NewRequest("PATCH", whipBasePath, nil)
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, req)
+
+	if w.Code != 405 {
+		t.Fatalf("status = %d, want 405 for an unsupported method", w.Code)
+	}
+}
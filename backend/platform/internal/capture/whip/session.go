@@ -0,0 +1,130 @@
+package whip
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/hraban/opus"
+	"github.com/pion/webrtc/v4"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+)
+
+const whipOpusPayloadType = 111
+
+// session is one negotiated WHIP PeerConnection and its Opus decode state.
+type session struct {
+	id   string
+	pc   *webrtc.PeerConnection
+	done chan struct{}
+	once sync.Once
+}
+
+// newSession negotiates offerSDP against a fresh PeerConnection configured
+// for a single recvonly Opus audio track, starts decoding that track's RTP
+// into out tagged with id, and returns the session plus the SDP answer to
+// send back to the client.
+func newSession(id, offerSDP string, sampleRate int, out chan<- capture.Frame) (*session, string, error) {
+	m := &webrtc.MediaEngine{}
+	if err := m.RegisterCodec(webrtc.RTPCodecParameters{
+		RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeOpus, ClockRate: 48000, Channels: 1},
+		PayloadType:        whipOpusPayloadType,
+	}, webrtc.RTPCodecTypeAudio); err != nil {
+		return nil, "", fmt.Errorf("register opus codec: %w", err)
+	}
+
+	api := webrtc.NewAPI(webrtc.WithMediaEngine(m))
+	pc, err := api.NewPeerConnection(webrtc.Configuration{})
+	if err != nil {
+		return nil, "", fmt.Errorf("create peer connection: %w", err)
+	}
+
+	if _, err := pc.AddTransceiverFromKind(webrtc.RTPCodecTypeAudio, webrtc.RTPTransceiverInit{
+		Direction: webrtc.RTPTransceiverDirectionRecvonly,
+	}); err != nil {
+		_ = pc.Close()
+		return nil, "", fmt.Errorf("add audio transceiver: %w", err)
+	}
+
+	sess := &session{id: id, pc: pc, done: make(chan struct{})}
+
+	pc.OnTrack(func(track *webrtc.TrackRemote, _ *webrtc.RTPReceiver) {
+		sess.decodeTrack(track, sampleRate, out)
+	})
+	pc.OnConnectionStateChange(func(state webrtc.PeerConnectionState) {
+		switch state {
+		case webrtc.PeerConnectionStateFailed, webrtc.PeerConnectionStateClosed, webrtc.PeerConnectionStateDisconnected:
+			sess.close()
+		}
+	})
+
+	if err := pc.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeOffer, SDP: offerSDP}); err != nil {
+		_ = pc.Close()
+		return nil, "", fmt.Errorf("set remote description: %w", err)
+	}
+
+	answer, err := pc.CreateAnswer(nil)
+	if err != nil {
+		_ = pc.Close()
+		return nil, "", fmt.Errorf("create answer: %w", err)
+	}
+
+	gatherComplete := webrtc.GatheringCompletePromise(pc)
+	if err := pc.SetLocalDescription(answer); err != nil {
+		_ = pc.Close()
+		return nil, "", fmt.Errorf("set local description: %w", err)
+	}
+	<-gatherComplete
+
+	return sess, pc.LocalDescription().SDP, nil
+}
+
+// decodeTrack reads RTP packets from track, decodes their Opus payload to
+// mono float32 PCM, and publishes each as a Frame until the track ends or
+// the session closes.
+func (s *session) decodeTrack(track *webrtc.TrackRemote, sampleRate int, out chan<- capture.Frame) {
+	dec, err := opus.NewDecoder(sampleRate, 1)
+	if err != nil {
+		slog.Error("whip: failed to create opus decoder", "session", s.id, "error", err)
+		return
+	}
+
+	pcm := make([]float32, sampleRate/4) // scratch buffer, big enough for the largest Opus frame (120ms)
+	for {
+		pkt, _, err := track.ReadRTP()
+		if err != nil {
+			if err != io.EOF {
+				slog.Debug("whip: track read ended", "session", s.id, "error", err)
+			}
+			return
+		}
+
+		n, err := dec.DecodeFloat32(pkt.Payload, pcm)
+		if err != nil {
+			slog.Debug("whip: opus decode error", "session", s.id, "error", err)
+			continue
+		}
+
+		frame := capture.Frame{
+			Audio:     append([]float32(nil), pcm[:n]...),
+			Source:    s.id,
+			Timestamp: time.Now().UnixNano(),
+		}
+		select {
+		case out <- frame:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// close tears down the PeerConnection; safe to call more than once.
+func (s *session) close() {
+	s.once.Do(func() {
+		close(s.done)
+		_ = s.pc.Close()
+	})
+}
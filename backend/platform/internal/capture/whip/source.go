@@ -0,0 +1,79 @@
+// Package whip implements a WHIP (WebRTC-HTTP Ingestion Protocol, per
+// draft-ietf-wish-whip) ingest endpoint: a client POSTs an SDP offer and
+// gets back an SDP answer plus a resource URL it can DELETE to hang up.
+// Each accepted session negotiates a single recvonly Opus audio track and
+// decodes it into the same Frame shape as every other capture.AudioSource,
+// so remote clients (meeting bots, mobile browsers) can contribute audio
+// without running the native capturer.
+package whip
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+)
+
+const whipFrameBuffer = 32 // buffered frames per Source before a slow consumer stalls senders
+
+// Source accepts WHIP sessions over HTTP and emits their decoded audio as
+// capture.Frames, so it can be combined with local/RTSP sources through the
+// AudioSource interface. A single Source can hold many concurrent sessions;
+// each gets a unique device ID (classified "remote" by
+// internal/audio.Capturer.classifyDevice) so downstream VAD/diarization
+// keeps them separate.
+type Source struct {
+	sampleRate int
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	out      chan capture.Frame
+	stopOnce sync.Once
+}
+
+// NewSource returns a Source ready to accept WHIP sessions and decode their
+// audio at sampleRate (mono).
+func NewSource(sampleRate int) *Source {
+	return &Source{
+		sampleRate: sampleRate,
+		sessions:   make(map[string]*session),
+		out:        make(chan capture.Frame, whipFrameBuffer),
+	}
+}
+
+// Start returns the channel every active and future session's audio is
+// published to. WHIP sessions are created asynchronously through Handler,
+// so the returned channel may sit idle until the first POST /whip arrives.
+func (s *Source) Start(ctx context.Context) (<-chan capture.Frame, error) {
+	return s.out, nil
+}
+
+// Stop closes every active session and the output channel.
+func (s *Source) Stop() {
+	s.stopOnce.Do(func() {
+		s.mu.Lock()
+		for id, sess := range s.sessions {
+			sess.close()
+			delete(s.sessions, id)
+		}
+		s.mu.Unlock()
+		close(s.out)
+	})
+}
+
+// Info identifies this as the whip backend.
+func (s *Source) Info() capture.SourceInfo {
+	return capture.SourceInfo{Backend: "whip", Name: "whip"}
+}
+
+// newSessionID returns a random hex ID used as both the session map key and
+// the per-session device ID frames are tagged with.
+func newSessionID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whip-" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,71 @@
+package config
+
+import "sync"
+
+// Tunable identifies a config value that downstream consumers can
+// subscribe to instead of re-reading Store.Current on every use.
+type Tunable string
+
+// Tunables that consumers are expected to subscribe to for live reload.
+const (
+	TunableAutoAnswerCooldown Tunable = "auto_answer.cooldown_seconds"
+	TunableLogLevel           Tunable = "logging.level"
+	TunableMemoryBatchSize    Tunable = "memory.batch_max_size"
+	TunableAudioVADThreshold  Tunable = "audio.vad_threshold"
+	TunableScreenCaptureRate  Tunable = "screen.capture_rate"
+)
+
+// bus fans out config changes to per-Tunable subscribers.
+type bus struct {
+	mu   sync.RWMutex
+	subs map[Tunable][]chan *Config
+}
+
+func newBus() *bus {
+	return &bus{subs: make(map[Tunable][]chan *Config)}
+}
+
+// subscribe returns a buffered (size 1) channel that receives the new
+// Config each time t changes. Slow consumers see the latest value, not
+// every intermediate one - a pending send is replaced rather than queued.
+func (b *bus) subscribe(t Tunable) <-chan *Config {
+	ch := make(chan *Config, 1)
+	b.mu.Lock()
+	b.subs[t] = append(b.subs[t], ch)
+	b.mu.Unlock()
+	return ch
+}
+
+func (b *bus) publish(old, next *Config) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for t, changed := range tunableDiff(old, next) {
+		if !changed {
+			continue
+		}
+		for _, ch := range b.subs[t] {
+			select {
+			case ch <- next:
+			default:
+				select {
+				case <-ch:
+				default:
+				}
+				select {
+				case ch <- next:
+				default:
+				}
+			}
+		}
+	}
+}
+
+func tunableDiff(old, next *Config) map[Tunable]bool {
+	return map[Tunable]bool{
+		TunableAutoAnswerCooldown: old.AutoAnswer.CooldownSeconds != next.AutoAnswer.CooldownSeconds,
+		TunableLogLevel:           old.Logging.Level != next.Logging.Level,
+		TunableMemoryBatchSize:    old.Memory.BatchMaxSize != next.Memory.BatchMaxSize,
+		TunableAudioVADThreshold:  old.Audio.VADThreshold != next.Audio.VADThreshold,
+		TunableScreenCaptureRate:  old.Screen.CaptureRate != next.Screen.CaptureRate,
+	}
+}
@@ -10,54 +10,154 @@ import (
 
 // PlatformConfig holds Go platform-specific settings.
 type PlatformConfig struct {
-	HTTPAddr      string
-	InferenceAddr string
+	HTTPAddr           string   `yaml:"http_addr" toml:"http_addr"`
+	InferenceAddr      string   `yaml:"inference_addr" toml:"inference_addr"`
+	InferenceAddrs     []string `yaml:"inference_addrs" toml:"inference_addrs"`           // additional inference backends for HostPool; falls back to [InferenceAddr] when empty
+	HedgeDelayMs       int      `yaml:"hedge_delay_ms" toml:"hedge_delay_ms"`             // delay before firing a hedged request to the next-best host
+	OTLPEndpoint       string   `yaml:"otlp_endpoint" toml:"otlp_endpoint"`               // OTLP/gRPC collector address, e.g. "localhost:4317"; empty disables export
+	RemoteAudioSources []string `yaml:"remote_audio_sources" toml:"remote_audio_sources"` // RTSP URLs to capture audio from, each optionally prefixed "label=", e.g. "conference-room=rtsp://cam.local/audio"
+	WHIPEnabled        bool     `yaml:"whip_enabled" toml:"whip_enabled"`                 // accept WebRTC audio pushed to POST /whip (draft-ietf-wish-whip) as an additional audio source
+	MumbleEnabled      bool     `yaml:"mumble_enabled" toml:"mumble_enabled"`             // join a Mumble server as a bot and transcribe the other participants as an additional audio source
+	MumbleServer       string   `yaml:"mumble_server" toml:"mumble_server"`               // Mumble server address, e.g. "mumble.example.com:64738"
+	MumbleUsername     string   `yaml:"mumble_username" toml:"mumble_username"`           // username the bot connects as
+	MumbleChannel      string   `yaml:"mumble_channel" toml:"mumble_channel"`             // channel path to join after connecting, e.g. "Root/Meeting"; empty stays in the root channel
+	MumbleCert         string   `yaml:"mumble_cert" toml:"mumble_cert"`                   // path to a PEM client certificate, for servers that require certificate auth
+
+	HTTPTLS      TLSConfig `yaml:"http_tls" toml:"http_tls"`           // serves the WebSocket/HTTP API over TLS when Enabled
+	InferenceTLS TLSConfig `yaml:"inference_tls" toml:"inference_tls"` // secures the gRPC connection to the inference server, with mTLS when ClientCAFile is set
+}
+
+// TLSConfig describes a TLS policy shared by the HTTP server, an eventual
+// gRPC server, and the inference gRPC client: its own certificate/key pair,
+// an optional CA bundle used to verify the peer's certificate (enabling
+// mTLS), and the minimum version/cipher suite policy regulated deployments
+// need to pin down.
+type TLSConfig struct {
+	Enabled      bool     `yaml:"enabled" toml:"enabled"`
+	CertFile     string   `yaml:"cert_file" toml:"cert_file"`
+	KeyFile      string   `yaml:"key_file" toml:"key_file"`
+	ClientCAFile string   `yaml:"client_ca_file" toml:"client_ca_file"` // CA bundle verifying the peer's certificate; set to require mTLS
+	MinVersion   string   `yaml:"min_version" toml:"min_version"`       // "VersionTLS12" or "VersionTLS13"; empty defaults to VersionTLS12
+	CipherSuites []string `yaml:"cipher_suites" toml:"cipher_suites"`   // crypto/tls suite names, e.g. "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"; empty keeps Go's default policy
 }
 
 // AudioConfig holds audio capture/processing settings.
 type AudioConfig struct {
-	SampleRate         int
-	VADThreshold       float64
-	MaxSilenceChunks   int
-	CaptureSystemAudio bool
-	ExcludedDevices    []string
+	SampleRate           int      `yaml:"sample_rate" toml:"sample_rate"`
+	VADThreshold         float64  `yaml:"vad_threshold" toml:"vad_threshold"`
+	MaxSilenceChunks     int      `yaml:"max_silence_chunks" toml:"max_silence_chunks"`
+	CaptureSystemAudio   bool     `yaml:"capture_system_audio" toml:"capture_system_audio"`
+	SystemAudioBackend   string   `yaml:"system_audio_backend" toml:"system_audio_backend"` // "auto" (native loopback, e.g. WASAPI, falling back to device scanning), "native", or "device" (e.g. Stereo Mix/BlackHole only)
+	ExcludedDevices      []string `yaml:"excluded_devices" toml:"excluded_devices"`
+	Backend              string   `yaml:"backend" toml:"backend"`                             // capture backend name, e.g. "portaudio", "malgo", or "gstreamer"
+	PrerollMs            int      `yaml:"preroll_ms" toml:"preroll_ms"`                       // how much buffered audio to prepend when speech starts, so VAD onset latency doesn't clip leading words
+	GStreamerPipeline    string   `yaml:"gstreamer_pipeline" toml:"gstreamer_pipeline"`       // launch string for the "gstreamer" backend, must end in "appsink name=sink"
+	TargetLUFS           float64  `yaml:"target_lufs" toml:"target_lufs"`                     // loudness level chunks are normalized toward before VAD/transcription
+	NormalizationMode    string   `yaml:"normalization_mode" toml:"normalization_mode"`       // "off", "rms", or "ebur128"
+	DiarizationThreshold float64  `yaml:"diarization_threshold" toml:"diarization_threshold"` // cosine similarity above which a segment is assigned to an existing speaker cluster
+	MaxSpeakers          int      `yaml:"max_speakers" toml:"max_speakers"`                   // per-source cap on distinct speaker clusters before new segments join the nearest one
+	RegistryDir          string   `yaml:"registry_dir" toml:"registry_dir"`                   // directory the speaker registry (cluster centroids/labels) is persisted to, so labels survive a restart
+	VADEnergyMargin      float64  `yaml:"vad_energy_margin" toml:"vad_energy_margin"`         // multiple of a device's rolling noise floor a window's RMS energy must clear before a VAD inference call runs
 }
 
 // ScreenConfig holds screen capture settings.
 type ScreenConfig struct {
-	CaptureRate              float64 // Hz
-	StableCountThreshold     int
-	MinTextLength            int
-	PHashSimilarityThreshold float64
+	CaptureRate              float64 `yaml:"capture_rate" toml:"capture_rate"` // Hz
+	StableCountThreshold     int     `yaml:"stable_count_threshold" toml:"stable_count_threshold"`
+	MinTextLength            int     `yaml:"min_text_length" toml:"min_text_length"`
+	PHashSimilarityThreshold float64 `yaml:"phash_similarity_threshold" toml:"phash_similarity_threshold"`
+	Backend                  string  `yaml:"backend" toml:"backend"` // capture backend name, e.g. "native" or "screenshot"
 }
 
 // AutoAnswerConfig holds auto-answer feature settings.
 type AutoAnswerConfig struct {
-	Enabled         bool
-	CooldownSeconds float64
-	MinQuestionLen  int
+	Enabled         bool    `yaml:"enabled" toml:"enabled"`
+	CooldownSeconds float64 `yaml:"cooldown_seconds" toml:"cooldown_seconds"`
+	MinQuestionLen  int     `yaml:"min_question_length" toml:"min_question_length"`
 }
 
 // MemoryConfig holds vector memory batcher settings.
 type MemoryConfig struct {
-	BatchMaxSize      int
-	BatchFlushDelayMs int
+	BatchMaxSize      int    `yaml:"batch_max_size" toml:"batch_max_size"`
+	BatchFlushDelayMs int    `yaml:"batch_flush_delay_ms" toml:"batch_flush_delay_ms"`
+	DLQDir            string `yaml:"dlq_dir" toml:"dlq_dir"` // directory for the batcher's dead-letter queue of undeliverable batches
 }
 
 // LoggingConfig holds logging settings.
 type LoggingConfig struct {
-	Level  string
-	Format string
+	Level  string `yaml:"level" toml:"level"`
+	Format string `yaml:"format" toml:"format"`
+}
+
+// ProfilingConfig holds continuous-profiling trigger settings.
+type ProfilingConfig struct {
+	Enabled bool    `yaml:"enabled" toml:"enabled"`
+	HeapMB  float64 `yaml:"heap_mb" toml:"heap_mb"` // capture a profile once heap usage exceeds this; 0 disables the heap trigger
+	CPUPct  float64 `yaml:"cpu_pct" toml:"cpu_pct"` // capture a profile once CPU usage exceeds this (100 == one full core); 0 disables the CPU trigger
+	Dir     string  `yaml:"dir" toml:"dir"`         // directory profiles are written to
+}
+
+// SinkConfig holds settings for retaining captured screen frames,
+// transcripts, and LLM outputs.
+type SinkConfig struct {
+	Type          string `yaml:"type" toml:"type"` // "null" (default), "filesystem", or "console"
+	Dir           string `yaml:"dir" toml:"dir"`
+	MaxSizeMB     int    `yaml:"max_size_mb" toml:"max_size_mb"`
+	MaxBackups    int    `yaml:"max_backups" toml:"max_backups"`
+	MaxAgeSeconds int    `yaml:"max_age_seconds" toml:"max_age_seconds"`
+}
+
+// KafkaEventSinkConfig configures the Kafka events.Sink.
+type KafkaEventSinkConfig struct {
+	Enabled bool     `yaml:"enabled" toml:"enabled"`
+	Brokers []string `yaml:"brokers" toml:"brokers"`
+	Topic   string   `yaml:"topic" toml:"topic"`
+}
+
+// NATSEventSinkConfig configures the NATS events.Sink.
+type NATSEventSinkConfig struct {
+	Enabled bool   `yaml:"enabled" toml:"enabled"`
+	URL     string `yaml:"url" toml:"url"`
+	Subject string `yaml:"subject" toml:"subject"`
+}
+
+// WebhookEventSinkConfig configures the generic HTTP webhook events.Sink.
+type WebhookEventSinkConfig struct {
+	Enabled   bool   `yaml:"enabled" toml:"enabled"`
+	URL       string `yaml:"url" toml:"url"`
+	TimeoutMs int    `yaml:"timeout_ms" toml:"timeout_ms"` // 0 uses events.DefaultWebhookTimeout
+}
+
+// EventSinksConfig holds settings for fanning transcript-final,
+// screen-text-stable, and auto-answer events out to external systems via
+// internal/events. Each sink is independently enable/disable-able.
+type EventSinksConfig struct {
+	Kafka   KafkaEventSinkConfig   `yaml:"kafka" toml:"kafka"`
+	NATS    NATSEventSinkConfig    `yaml:"nats" toml:"nats"`
+	Webhook WebhookEventSinkConfig `yaml:"webhook" toml:"webhook"`
+}
+
+// ReplayConfig holds settings for the rolling on-disk buffer of recently
+// captured audio and screen frames served as an HLS-style replay.
+type ReplayConfig struct {
+	Enabled        bool   `yaml:"enabled" toml:"enabled"`
+	Dir            string `yaml:"dir" toml:"dir"`
+	WindowSeconds  int    `yaml:"window_seconds" toml:"window_seconds"`   // 0 uses replay.DefaultWindow
+	SegmentSeconds int    `yaml:"segment_seconds" toml:"segment_seconds"` // 0 uses replay.DefaultSegmentDuration
 }
 
 // Config is the root configuration container.
 type Config struct {
-	Platform   PlatformConfig
-	Audio      AudioConfig
-	Screen     ScreenConfig
-	AutoAnswer AutoAnswerConfig
-	Memory     MemoryConfig
-	Logging    LoggingConfig
+	Platform   PlatformConfig   `yaml:"platform" toml:"platform"`
+	Audio      AudioConfig      `yaml:"audio" toml:"audio"`
+	Screen     ScreenConfig     `yaml:"screen" toml:"screen"`
+	AutoAnswer AutoAnswerConfig `yaml:"auto_answer" toml:"auto_answer"`
+	Memory     MemoryConfig     `yaml:"memory" toml:"memory"`
+	Logging    LoggingConfig    `yaml:"logging" toml:"logging"`
+	Profiling  ProfilingConfig  `yaml:"profiling" toml:"profiling"`
+	Sinks      SinkConfig       `yaml:"sinks" toml:"sinks"`
+	EventSinks EventSinksConfig `yaml:"event_sinks" toml:"event_sinks"`
+	Replay     ReplayConfig     `yaml:"replay" toml:"replay"`
 }
 
 // Validate checks config against schema constraints.
@@ -74,6 +174,19 @@ func (c *Config) Validate() error {
 	if c.Audio.MaxSilenceChunks < 1 {
 		errs = append(errs, fmt.Sprintf("audio.max_silence_chunks must be >= 1, got %d", c.Audio.MaxSilenceChunks))
 	}
+	if c.Audio.PrerollMs < 0 {
+		errs = append(errs, fmt.Sprintf("audio.preroll_ms must be >= 0, got %d", c.Audio.PrerollMs))
+	}
+	validNormModes := map[string]bool{"off": true, "rms": true, "ebur128": true}
+	if !validNormModes[c.Audio.NormalizationMode] {
+		errs = append(errs, fmt.Sprintf("audio.normalization_mode must be one of [off, rms, ebur128], got %q", c.Audio.NormalizationMode))
+	}
+	if c.Audio.DiarizationThreshold < 0 || c.Audio.DiarizationThreshold > 1 {
+		errs = append(errs, fmt.Sprintf("audio.diarization_threshold must be 0-1, got %f", c.Audio.DiarizationThreshold))
+	}
+	if c.Audio.MaxSpeakers < 1 {
+		errs = append(errs, fmt.Sprintf("audio.max_speakers must be >= 1, got %d", c.Audio.MaxSpeakers))
+	}
 	// Screen validation
 	if c.Screen.CaptureRate < 0.1 || c.Screen.CaptureRate > 10 {
 		errs = append(errs, fmt.Sprintf("screen.capture_rate must be 0.1-10, got %f", c.Screen.CaptureRate))
@@ -92,45 +205,182 @@ func (c *Config) Validate() error {
 	if c.AutoAnswer.MinQuestionLen < 1 {
 		errs = append(errs, fmt.Sprintf("auto_answer.min_question_length must be >= 1, got %d", c.AutoAnswer.MinQuestionLen))
 	}
+	// TLS validation
+	errs = append(errs, validateTLS("platform.http_tls", c.Platform.HTTPTLS)...)
+	errs = append(errs, validateTLS("platform.inference_tls", c.Platform.InferenceTLS)...)
 	if len(errs) > 0 {
 		return fmt.Errorf("config validation failed:\n  - %s", strings.Join(errs, "\n  - "))
 	}
 	return nil
 }
 
-// Load reads configuration from environment variables and validates.
-func Load() (*Config, error) {
+// defaultConfig returns the hardcoded defaults used when neither a config
+// file nor an environment variable supplies a value.
+func defaultConfig() *Config {
+	return &Config{
+		Platform: PlatformConfig{
+			HTTPAddr:      ":8000",
+			InferenceAddr: "localhost:50051",
+			HedgeDelayMs:  50,
+		},
+		Audio: AudioConfig{
+			SampleRate:           16000,
+			VADThreshold:         0.5,
+			MaxSilenceChunks:     15,
+			CaptureSystemAudio:   true,
+			SystemAudioBackend:   "auto",
+			ExcludedDevices:      []string{"iphone", "teams"},
+			Backend:              "portaudio",
+			PrerollMs:            300,
+			GStreamerPipeline:    "",
+			TargetLUFS:           -23.0,
+			NormalizationMode:    "rms",
+			DiarizationThreshold: 0.75,
+			MaxSpeakers:          8,
+			RegistryDir:          "speaker_registry",
+			VADEnergyMargin:      3.0,
+		},
+		Screen: ScreenConfig{
+			CaptureRate:              1.0,
+			StableCountThreshold:     2,
+			MinTextLength:            10,
+			PHashSimilarityThreshold: 0.95,
+			Backend:                  "native",
+		},
+		AutoAnswer: AutoAnswerConfig{
+			Enabled:         true,
+			CooldownSeconds: 10.0,
+			MinQuestionLen:  10,
+		},
+		Memory: MemoryConfig{
+			BatchMaxSize:      50,
+			BatchFlushDelayMs: 2000,
+			DLQDir:            "memory_dlq",
+		},
+		Logging: LoggingConfig{
+			Level:  "INFO",
+			Format: "text",
+		},
+		Profiling: ProfilingConfig{
+			Enabled: false,
+			HeapMB:  512,
+			CPUPct:  80,
+			Dir:     "profiles",
+		},
+		Sinks: SinkConfig{
+			Type: "null",
+		},
+		EventSinks: EventSinksConfig{
+			Kafka:   KafkaEventSinkConfig{Topic: "good-listener-events"},
+			NATS:    NATSEventSinkConfig{URL: "nats://localhost:4222", Subject: "good-listener.events"},
+			Webhook: WebhookEventSinkConfig{TimeoutMs: 5000},
+		},
+		Replay: ReplayConfig{
+			Enabled:        false,
+			Dir:            "replay",
+			WindowSeconds:  600,
+			SegmentSeconds: 2,
+		},
+	}
+}
+
+// loadFromDefaults applies environment variable overrides on top of def,
+// which may itself already hold file-sourced values. Env vars always win.
+func loadFromDefaults(def *Config) (*Config, error) {
 	cfg := &Config{
 		Platform: PlatformConfig{
-			HTTPAddr:      getEnv("HTTP_ADDR", ":8000"),
-			InferenceAddr: getEnv("INFERENCE_ADDR", "localhost:50051"),
+			HTTPAddr:           getEnv("HTTP_ADDR", def.Platform.HTTPAddr),
+			InferenceAddr:      getEnv("INFERENCE_ADDR", def.Platform.InferenceAddr),
+			InferenceAddrs:     getEnvList("INFERENCE_ADDRS", def.Platform.InferenceAddrs),
+			HedgeDelayMs:       getEnvInt("HEDGE_DELAY_MS", def.Platform.HedgeDelayMs),
+			OTLPEndpoint:       getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", def.Platform.OTLPEndpoint),
+			RemoteAudioSources: getEnvList("REMOTE_AUDIO_SOURCES", def.Platform.RemoteAudioSources),
+			WHIPEnabled:        getEnvBool("WHIP_ENABLED", def.Platform.WHIPEnabled),
+			MumbleEnabled:      getEnvBool("MUMBLE_ENABLED", def.Platform.MumbleEnabled),
+			MumbleServer:       getEnv("MUMBLE_SERVER", def.Platform.MumbleServer),
+			MumbleUsername:     getEnv("MUMBLE_USERNAME", def.Platform.MumbleUsername),
+			MumbleChannel:      getEnv("MUMBLE_CHANNEL", def.Platform.MumbleChannel),
+			MumbleCert:         getEnv("MUMBLE_CERT", def.Platform.MumbleCert),
+			HTTPTLS:            loadTLSFromEnv("HTTP_TLS", def.Platform.HTTPTLS),
+			InferenceTLS:       loadTLSFromEnv("INFERENCE_TLS", def.Platform.InferenceTLS),
 		},
 		Audio: AudioConfig{
-			SampleRate:         getEnvInt("SAMPLE_RATE", 16000),
-			VADThreshold:       getEnvFloat("VAD_THRESHOLD", 0.5),
-			MaxSilenceChunks:   getEnvInt("MAX_SILENCE_CHUNKS", 15),
-			CaptureSystemAudio: getEnvBool("CAPTURE_SYSTEM_AUDIO", true),
-			ExcludedDevices:    getEnvList("EXCLUDED_AUDIO_DEVICES", []string{"iphone", "teams"}),
+			SampleRate:           getEnvInt("SAMPLE_RATE", def.Audio.SampleRate),
+			VADThreshold:         getEnvFloat("VAD_THRESHOLD", def.Audio.VADThreshold),
+			MaxSilenceChunks:     getEnvInt("MAX_SILENCE_CHUNKS", def.Audio.MaxSilenceChunks),
+			CaptureSystemAudio:   getEnvBool("CAPTURE_SYSTEM_AUDIO", def.Audio.CaptureSystemAudio),
+			SystemAudioBackend:   strings.ToLower(getEnv("SYSTEM_AUDIO_BACKEND", def.Audio.SystemAudioBackend)),
+			ExcludedDevices:      getEnvList("EXCLUDED_AUDIO_DEVICES", def.Audio.ExcludedDevices),
+			Backend:              getEnv("AUDIO_BACKEND", def.Audio.Backend),
+			PrerollMs:            getEnvInt("AUDIO_PREROLL_MS", def.Audio.PrerollMs),
+			GStreamerPipeline:    getEnv("AUDIO_GSTREAMER_PIPELINE", def.Audio.GStreamerPipeline),
+			TargetLUFS:           getEnvFloat("AUDIO_TARGET_LUFS", def.Audio.TargetLUFS),
+			NormalizationMode:    strings.ToLower(getEnv("AUDIO_NORMALIZATION_MODE", def.Audio.NormalizationMode)),
+			DiarizationThreshold: getEnvFloat("AUDIO_DIARIZATION_THRESHOLD", def.Audio.DiarizationThreshold),
+			MaxSpeakers:          getEnvInt("AUDIO_MAX_SPEAKERS", def.Audio.MaxSpeakers),
+			RegistryDir:          getEnv("AUDIO_REGISTRY_DIR", def.Audio.RegistryDir),
+			VADEnergyMargin:      getEnvFloat("AUDIO_VAD_ENERGY_MARGIN", def.Audio.VADEnergyMargin),
 		},
 		Screen: ScreenConfig{
-			CaptureRate:              getEnvFloat("SCREEN_CAPTURE_RATE", 1.0),
-			StableCountThreshold:     getEnvInt("SCREEN_STABLE_COUNT_THRESHOLD", 2),
-			MinTextLength:            getEnvInt("SCREEN_MIN_TEXT_LENGTH", 10),
-			PHashSimilarityThreshold: getEnvFloat("SCREEN_PHASH_THRESHOLD", 0.95),
+			CaptureRate:              getEnvFloat("SCREEN_CAPTURE_RATE", def.Screen.CaptureRate),
+			StableCountThreshold:     getEnvInt("SCREEN_STABLE_COUNT_THRESHOLD", def.Screen.StableCountThreshold),
+			MinTextLength:            getEnvInt("SCREEN_MIN_TEXT_LENGTH", def.Screen.MinTextLength),
+			PHashSimilarityThreshold: getEnvFloat("SCREEN_PHASH_THRESHOLD", def.Screen.PHashSimilarityThreshold),
+			Backend:                  getEnv("SCREEN_BACKEND", def.Screen.Backend),
 		},
 		AutoAnswer: AutoAnswerConfig{
-			Enabled:         getEnvBool("AUTO_ANSWER_ENABLED", true),
-			CooldownSeconds: getEnvFloat("AUTO_ANSWER_COOLDOWN", 10.0),
-			MinQuestionLen:  getEnvInt("MIN_QUESTION_LENGTH", 10),
+			Enabled:         getEnvBool("AUTO_ANSWER_ENABLED", def.AutoAnswer.Enabled),
+			CooldownSeconds: getEnvFloat("AUTO_ANSWER_COOLDOWN", def.AutoAnswer.CooldownSeconds),
+			MinQuestionLen:  getEnvInt("MIN_QUESTION_LENGTH", def.AutoAnswer.MinQuestionLen),
 		},
 		Memory: MemoryConfig{
-			BatchMaxSize:      getEnvInt("MEMORY_BATCH_MAX_SIZE", 50),
-			BatchFlushDelayMs: getEnvInt("MEMORY_BATCH_FLUSH_DELAY_MS", 2000),
+			BatchMaxSize:      getEnvInt("MEMORY_BATCH_MAX_SIZE", def.Memory.BatchMaxSize),
+			BatchFlushDelayMs: getEnvInt("MEMORY_BATCH_FLUSH_DELAY_MS", def.Memory.BatchFlushDelayMs),
+			DLQDir:            getEnv("MEMORY_DLQ_DIR", def.Memory.DLQDir),
 		},
 		Logging: LoggingConfig{
-			Level:  strings.ToUpper(getEnv("LOG_LEVEL", "INFO")),
-			Format: strings.ToLower(getEnv("LOG_FORMAT", "text")),
+			Level:  strings.ToUpper(getEnv("LOG_LEVEL", def.Logging.Level)),
+			Format: strings.ToLower(getEnv("LOG_FORMAT", def.Logging.Format)),
+		},
+		Profiling: ProfilingConfig{
+			Enabled: getEnvBool("PROFILE_ENABLED", def.Profiling.Enabled),
+			HeapMB:  getEnvFloat("PROFILE_HEAP_MB", def.Profiling.HeapMB),
+			CPUPct:  getEnvFloat("PROFILE_CPU_PCT", def.Profiling.CPUPct),
+			Dir:     getEnv("PROFILE_DIR", def.Profiling.Dir),
+		},
+		Sinks: SinkConfig{
+			Type:          getEnv("SINK_TYPE", def.Sinks.Type),
+			Dir:           getEnv("SINK_DIR", def.Sinks.Dir),
+			MaxSizeMB:     getEnvInt("SINK_MAX_SIZE_MB", def.Sinks.MaxSizeMB),
+			MaxBackups:    getEnvInt("SINK_MAX_BACKUPS", def.Sinks.MaxBackups),
+			MaxAgeSeconds: getEnvInt("SINK_MAX_AGE_SECONDS", def.Sinks.MaxAgeSeconds),
+		},
+		EventSinks: EventSinksConfig{
+			Kafka: KafkaEventSinkConfig{
+				Enabled: getEnvBool("EVENT_SINK_KAFKA_ENABLED", def.EventSinks.Kafka.Enabled),
+				Brokers: getEnvList("EVENT_SINK_KAFKA_BROKERS", def.EventSinks.Kafka.Brokers),
+				Topic:   getEnv("EVENT_SINK_KAFKA_TOPIC", def.EventSinks.Kafka.Topic),
+			},
+			NATS: NATSEventSinkConfig{
+				Enabled: getEnvBool("EVENT_SINK_NATS_ENABLED", def.EventSinks.NATS.Enabled),
+				URL:     getEnv("EVENT_SINK_NATS_URL", def.EventSinks.NATS.URL),
+				Subject: getEnv("EVENT_SINK_NATS_SUBJECT", def.EventSinks.NATS.Subject),
+			},
+			Webhook: WebhookEventSinkConfig{
+				Enabled:   getEnvBool("EVENT_SINK_WEBHOOK_ENABLED", def.EventSinks.Webhook.Enabled),
+				URL:       getEnv("EVENT_SINK_WEBHOOK_URL", def.EventSinks.Webhook.URL),
+				TimeoutMs: getEnvInt("EVENT_SINK_WEBHOOK_TIMEOUT_MS", def.EventSinks.Webhook.TimeoutMs),
+			},
 		},
+		Replay: ReplayConfig{
+			Enabled:        getEnvBool("REPLAY_ENABLED", def.Replay.Enabled),
+			Dir:            getEnv("REPLAY_DIR", def.Replay.Dir),
+			WindowSeconds:  getEnvInt("REPLAY_WINDOW_SECONDS", def.Replay.WindowSeconds),
+			SegmentSeconds: getEnvInt("REPLAY_SEGMENT_SECONDS", def.Replay.SegmentSeconds),
+		},
+	}
+	if len(cfg.Platform.InferenceAddrs) == 0 {
+		cfg.Platform.InferenceAddrs = []string{cfg.Platform.InferenceAddr}
 	}
 	if err := cfg.Validate(); err != nil {
 		return nil, err
@@ -138,6 +388,17 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// Load reads configuration layered defaults -> CONFIG_FILE (YAML/TOML, if
+// set) -> environment variable overrides, and validates. See Schema/
+// JSONSchema for a machine-readable description of the constraints Validate
+// enforces.
+func Load() (*Config, error) {
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		return LoadFromFile(path)
+	}
+	return loadFromDefaults(defaultConfig())
+}
+
 // MustLoad calls Load and panics on error.
 func MustLoad() *Config {
 	cfg, err := Load()
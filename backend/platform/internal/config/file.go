@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadFromFile reads a YAML or TOML config file (selected by extension),
+// merges it over the hardcoded defaults, then applies environment variable
+// overrides on top - env vars still win over the file. The merged result
+// is validated exactly like Load.
+func LoadFromFile(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: read %s: %w", path, err)
+	}
+
+	def := defaultConfig()
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, def); err != nil {
+			return nil, fmt.Errorf("config: parse yaml %s: %w", path, err)
+		}
+	case ".toml":
+		if err := toml.Unmarshal(data, def); err != nil {
+			return nil, fmt.Errorf("config: parse toml %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("config: unsupported file extension %q", ext)
+	}
+
+	return loadFromDefaults(def)
+}
@@ -0,0 +1,239 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadFromFileYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	body := "audio:\n  sample_rate: 44100\nauto_answer:\n  cooldown_seconds: 5\n"
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	cfg, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+	if cfg.Audio.SampleRate != 44100 {
+		t.Errorf("Audio.SampleRate = %d, want 44100", cfg.Audio.SampleRate)
+	}
+	if cfg.AutoAnswer.CooldownSeconds != 5 {
+		t.Errorf("AutoAnswer.CooldownSeconds = %f, want 5", cfg.AutoAnswer.CooldownSeconds)
+	}
+	// Unset fields should keep hardcoded defaults.
+	if cfg.Platform.HTTPAddr != ":8000" {
+		t.Errorf("Platform.HTTPAddr = %q, want :8000", cfg.Platform.HTTPAddr)
+	}
+}
+
+func TestLoadFromFileTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	body := "[screen]\ncapture_rate = 2.0\n"
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	cfg, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+	if cfg.Screen.CaptureRate != 2.0 {
+		t.Errorf("Screen.CaptureRate = %f, want 2.0", cfg.Screen.CaptureRate)
+	}
+}
+
+func TestLoadFromFileEnvOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	body := "audio:\n  sample_rate: 44100\n"
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	os.Setenv("SAMPLE_RATE", "22050")
+	defer os.Unsetenv("SAMPLE_RATE")
+
+	cfg, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+	if cfg.Audio.SampleRate != 22050 {
+		t.Errorf("Audio.SampleRate = %d, want env override 22050", cfg.Audio.SampleRate)
+	}
+}
+
+func TestLoadUsesConfigFileEnvVar(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	body := "audio:\n  sample_rate: 44100\n"
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	os.Setenv("CONFIG_FILE", path)
+	defer os.Unsetenv("CONFIG_FILE")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Audio.SampleRate != 44100 {
+		t.Errorf("Audio.SampleRate = %d, want 44100 (from CONFIG_FILE)", cfg.Audio.SampleRate)
+	}
+}
+
+func TestLoadFromFileRejectsInvalidValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	body := "audio:\n  sample_rate: 12345\n"
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, err := LoadFromFile(path); err == nil {
+		t.Fatal("expected validation error for unsupported sample rate")
+	}
+}
+
+func TestLoadFromFileUnsupportedExtension(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte("{}"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, err := LoadFromFile(path); err == nil {
+		t.Fatal("expected error for unsupported file extension")
+	}
+}
+
+func TestStoreWatchRejectsInvalidReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("audio:\n  sample_rate: 16000\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	store, err := NewStore(path)
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloaded := make(chan *Config, 1)
+	if err := store.Watch(ctx, func(_, next *Config) { reloaded <- next }); err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	// Write an invalid sample rate - the store must keep serving the old config.
+	if err := os.WriteFile(path, []byte("audio:\n  sample_rate: 99999\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	select {
+	case <-reloaded:
+		t.Fatal("onReload fired for an invalid config")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if store.Current().Audio.SampleRate != 16000 {
+		t.Errorf("Current().Audio.SampleRate = %d, want unchanged 16000", store.Current().Audio.SampleRate)
+	}
+}
+
+func TestStoreWatchAppliesValidReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("audio:\n  sample_rate: 16000\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	store, err := NewStore(path)
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloaded := make(chan *Config, 1)
+	if err := store.Watch(ctx, func(_, next *Config) { reloaded <- next }); err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("audio:\n  sample_rate: 48000\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	select {
+	case next := <-reloaded:
+		if next.Audio.SampleRate != 48000 {
+			t.Errorf("reloaded Audio.SampleRate = %d, want 48000", next.Audio.SampleRate)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for reload")
+	}
+
+	if store.Current().Audio.SampleRate != 48000 {
+		t.Errorf("Current().Audio.SampleRate = %d, want 48000", store.Current().Audio.SampleRate)
+	}
+}
+
+func TestStoreSubscribeReceivesTunableChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("logging:\n  level: info\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	store, err := NewStore(path)
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+
+	sub := store.Subscribe(TunableLogLevel)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := store.Watch(ctx, nil); err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("logging:\n  level: debug\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	select {
+	case next := <-sub:
+		if next.Logging.Level != "DEBUG" {
+			t.Errorf("Logging.Level = %q, want DEBUG", next.Logging.Level)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for tunable change")
+	}
+}
+
+func TestConcurrentCurrentReadsAreSafe(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("audio:\n  sample_rate: 16000\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	store, err := NewStore(path)
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			_ = store.Current()
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		_ = store.Current()
+	}
+	<-done
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// FieldKind enumerates the JSON Schema primitive types a Field maps to.
+type FieldKind string
+
+const (
+	KindInt    FieldKind = "integer"
+	KindFloat  FieldKind = "number"
+	KindString FieldKind = "string"
+)
+
+// Field describes one value Validate constrains: its dotted config path,
+// JSON kind, and the constraint Validate enforces (Min/Max for a numeric
+// range, Enum for a fixed set of allowed values). Schema is the single
+// source of truth both Validate and JSONSchema read from, so the Go
+// validation and the generated schema for the TS frontend and Python
+// inference service can't drift apart.
+type Field struct {
+	Path string // dotted path, e.g. "audio.vad_threshold"
+	Kind FieldKind
+	Min  *float64
+	Max  *float64
+	Enum []any
+}
+
+// Schema returns the descriptor for every field Validate constrains, in the
+// same order Validate checks them.
+func Schema() []Field {
+	return []Field{
+		{Path: "audio.sample_rate", Kind: KindInt, Enum: []any{8000, 16000, 22050, 44100, 48000}},
+		{Path: "audio.vad_threshold", Kind: KindFloat, Min: floatPtr(0), Max: floatPtr(1)},
+		{Path: "audio.max_silence_chunks", Kind: KindInt, Min: floatPtr(1)},
+		{Path: "audio.preroll_ms", Kind: KindInt, Min: floatPtr(0)},
+		{Path: "audio.normalization_mode", Kind: KindString, Enum: []any{"off", "rms", "ebur128"}},
+		{Path: "audio.diarization_threshold", Kind: KindFloat, Min: floatPtr(0), Max: floatPtr(1)},
+		{Path: "audio.max_speakers", Kind: KindInt, Min: floatPtr(1)},
+		{Path: "screen.capture_rate", Kind: KindFloat, Min: floatPtr(0.1), Max: floatPtr(10)},
+		{Path: "screen.phash_similarity_threshold", Kind: KindFloat, Min: floatPtr(0), Max: floatPtr(1)},
+		{Path: "memory.batch_max_size", Kind: KindInt, Min: floatPtr(1)},
+		{Path: "auto_answer.cooldown_seconds", Kind: KindFloat, Min: floatPtr(0)},
+		{Path: "auto_answer.min_question_length", Kind: KindInt, Min: floatPtr(1)},
+		{Path: "platform.http_tls.min_version", Kind: KindString, Enum: []any{"VersionTLS12", "VersionTLS13"}},
+		{Path: "platform.inference_tls.min_version", Kind: KindString, Enum: []any{"VersionTLS12", "VersionTLS13"}},
+	}
+}
+
+func floatPtr(f float64) *float64 { return &f }
+
+// JSONSchema renders Schema() as a JSON Schema object document, nesting each
+// Field's dotted path into the same object structure the YAML/TOML config
+// files use, for the TS frontend and Python inference service to validate
+// against.
+func JSONSchema() ([]byte, error) {
+	root := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{},
+	}
+	for _, f := range Schema() {
+		insertSchemaField(root, f)
+	}
+	return json.MarshalIndent(root, "", "  ")
+}
+
+func insertSchemaField(root map[string]any, f Field) {
+	props := root["properties"].(map[string]any)
+	parts := strings.Split(f.Path, ".")
+	for i, part := range parts {
+		if i == len(parts)-1 {
+			props[part] = fieldSchema(f)
+			return
+		}
+		child, ok := props[part].(map[string]any)
+		if !ok {
+			child = map[string]any{"type": "object", "properties": map[string]any{}}
+			props[part] = child
+		}
+		props = child["properties"].(map[string]any)
+	}
+}
+
+func fieldSchema(f Field) map[string]any {
+	s := map[string]any{"type": string(f.Kind)}
+	if f.Min != nil {
+		s["minimum"] = *f.Min
+	}
+	if f.Max != nil {
+		s["maximum"] = *f.Max
+	}
+	if len(f.Enum) > 0 {
+		s["enum"] = f.Enum
+	}
+	return s
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONSchemaNestsDottedPaths(t *testing.T) {
+	data, err := JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema() error = %v", err)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	audio, ok := doc["properties"].(map[string]any)["audio"].(map[string]any)
+	if !ok {
+		t.Fatal("schema missing \"audio\" object property")
+	}
+	vad, ok := audio["properties"].(map[string]any)["vad_threshold"].(map[string]any)
+	if !ok {
+		t.Fatal("schema missing \"audio.vad_threshold\" property")
+	}
+	if vad["type"] != "number" {
+		t.Errorf("audio.vad_threshold type = %v, want number", vad["type"])
+	}
+	if vad["minimum"] != 0.0 || vad["maximum"] != 1.0 {
+		t.Errorf("audio.vad_threshold range = [%v, %v], want [0, 1]", vad["minimum"], vad["maximum"])
+	}
+}
+
+func TestJSONSchemaEncodesEnum(t *testing.T) {
+	data, err := JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema() error = %v", err)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	audio := doc["properties"].(map[string]any)["audio"].(map[string]any)
+	sampleRate := audio["properties"].(map[string]any)["sample_rate"].(map[string]any)
+	enum, ok := sampleRate["enum"].([]any)
+	if !ok || len(enum) != 5 {
+		t.Fatalf("audio.sample_rate enum = %v, want 5 entries", sampleRate["enum"])
+	}
+}
+
+func TestSchemaCoversEveryValidatedField(t *testing.T) {
+	fields := Schema()
+	if len(fields) == 0 {
+		t.Fatal("Schema() returned no fields")
+	}
+	seen := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if seen[f.Path] {
+			t.Errorf("duplicate field path %q", f.Path)
+		}
+		seen[f.Path] = true
+	}
+}
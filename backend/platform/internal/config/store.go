@@ -0,0 +1,97 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Store holds a live, hot-reloadable Config loaded from a file. Reads are
+// lock-free via an atomic pointer swap, and a reload is only applied if it
+// passes Validate - an invalid file on disk leaves the previous config in
+// place.
+type Store struct {
+	path string
+	ptr  atomic.Pointer[Config]
+	bus  *bus
+}
+
+// NewStore loads path and returns a Store serving it.
+func NewStore(path string) (*Store, error) {
+	cfg, err := LoadFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{path: path, bus: newBus()}
+	s.ptr.Store(cfg)
+	return s, nil
+}
+
+// Current returns the live config. Safe for concurrent use.
+func (s *Store) Current() *Config {
+	return s.ptr.Load()
+}
+
+// Watch watches the backing file for changes and re-validates on every
+// write. A reload only swaps the live config if it passes Validate;
+// otherwise the failure is logged and the previous config keeps serving.
+// onReload, if non-nil, is called with (old, new) after each successful
+// swap. Watch returns once the watcher is established; the watch loop runs
+// until ctx is canceled.
+func (s *Store) Watch(ctx context.Context, onReload func(old, next *Config)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("config: create watcher: %w", err)
+	}
+	if err := watcher.Add(s.path); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("config: watch %s: %w", s.path, err)
+	}
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+				s.reload(onReload)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				slog.Warn("config watcher error", "path", s.path, "error", err)
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *Store) reload(onReload func(old, next *Config)) {
+	next, err := LoadFromFile(s.path)
+	if err != nil {
+		slog.Warn("config reload rejected", "path", s.path, "error", err)
+		return
+	}
+	old := s.ptr.Swap(next)
+	if onReload != nil {
+		onReload(old, next)
+	}
+	s.bus.publish(old, next)
+}
+
+// Subscribe returns a channel that receives the new Config whenever the
+// given Tunable changes across a reload, so consumers like the auto-answer
+// cooldown or log level can pick up live changes without restarting.
+func (s *Store) Subscribe(t Tunable) <-chan *Config {
+	return s.bus.subscribe(t)
+}
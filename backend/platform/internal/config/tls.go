@@ -0,0 +1,121 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// tlsVersions maps the MinVersion strings accepted in config to their
+// crypto/tls constants. Anything below TLS 1.2 is rejected by Validate.
+var tlsVersions = map[string]uint16{
+	"VersionTLS12": tls.VersionTLS12,
+	"VersionTLS13": tls.VersionTLS13,
+}
+
+// cipherSuiteIDs maps crypto/tls cipher suite names to their IDs, covering
+// both the secure and insecure/weak suites Go knows about so Validate can
+// give a precise error instead of silently ignoring a typo'd name.
+func cipherSuiteIDs() map[string]uint16 {
+	ids := make(map[string]uint16)
+	for _, s := range tls.CipherSuites() {
+		ids[s.Name] = s.ID
+	}
+	for _, s := range tls.InsecureCipherSuites() {
+		ids[s.Name] = s.ID
+	}
+	return ids
+}
+
+// validateTLS checks t's constraints and returns Validate-style error
+// strings prefixed with path, e.g. "platform.http_tls.min_version: ...".
+func validateTLS(path string, t TLSConfig) []string {
+	if !t.Enabled {
+		return nil
+	}
+	var errs []string
+	if t.CertFile == "" || t.KeyFile == "" {
+		errs = append(errs, fmt.Sprintf("%s: cert_file and key_file are required when enabled", path))
+	}
+	if t.MinVersion != "" {
+		if _, ok := tlsVersions[t.MinVersion]; !ok {
+			errs = append(errs, fmt.Sprintf("%s.min_version must be one of [VersionTLS12, VersionTLS13], got %q", path, t.MinVersion))
+		}
+	}
+	if len(t.CipherSuites) > 0 {
+		ids := cipherSuiteIDs()
+		for _, name := range t.CipherSuites {
+			if _, ok := ids[name]; !ok {
+				errs = append(errs, fmt.Sprintf("%s.cipher_suites: unknown suite %q", path, name))
+			}
+		}
+	}
+	return errs
+}
+
+// loadTLSFromEnv applies environment variable overrides on top of def,
+// following the prefix_FIELD convention other sections use (e.g. prefix
+// "HTTP_TLS" reads HTTP_TLS_ENABLED, HTTP_TLS_CERT_FILE, ...).
+func loadTLSFromEnv(prefix string, def TLSConfig) TLSConfig {
+	return TLSConfig{
+		Enabled:      getEnvBool(prefix+"_ENABLED", def.Enabled),
+		CertFile:     getEnv(prefix+"_CERT_FILE", def.CertFile),
+		KeyFile:      getEnv(prefix+"_KEY_FILE", def.KeyFile),
+		ClientCAFile: getEnv(prefix+"_CLIENT_CA_FILE", def.ClientCAFile),
+		MinVersion:   getEnv(prefix+"_MIN_VERSION", def.MinVersion),
+		CipherSuites: getEnvList(prefix+"_CIPHER_SUITES", def.CipherSuites),
+	}
+}
+
+// Build turns t into a *tls.Config ready for http.Server.TLSConfig,
+// credentials.NewTLS, or grpc.NewServer's grpc.Creds. Callers that only need
+// a client-side config verifying a peer's server certificate can ignore the
+// resulting ClientCAs/ClientAuth fields; callers terminating mTLS
+// (HTTPTLS.ClientCAFile set) get RequireAndVerifyClientCert for free. Returns
+// nil, nil when t is disabled.
+func (t TLSConfig) Build() (*tls.Config, error) {
+	if !t.Enabled {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if v, ok := tlsVersions[t.MinVersion]; ok {
+		cfg.MinVersion = v
+	}
+	if len(t.CipherSuites) > 0 {
+		ids := cipherSuiteIDs()
+		for _, name := range t.CipherSuites {
+			if id, ok := ids[name]; ok {
+				cfg.CipherSuites = append(cfg.CipherSuites, id)
+			}
+		}
+	}
+
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("config: load tls cert/key: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if t.ClientCAFile != "" {
+		pem, err := os.ReadFile(t.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("config: read tls ca %s: %w", t.ClientCAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("config: no certificates parsed from %s", t.ClientCAFile)
+		}
+		// Serves both roles: the server side consults ClientCAs to verify
+		// incoming client certs, the dialing side consults RootCAs to
+		// verify the server it's connecting to.
+		cfg.ClientCAs = pool
+		cfg.RootCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return cfg, nil
+}
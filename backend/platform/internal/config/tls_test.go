@@ -0,0 +1,129 @@
+package config
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestCert writes a self-signed cert/key pair to dir and returns their paths.
+func writeTestCert(t *testing.T, dir string) (certPath, keyPath string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+
+	certPath = filepath.Join(dir, "cert.pem")
+	keyPath = filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644); err != nil {
+		t.Fatalf("WriteFile cert: %v", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o644); err != nil {
+		t.Fatalf("WriteFile key: %v", err)
+	}
+	return certPath, keyPath
+}
+
+func TestTLSConfigBuildDisabledReturnsNil(t *testing.T) {
+	cfg, err := (TLSConfig{}).Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if cfg != nil {
+		t.Errorf("Build() = %v, want nil for disabled config", cfg)
+	}
+}
+
+func TestTLSConfigBuildLoadsCertAndMinVersion(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath := writeTestCert(t, dir)
+
+	tlsCfg, err := TLSConfig{
+		Enabled:    true,
+		CertFile:   certPath,
+		KeyFile:    keyPath,
+		MinVersion: "VersionTLS13",
+	}.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if tlsCfg.MinVersion != tls.VersionTLS13 {
+		t.Errorf("MinVersion = %x, want VersionTLS13", tlsCfg.MinVersion)
+	}
+	if len(tlsCfg.Certificates) != 1 {
+		t.Fatalf("Certificates = %d, want 1", len(tlsCfg.Certificates))
+	}
+}
+
+func TestTLSConfigBuildDefaultsMinVersionTo12(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath := writeTestCert(t, dir)
+
+	tlsCfg, err := TLSConfig{Enabled: true, CertFile: certPath, KeyFile: keyPath}.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if tlsCfg.MinVersion != tls.VersionTLS12 {
+		t.Errorf("MinVersion = %x, want VersionTLS12 default", tlsCfg.MinVersion)
+	}
+}
+
+func TestValidateTLSRejectsVersionBelow12(t *testing.T) {
+	c := defaultConfig()
+	c.Platform.HTTPTLS = TLSConfig{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem", MinVersion: "VersionTLS11"}
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected validation error for min_version below TLS 1.2")
+	}
+}
+
+func TestValidateTLSRejectsUnknownCipherSuite(t *testing.T) {
+	c := defaultConfig()
+	c.Platform.InferenceTLS = TLSConfig{
+		Enabled:      true,
+		CertFile:     "cert.pem",
+		KeyFile:      "key.pem",
+		CipherSuites: []string{"NOT_A_REAL_SUITE"},
+	}
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected validation error for unknown cipher suite")
+	}
+}
+
+func TestValidateTLSRequiresCertAndKeyWhenEnabled(t *testing.T) {
+	c := defaultConfig()
+	c.Platform.HTTPTLS = TLSConfig{Enabled: true}
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected validation error for missing cert_file/key_file")
+	}
+}
+
+func TestValidateTLSSkipsDisabledConfig(t *testing.T) {
+	c := defaultConfig()
+	c.Platform.HTTPTLS = TLSConfig{MinVersion: "bogus"}
+	if err := c.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil for disabled tls config", err)
+	}
+}
@@ -0,0 +1,178 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+// Dispatcher fans events out to every registered Sink concurrently, each
+// through its own bounded worker pool so one slow or failing sink can't
+// block or lose events meant for the others.
+type Dispatcher struct {
+	mu    sync.RWMutex
+	sinks map[string]*sinkWorker
+}
+
+// NewDispatcher creates an empty Dispatcher; sinks are registered with AddSink.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{sinks: make(map[string]*sinkWorker)}
+}
+
+// AddSink registers sink under name, closing and replacing any sink already
+// registered under that name.
+func (d *Dispatcher) AddSink(name string, sink Sink) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if existing, ok := d.sinks[name]; ok {
+		existing.close()
+	}
+	d.sinks[name] = newSinkWorker(name, sink)
+}
+
+// Publish fans event out to every registered sink's worker pool. It never
+// blocks on a sink: a sink whose queue is saturated drops the event and
+// counts it rather than holding up the caller, which is always on a hot
+// path (a transcript, screen-text, or auto-answer handler).
+func (d *Dispatcher) Publish(ctx context.Context, topic string, event Event) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	for _, w := range d.sinks {
+		w.publish(ctx, topic, event)
+	}
+}
+
+// SinkStats is a point-in-time snapshot of one sink's delivery counters.
+type SinkStats struct {
+	Published int64
+	Retries   int64
+	Dropped   int64
+}
+
+// Stats returns every registered sink's delivery counters, keyed by the name
+// it was registered under.
+func (d *Dispatcher) Stats() map[string]SinkStats {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	stats := make(map[string]SinkStats, len(d.sinks))
+	for name, w := range d.sinks {
+		stats[name] = w.stats()
+	}
+	return stats
+}
+
+// Close stops every registered sink's workers and closes its underlying Sink.
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, w := range d.sinks {
+		w.close()
+	}
+	d.sinks = make(map[string]*sinkWorker)
+}
+
+const (
+	sinkWorkerPoolSize  = 4
+	sinkWorkerQueueSize = 256
+	sinkMaxRetries      = 3
+)
+
+// sinkWorker runs one Sink behind a bounded queue and worker pool, retrying
+// a transient publish failure with backoff and counting one that exhausts
+// its retry budget instead of blocking the caller or losing it silently.
+type sinkWorker struct {
+	name string
+	sink Sink
+
+	queue chan queuedEvent
+	wg    sync.WaitGroup
+
+	published int64
+	retries   int64
+	dropped   int64
+}
+
+type queuedEvent struct {
+	ctx   context.Context
+	topic string
+	event Event
+}
+
+func newSinkWorker(name string, sink Sink) *sinkWorker {
+	w := &sinkWorker{
+		name:  name,
+		sink:  sink,
+		queue: make(chan queuedEvent, sinkWorkerQueueSize),
+	}
+	for i := 0; i < sinkWorkerPoolSize; i++ {
+		w.wg.Add(1)
+		go w.run()
+	}
+	return w
+}
+
+func (w *sinkWorker) publish(ctx context.Context, topic string, event Event) {
+	select {
+	case w.queue <- queuedEvent{ctx: ctx, topic: topic, event: event}:
+	default:
+		atomic.AddInt64(&w.dropped, 1)
+		slog.Warn("event sink queue full, dropping event", "sink", w.name, "topic", topic)
+	}
+}
+
+func (w *sinkWorker) run() {
+	defer w.wg.Done()
+	for qe := range w.queue {
+		w.deliver(qe)
+	}
+}
+
+// deliver retries a failing publish with backoff (base 200ms, factor 2,
+// capped at 5s, +/-20% jitter, up to sinkMaxRetries retries), same curve
+// shape as the memory batcher's flush retry, then counts the outcome.
+func (w *sinkWorker) deliver(qe queuedEvent) {
+	retryCfg := resilience.RetryConfig{
+		MaxRetries: sinkMaxRetries,
+		Strategy: resilience.NewBackoff(resilience.BackoffConfig{
+			BaseDelay: 200 * time.Millisecond,
+			Factor:    2,
+			Jitter:    0.2,
+			MaxDelay:  5 * time.Second,
+		}),
+		IsRetryable: resilience.IsRetryableGRPC,
+	}
+
+	attempts := 0
+	err := resilience.Retry(qe.ctx, retryCfg, func() error {
+		if attempts > 0 {
+			atomic.AddInt64(&w.retries, 1)
+		}
+		attempts++
+		return w.sink.Publish(qe.ctx, qe.topic, qe.event)
+	})
+
+	if err != nil {
+		atomic.AddInt64(&w.dropped, 1)
+		slog.Warn("event sink publish exhausted its retry budget", "sink", w.name, "topic", qe.topic, "error", err, "attempts", attempts)
+		return
+	}
+	atomic.AddInt64(&w.published, 1)
+}
+
+func (w *sinkWorker) stats() SinkStats {
+	return SinkStats{
+		Published: atomic.LoadInt64(&w.published),
+		Retries:   atomic.LoadInt64(&w.retries),
+		Dropped:   atomic.LoadInt64(&w.dropped),
+	}
+}
+
+func (w *sinkWorker) close() {
+	close(w.queue)
+	w.wg.Wait()
+	_ = w.sink.Close()
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"log/slog"
+	"time"
+)
+
+// FactoryConfig mirrors config.EventSinksConfig; kept separate so this
+// package doesn't import internal/config, matching how internal/sinks keeps
+// its own Config rather than depending on the config package directly.
+type FactoryConfig struct {
+	Kafka struct {
+		Enabled bool
+		Brokers []string
+		Topic   string
+	}
+	NATS struct {
+		Enabled bool
+		URL     string
+		Subject string
+	}
+	Webhook struct {
+		Enabled   bool
+		URL       string
+		TimeoutMs int
+	}
+}
+
+// BuildSinks constructs every enabled sink in cfg and registers it on d. A
+// sink whose constructor fails is logged and skipped rather than aborting
+// startup, matching how sinks.New degrades to a null sink on failure.
+func BuildSinks(d *Dispatcher, cfg FactoryConfig) {
+	if cfg.Kafka.Enabled {
+		sink, err := NewKafkaSink(KafkaConfig{Brokers: cfg.Kafka.Brokers, Topic: cfg.Kafka.Topic})
+		if err != nil {
+			slog.Warn("events: kafka sink init failed, skipping", "error", err)
+		} else {
+			d.AddSink("kafka", sink)
+		}
+	}
+
+	if cfg.NATS.Enabled {
+		sink, err := NewNATSSink(NATSConfig{URL: cfg.NATS.URL, Subject: cfg.NATS.Subject})
+		if err != nil {
+			slog.Warn("events: nats sink init failed, skipping", "error", err)
+		} else {
+			d.AddSink("nats", sink)
+		}
+	}
+
+	if cfg.Webhook.Enabled {
+		sink, err := NewWebhookSink(WebhookConfig{
+			URL:     cfg.Webhook.URL,
+			Timeout: time.Duration(cfg.Webhook.TimeoutMs) * time.Millisecond,
+		})
+		if err != nil {
+			slog.Warn("events: webhook sink init failed, skipping", "error", err)
+		} else {
+			d.AddSink("webhook", sink)
+		}
+	}
+}
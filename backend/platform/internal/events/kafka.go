@@ -0,0 +1,65 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// KafkaConfig addresses a Kafka cluster and the topic KafkaSink publishes to
+// by default (Publish's topic argument still wins when non-empty).
+type KafkaConfig struct {
+	Brokers []string
+	Topic   string
+}
+
+// KafkaSink publishes events to Kafka through a synchronous producer, so a
+// failed publish surfaces as an error the Dispatcher's retry/backoff can act
+// on rather than being silently buffered.
+type KafkaSink struct {
+	cfg      KafkaConfig
+	producer sarama.SyncProducer
+}
+
+// NewKafkaSink dials cfg.Brokers and returns a sink backed by a sync producer
+// configured for at-least-once delivery (RequiredAcks = WaitForAll).
+func NewKafkaSink(cfg KafkaConfig) (*KafkaSink, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("events: kafka sink requires at least one broker")
+	}
+
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+	saramaCfg.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(cfg.Brokers, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("events: kafka producer: %w", err)
+	}
+
+	return &KafkaSink{cfg: cfg, producer: producer}, nil
+}
+
+func (s *KafkaSink) Publish(_ context.Context, topic string, event Event) error {
+	if topic == "" {
+		topic = s.cfg.Topic
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("events: marshal kafka event: %w", err)
+	}
+
+	_, _, err = s.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(payload),
+	})
+	if err != nil {
+		return fmt.Errorf("events: kafka publish: %w", err)
+	}
+	return nil
+}
+
+func (s *KafkaSink) Close() error { return s.producer.Close() }
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSConfig addresses a NATS server and the subject NATSSink publishes to
+// by default (Publish's topic argument still wins when non-empty).
+type NATSConfig struct {
+	URL     string
+	Subject string
+}
+
+// NATSSink publishes events to a NATS subject.
+type NATSSink struct {
+	cfg  NATSConfig
+	conn *nats.Conn
+}
+
+// NewNATSSink connects to cfg.URL and returns a sink backed by that connection.
+func NewNATSSink(cfg NATSConfig) (*NATSSink, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("events: nats sink requires a URL")
+	}
+
+	conn, err := nats.Connect(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("events: nats connect: %w", err)
+	}
+
+	return &NATSSink{cfg: cfg, conn: conn}, nil
+}
+
+func (s *NATSSink) Publish(_ context.Context, topic string, event Event) error {
+	if topic == "" {
+		topic = s.cfg.Subject
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("events: marshal nats event: %w", err)
+	}
+
+	if err := s.conn.Publish(topic, payload); err != nil {
+		return fmt.Errorf("events: nats publish: %w", err)
+	}
+	return nil
+}
+
+func (s *NATSSink) Close() error {
+	s.conn.Close()
+	return nil
+}
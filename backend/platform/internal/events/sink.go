@@ -0,0 +1,35 @@
+// Package events fans transcript-final, screen-text-stable, and auto-answer
+// events out to external systems (dashboards, archives, downstream LLM
+// workers) through a pluggable Sink, so a consumer doesn't need gRPC or
+// WebSocket access to this process to watch the session unfold.
+package events
+
+import (
+	"context"
+	"time"
+)
+
+// Event is the stable schema every Sink receives, regardless of which part
+// of the pipeline produced it.
+type Event struct {
+	ID       int64     `json:"id"`
+	Ts       time.Time `json:"ts"`
+	Source   string    `json:"source"` // "user", "system", "screen", etc - whatever produced it
+	Kind     string    `json:"kind"`   // "transcript", "screen_text", or "auto_answer"
+	Text     string    `json:"text"`
+	DeviceID string    `json:"deviceId"` // "" when the producing layer doesn't track a per-device ID
+}
+
+// Sink publishes events to an external system under topic (e.g. a Kafka
+// topic, a NATS subject, or just a tag included in a webhook's JSON body).
+// Implementations must be safe for concurrent use.
+type Sink interface {
+	Publish(ctx context.Context, topic string, event Event) error
+	Close() error
+}
+
+var (
+	_ Sink = (*KafkaSink)(nil)
+	_ Sink = (*NATSSink)(nil)
+	_ Sink = (*WebhookSink)(nil)
+)
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookConfig controls where WebhookSink posts events and how long it
+// waits for the remote end to accept them.
+type WebhookConfig struct {
+	URL     string
+	Timeout time.Duration // 0 means DefaultWebhookTimeout
+}
+
+// DefaultWebhookTimeout bounds a single webhook POST when Timeout is unset.
+const DefaultWebhookTimeout = 5 * time.Second
+
+func (c WebhookConfig) withDefaults() WebhookConfig {
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultWebhookTimeout
+	}
+	return c
+}
+
+// WebhookSink JSON-POSTs each event to a configured URL, the lowest-friction
+// way for a downstream consumer (a dashboard, an archive, an LLM worker) to
+// receive events without speaking gRPC.
+type WebhookSink struct {
+	cfg    WebhookConfig
+	client *http.Client
+}
+
+// webhookPayload is the body WebhookSink POSTs; topic rides alongside the
+// event itself since a single webhook URL may be shared across topics.
+type webhookPayload struct {
+	Topic string `json:"topic"`
+	Event Event  `json:"event"`
+}
+
+// NewWebhookSink returns a sink posting to cfg.URL.
+func NewWebhookSink(cfg WebhookConfig) (*WebhookSink, error) {
+	cfg = cfg.withDefaults()
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("events: webhook sink requires a URL")
+	}
+	return &WebhookSink{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, topic string, event Event) error {
+	body, err := json.Marshal(webhookPayload{Topic: topic, Event: event})
+	if err != nil {
+		return fmt.Errorf("events: marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("events: build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("events: webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("events: webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close is a no-op; WebhookSink holds no long-lived connection to release.
+func (s *WebhookSink) Close() error { return nil }
@@ -3,20 +3,27 @@ package grpcclient
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"io"
 	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/sinks"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
 	pb "github.com/GriffinCanCode/good-listener/backend/platform/pkg/pb"
 )
@@ -42,29 +49,59 @@ type ClientConfig struct {
 	KeepaliveTimeout    time.Duration
 	HealthCheckInterval time.Duration
 	BreakerConfig       resilience.Config
+
+	// BackoffConfig drives the gRPC-style connection backoff used by
+	// WaitReady polling, monitorHealth's post-failure retry delay, and the
+	// LLM retry paths (AnalyzeStream, SummarizeTranscript). Tests and
+	// integration harnesses can inject a deterministic config here.
+	BackoffConfig resilience.BackoffConfig
+
+	// Sink optionally persists transcripts and LLM outputs as they're
+	// produced. Defaults to sinks.NewNullSink() (discard) when nil.
+	Sink sinks.Sink
+
+	// DefaultMetadata is attached as outgoing gRPC metadata on every call
+	// made through this Client (e.g. x-session-id, x-user-id, x-request-id,
+	// x-locale), letting the Python side scope memory/OCR/LLM operations per
+	// session. Per-call metadata set via WithMetadata overrides these keys.
+	DefaultMetadata map[string]string
+
+	// TLS secures the connection to the inference server, with mTLS when it
+	// carries client certificates. Nil dials insecure, as before.
+	TLS *tls.Config
 }
 
 // DefaultConfig returns production-ready defaults.
 func DefaultConfig() ClientConfig {
+	breakerCfg := resilience.DefaultConfig()
+	breakerCfg.Classifier = resilience.GRPCClassifier
 	return ClientConfig{
 		KeepaliveTime:       DefaultKeepaliveTime,
 		KeepaliveTimeout:    DefaultKeepaliveTimeout,
 		HealthCheckInterval: DefaultHealthCheckInterval,
-		BreakerConfig:       resilience.DefaultConfig(),
+		BreakerConfig:       breakerCfg,
+		BackoffConfig:       resilience.DefaultBackoffConfig(),
 	}
 }
 
 // Client wraps all inference service clients.
 type Client struct {
-	conn          *grpc.ClientConn
-	Transcription pb.TranscriptionServiceClient
-	VAD           pb.VADServiceClient
-	OCR           pb.OCRServiceClient
-	LLM           pb.LLMServiceClient
-	Memory        pb.MemoryServiceClient
-	Health        grpc_health_v1.HealthClient
-	cb            *resilience.Breaker
-	healthCancel  context.CancelFunc
+	*lifecycle
+	conn           *grpc.ClientConn
+	Transcription  pb.TranscriptionServiceClient
+	VAD            pb.VADServiceClient
+	OCR            pb.OCRServiceClient
+	LLM            pb.LLMServiceClient
+	Memory         pb.MemoryServiceClient
+	Health         grpc_health_v1.HealthClient
+	cb             *resilience.Breaker
+	reattached     bool // true if attached to an out-of-band server via NewReattach
+	backoffCfg     resilience.BackoffConfig
+	healthInterval time.Duration
+	sink           sinks.Sink
+	lastCallInfo   atomic.Value // holds CallInfo
+	closeOnce      sync.Once
+	closeErr       error
 }
 
 // New creates a new inference client with default config.
@@ -72,54 +109,105 @@ func New(addr string) (*Client, error) {
 	return NewWithConfig(addr, DefaultConfig())
 }
 
-// NewWithConfig creates a client with custom configuration.
+// NewWithConfig creates a client with custom configuration and starts its
+// health-monitoring lifecycle, equivalent to calling Start(context.Background()).
 func NewWithConfig(addr string, cfg ClientConfig) (*Client, error) {
+	defaultMD := toOutgoingMD(cfg.DefaultMetadata)
+
+	transportCreds := insecure.NewCredentials()
+	if cfg.TLS != nil {
+		transportCreds = credentials.NewTLS(cfg.TLS)
+	}
+
 	conn, err := grpc.Dial(addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(transportCreds),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time:                cfg.KeepaliveTime,
 			Timeout:             cfg.KeepaliveTimeout,
 			PermitWithoutStream: true,
 		}),
 		grpc.WithDefaultServiceConfig(`{"healthCheckConfig":{"serviceName":""}}`),
-		grpc.WithUnaryInterceptor(trace.UnaryClientInterceptor()),
-		grpc.WithStreamInterceptor(trace.StreamClientInterceptor()),
+		grpc.WithChainUnaryInterceptor(defaultMetadataUnaryInterceptor(defaultMD), trace.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(defaultMetadataStreamInterceptor(defaultMD), trace.StreamClientInterceptor()),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	sink := cfg.Sink
+	if sink == nil {
+		sink = sinks.NewNullSink()
+	}
+
 	c := &Client{
-		conn:          conn,
-		Transcription: pb.NewTranscriptionServiceClient(conn),
-		VAD:           pb.NewVADServiceClient(conn),
-		OCR:           pb.NewOCRServiceClient(conn),
-		LLM:           pb.NewLLMServiceClient(conn),
-		Memory:        pb.NewMemoryServiceClient(conn),
-		Health:        grpc_health_v1.NewHealthClient(conn),
-		cb:            resilience.New(cfg.BreakerConfig),
+		lifecycle:      newLifecycle(),
+		conn:           conn,
+		Transcription:  pb.NewTranscriptionServiceClient(conn),
+		VAD:            pb.NewVADServiceClient(conn),
+		OCR:            pb.NewOCRServiceClient(conn),
+		LLM:            pb.NewLLMServiceClient(conn),
+		Memory:         pb.NewMemoryServiceClient(conn),
+		Health:         grpc_health_v1.NewHealthClient(conn),
+		backoffCfg:     cfg.BackoffConfig,
+		healthInterval: cfg.HealthCheckInterval,
+		sink:           sink,
 	}
+	c.cb = resilience.New(cfg.BreakerConfig).WithHook(c.onBreakerTransition)
 
-	ctx, cancel := context.WithCancel(context.Background())
-	c.healthCancel = cancel
-	go c.monitorHealth(ctx, cfg.HealthCheckInterval)
+	_ = c.Start(context.Background())
 
 	return c, nil
 }
 
-// monitorHealth periodically checks server health.
+// Start begins health monitoring, transitioning New -> Starting. It is
+// idempotent: subsequent calls are no-ops. ctx bounds the monitor goroutine's
+// lifetime in addition to Stop.
+func (c *Client) Start(ctx context.Context) error {
+	c.startOnce.Do(func() {
+		c.setState(StateStarting)
+		hctx, cancel := context.WithCancel(ctx)
+		c.cancel = cancel
+		go func() {
+			c.monitorHealth(hctx, c.healthInterval)
+			c.setState(StateStopped)
+			close(c.stoppedCh)
+		}()
+	})
+	return nil
+}
+
+// Stop begins shutdown, transitioning to Stopping and canceling the health
+// monitor goroutine. It does not block; use Wait for that. Idempotent.
+func (c *Client) Stop() {
+	c.stopOnce.Do(func() {
+		c.setState(StateStopping)
+		if c.cancel != nil {
+			c.cancel()
+		}
+	})
+}
+
+// monitorHealth periodically checks server health. It polls at interval
+// while healthy, but switches to a growing connection-backoff delay once a
+// check fails, so a degraded server doesn't get hammered at the normal
+// cadence; a successful SERVING response resets back to interval.
 func (c *Client) monitorHealth(ctx context.Context, interval time.Duration) {
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
+	backoff := resilience.NewBackoff(c.backoffCfg)
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case <-timer.C:
 			if err := c.checkHealth(ctx); err != nil {
 				slog.Debug("health check failed", "error", err)
+				timer.Reset(backoff.Next())
+				continue
 			}
+			backoff.Reset()
+			timer.Reset(interval)
 		}
 	}
 }
@@ -132,13 +220,16 @@ func (c *Client) checkHealth(ctx context.Context) error {
 	resp, err := c.Health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
 	if err != nil {
 		c.cb.Failure()
+		c.markDegraded()
 		return err
 	}
 	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
 		c.cb.Failure()
+		c.markDegraded()
 		return ErrServerDown
 	}
 	c.cb.Success()
+	c.markReady()
 	return nil
 }
 
@@ -153,28 +244,38 @@ func (c *Client) CheckHealth(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-// WaitReady blocks until the inference server is available or timeout.
+// WaitReady blocks until the inference server is available or timeout. The
+// polling interval starts at StartupPollInterval and grows via connection
+// backoff on each failed probe, rather than hammering a slow-starting
+// server at a fixed cadence.
 func (c *Client) WaitReady(ctx context.Context, timeout time.Duration) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	ticker := time.NewTicker(StartupPollInterval)
-	defer ticker.Stop()
+	backoffCfg := c.backoffCfg
+	if backoffCfg.BaseDelay <= 0 {
+		backoffCfg.BaseDelay = StartupPollInterval
+	}
+	backoff := resilience.NewBackoff(backoffCfg)
+	timer := time.NewTimer(StartupPollInterval)
+	defer timer.Stop()
 
 	slog.Info("waiting for inference server", "timeout", timeout)
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-ticker.C:
+		case <-timer.C:
 			checkCtx, checkCancel := context.WithTimeout(ctx, HealthCheckTimeout)
 			resp, err := c.Health.Check(checkCtx, &grpc_health_v1.HealthCheckRequest{})
 			checkCancel()
 			if err == nil && resp.Status == grpc_health_v1.HealthCheckResponse_SERVING {
 				slog.Info("inference server ready")
 				c.cb.Reset() // Clear any startup failures
+				c.markReady()
 				return nil
 			}
+			timer.Reset(backoff.Next())
 		}
 	}
 }
@@ -194,6 +295,17 @@ func (c *Client) Breaker() *resilience.Breaker {
 	return c.cb
 }
 
+// SetSink replaces the artifact sink transcripts and LLM outputs are
+// persisted to. Passing nil restores the discard-everything default. Not
+// safe to call concurrently with Transcribe/AnalyzeStream/SummarizeTranscript;
+// call it once right after construction, before the client serves traffic.
+func (c *Client) SetSink(sink sinks.Sink) {
+	if sink == nil {
+		sink = sinks.NewNullSink()
+	}
+	c.sink = sink
+}
+
 // withBreaker wraps a call with circuit breaker logic.
 func (c *Client) withBreaker(fn func() error) error {
 	if err := c.cb.Allow(); err != nil {
@@ -208,6 +320,15 @@ func (c *Client) withBreaker(fn func() error) error {
 	return err
 }
 
+// llmRetryConfig returns LLMRetryConfig with its delay schedule replaced by
+// a fresh connection-backoff Strategy built from the client's BackoffConfig,
+// so LLM retry paths grow delays the same way WaitReady/monitorHealth do.
+func (c *Client) llmRetryConfig() resilience.RetryConfig {
+	cfg := resilience.LLMRetryConfig()
+	cfg.Strategy = resilience.NewBackoff(c.backoffCfg)
+	return cfg
+}
+
 // isTransient checks if error should trip circuit breaker.
 func isTransient(err error) bool {
 	s, ok := status.FromError(err)
@@ -222,46 +343,86 @@ func isTransient(err error) bool {
 	}
 }
 
-// Close closes the gRPC connection and stops health monitoring.
+// Close stops health monitoring, waits for it to fully exit, and closes the
+// gRPC connection. It never terminates the inference server process itself
+// (this client has never spawned one), so it's always safe to call on a
+// reattached Client too. Idempotent and safe to call more than once.
 func (c *Client) Close() error {
-	if c.healthCancel != nil {
-		c.healthCancel()
-	}
-	return c.conn.Close()
+	c.closeOnce.Do(func() {
+		c.Stop()
+		c.Wait()
+		c.closeErr = c.conn.Close()
+	})
+	return c.closeErr
 }
 
 // Transcribe sends audio for transcription.
 func (c *Client) Transcribe(ctx context.Context, audio []byte, sampleRate int32) (string, error) {
 	var result string
 	err := c.withBreaker(func() error {
-		resp, err := c.Transcription.Transcribe(ctx, &pb.TranscribeRequest{
-			AudioData:  audio,
-			SampleRate: sampleRate,
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			resp, err := c.Transcription.Transcribe(ctx, &pb.TranscribeRequest{
+				AudioData:  audio,
+				SampleRate: sampleRate,
+			}, opts...)
+			if err != nil {
+				return err
+			}
+			result = resp.Text
+			return nil
 		})
-		if err != nil {
-			return err
-		}
-		result = resp.Text
-		return nil
 	})
+	if err == nil && result != "" {
+		c.writeSink(ctx, "transcript", result)
+	}
 	return result, err
 }
 
+// writeSink persists text to the configured artifact sink, logging rather
+// than failing the caller if the write itself errors.
+func (c *Client) writeSink(ctx context.Context, kind, text string) {
+	if err := c.sink.Write(ctx, sinks.Artifact{Kind: kind, Data: []byte(text), Timestamp: time.Now()}); err != nil {
+		slog.Debug("artifact sink write failed", "kind", kind, "error", err)
+	}
+}
+
 // Diarize identifies speakers in audio with timestamps.
 func (c *Client) Diarize(ctx context.Context, audio []byte, sampleRate, minSpeakers, maxSpeakers int32) ([]*pb.SpeakerSegment, error) {
 	var result []*pb.SpeakerSegment
 	err := c.withBreaker(func() error {
-		resp, err := c.Transcription.Diarize(ctx, &pb.DiarizeRequest{
-			AudioData:   audio,
-			SampleRate:  sampleRate,
-			MinSpeakers: minSpeakers,
-			MaxSpeakers: maxSpeakers,
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			resp, err := c.Transcription.Diarize(ctx, &pb.DiarizeRequest{
+				AudioData:   audio,
+				SampleRate:  sampleRate,
+				MinSpeakers: minSpeakers,
+				MaxSpeakers: maxSpeakers,
+			}, opts...)
+			if err != nil {
+				return err
+			}
+			result = resp.Segments
+			return nil
+		})
+	})
+	return result, err
+}
+
+// Embed extracts a speaker embedding vector from an audio segment, for
+// client-side speaker clustering (see internal/diarization).
+func (c *Client) Embed(ctx context.Context, audio []byte, sampleRate int32) ([]float32, error) {
+	var result []float32
+	err := c.withBreaker(func() error {
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			resp, err := c.Transcription.Embed(ctx, &pb.EmbedRequest{
+				AudioData:  audio,
+				SampleRate: sampleRate,
+			}, opts...)
+			if err != nil {
+				return err
+			}
+			result = resp.Embedding
+			return nil
 		})
-		if err != nil {
-			return err
-		}
-		result = resp.Segments
-		return nil
 	})
 	return result, err
 }
@@ -271,15 +432,17 @@ func (c *Client) DetectSpeech(ctx context.Context, audio []byte, sampleRate int3
 	var prob float32
 	var isSpeech bool
 	err := c.withBreaker(func() error {
-		resp, err := c.VAD.DetectSpeech(ctx, &pb.VADRequest{
-			AudioChunk: audio,
-			SampleRate: sampleRate,
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			resp, err := c.VAD.DetectSpeech(ctx, &pb.VADRequest{
+				AudioChunk: audio,
+				SampleRate: sampleRate,
+			}, opts...)
+			if err != nil {
+				return err
+			}
+			prob, isSpeech = resp.SpeechProbability, resp.IsSpeech
+			return nil
 		})
-		if err != nil {
-			return err
-		}
-		prob, isSpeech = resp.SpeechProbability, resp.IsSpeech
-		return nil
 	})
 	return prob, isSpeech, err
 }
@@ -287,8 +450,10 @@ func (c *Client) DetectSpeech(ctx context.Context, audio []byte, sampleRate int3
 // ResetVAD resets VAD model state.
 func (c *Client) ResetVAD(ctx context.Context) error {
 	return c.withBreaker(func() error {
-		_, err := c.VAD.ResetState(ctx, &pb.ResetStateRequest{})
-		return err
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			_, err := c.VAD.ResetState(ctx, &pb.ResetStateRequest{}, opts...)
+			return err
+		})
 	})
 }
 
@@ -296,72 +461,139 @@ func (c *Client) ResetVAD(ctx context.Context) error {
 func (c *Client) ExtractText(ctx context.Context, imageData []byte, format string) (string, error) {
 	var result string
 	err := c.withBreaker(func() error {
-		resp, err := c.OCR.ExtractText(ctx, &pb.OCRRequest{
-			ImageData: imageData,
-			Format:    format,
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			resp, err := c.OCR.ExtractText(ctx, &pb.OCRRequest{
+				ImageData: imageData,
+				Format:    format,
+			}, opts...)
+			if err != nil {
+				return err
+			}
+			result = resp.Text
+			return nil
 		})
-		if err != nil {
-			return err
-		}
-		result = resp.Text
-		return nil
 	})
 	return result, err
 }
 
-// AnalyzeStream sends a query to the LLM and streams the response with retry.
-func (c *Client) AnalyzeStream(ctx context.Context, req *pb.AnalyzeRequest, onChunk func(string)) error {
+// analyzeStreamBacklog bounds the channel between the gRPC read loop and the
+// onChunk callback, so a slow callback applies backpressure rather than
+// letting an unbounded number of undelivered chunks pile up in memory.
+const analyzeStreamBacklog = 32
+
+// StreamStats reports delivery outcomes for a single AnalyzeStream call,
+// including retries that happened transparently underneath it.
+type StreamStats struct {
+	ChunksDelivered int
+	Retries         int
+	Resumes         int
+}
+
+// AnalyzeStream sends a query to the LLM and streams the response, delivering
+// each chunk to onChunk as soon as it arrives rather than buffering the
+// whole response. On a transient failure mid-stream, the retry reopens the
+// stream asking the server to resume after the last chunk already
+// delivered, and any chunk the server re-sends up to that point is skipped
+// rather than delivered twice.
+// chunkAlreadyDelivered reports whether seq was already handed to onChunk on
+// a prior attempt, given lastDelivered (-1 if no chunk has been delivered
+// yet). seq 0 is a valid, deliverable sequence number, so the "none
+// delivered" state can't be represented by lastDelivered itself being 0.
+func chunkAlreadyDelivered(seq, lastDelivered int64) bool {
+	return seq <= lastDelivered
+}
+
+func (c *Client) AnalyzeStream(ctx context.Context, req *pb.AnalyzeRequest, onChunk func(string)) (StreamStats, error) {
 	if err := c.cb.Allow(); err != nil {
-		return err
+		return StreamStats{}, err
 	}
 
-	var chunks []string // Buffer to replay on retry
-	err := resilience.Retry(ctx, resilience.LLMRetryConfig(), func() error {
-		stream, err := c.LLM.Analyze(ctx, req)
+	var stats StreamStats
+	lastDeliveredSeq := int64(-1) // -1 means no chunk delivered yet; 0 is a valid seq
+	var full strings.Builder
+	firstAttempt := true
+
+	err := resilience.Retry(ctx, c.llmRetryConfig(), func() error {
+		streamReq := req
+		if !firstAttempt {
+			stats.Retries++
+			resumed := *req
+			resumed.ResumeFromToken = true
+			resumed.LastDeliveredSeq = lastDeliveredSeq
+			streamReq = &resumed
+			stats.Resumes++
+		}
+		firstAttempt = false
+
+		stream, err := c.LLM.Analyze(ctx, streamReq)
 		if err != nil {
 			return err
 		}
-		chunks = chunks[:0] // Reset buffer on retry
+		if header, herr := stream.Header(); herr == nil {
+			c.captureCallInfo(header, nil)
+		}
 
-		for {
-			chunk, err := stream.Recv()
-			if errors.Is(err, io.EOF) {
-				return nil
+		delivered := make(chan string, analyzeStreamBacklog)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for chunk := range delivered {
+				onChunk(chunk)
+				full.WriteString(chunk)
+				stats.ChunksDelivered++
 			}
-			if err != nil {
-				return err
+		}()
+
+		recvErr := func() error {
+			for {
+				chunk, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if chunkAlreadyDelivered(chunk.Seq, lastDeliveredSeq) {
+					continue // already delivered on a prior attempt
+				}
+				lastDeliveredSeq = chunk.Seq
+				if chunk.Content != "" {
+					delivered <- chunk.Content
+				}
 			}
-			if chunk.Content != "" {
-				chunks = append(chunks, chunk.Content)
-			}
-		}
+		}()
+		close(delivered)
+		<-done // wait for onChunk to drain before the attempt returns
+		c.captureCallInfo(nil, stream.Trailer())
+		return recvErr
 	})
 
 	if err != nil {
 		if isTransient(err) {
 			c.cb.Failure()
 		}
-		return err
+		return stats, err
 	}
 
-	// Deliver all chunks after successful stream
-	for _, chunk := range chunks {
-		onChunk(chunk)
-	}
 	c.cb.Success()
-	return nil
+	if full.Len() > 0 {
+		c.writeSink(ctx, "llm_output", full.String())
+	}
+	return stats, nil
 }
 
 // IsQuestion checks if text is a question.
 func (c *Client) IsQuestion(ctx context.Context, text string) (bool, error) {
 	var result bool
 	err := c.withBreaker(func() error {
-		resp, err := c.LLM.IsQuestion(ctx, &pb.IsQuestionRequest{Text: text})
-		if err != nil {
-			return err
-		}
-		result = resp.IsQuestion
-		return nil
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			resp, err := c.LLM.IsQuestion(ctx, &pb.IsQuestionRequest{Text: text}, opts...)
+			if err != nil {
+				return err
+			}
+			result = resp.IsQuestion
+			return nil
+		})
 	})
 	return result, err
 }
@@ -369,11 +601,13 @@ func (c *Client) IsQuestion(ctx context.Context, text string) (bool, error) {
 // StoreMemory stores text in vector memory.
 func (c *Client) StoreMemory(ctx context.Context, text, source string) error {
 	err := c.withBreaker(func() error {
-		_, err := c.Memory.Store(ctx, &pb.StoreRequest{
-			Text:   text,
-			Source: source,
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			_, err := c.Memory.Store(ctx, &pb.StoreRequest{
+				Text:   text,
+				Source: source,
+			}, opts...)
+			return err
 		})
-		return err
 	})
 	if err != nil {
 		slog.Warn("failed to store memory", "error", err)
@@ -394,16 +628,18 @@ func (c *Client) BatchStoreMemory(ctx context.Context, items []MemoryItem) (int3
 	}
 	var storedCount int32
 	err := c.withBreaker(func() error {
-		pbItems := make([]*pb.StoreRequest, len(items))
-		for i, item := range items {
-			pbItems[i] = &pb.StoreRequest{Text: item.Text, Source: item.Source}
-		}
-		resp, err := c.Memory.BatchStore(ctx, &pb.BatchStoreRequest{Items: pbItems})
-		if err != nil {
-			return err
-		}
-		storedCount = resp.StoredCount
-		return nil
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			pbItems := make([]*pb.StoreRequest, len(items))
+			for i, item := range items {
+				pbItems[i] = &pb.StoreRequest{Text: item.Text, Source: item.Source}
+			}
+			resp, err := c.Memory.BatchStore(ctx, &pb.BatchStoreRequest{Items: pbItems}, opts...)
+			if err != nil {
+				return err
+			}
+			storedCount = resp.StoredCount
+			return nil
+		})
 	})
 	if err != nil {
 		slog.Warn("failed to batch store memory", "error", err, "count", len(items))
@@ -415,15 +651,17 @@ func (c *Client) BatchStoreMemory(ctx context.Context, items []MemoryItem) (int3
 func (c *Client) QueryMemory(ctx context.Context, query string, n int32) ([]string, error) {
 	var result []string
 	err := c.withBreaker(func() error {
-		resp, err := c.Memory.Query(ctx, &pb.QueryRequest{
-			QueryText: query,
-			NResults:  n,
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			resp, err := c.Memory.Query(ctx, &pb.QueryRequest{
+				QueryText: query,
+				NResults:  n,
+			}, opts...)
+			if err != nil {
+				return err
+			}
+			result = resp.Documents
+			return nil
 		})
-		if err != nil {
-			return err
-		}
-		result = resp.Documents
-		return nil
 	})
 	return result, err
 }
@@ -435,16 +673,18 @@ func (c *Client) SummarizeTranscript(ctx context.Context, transcript string, max
 	}
 
 	var result string
-	err := resilience.Retry(ctx, resilience.LLMRetryConfig(), func() error {
-		resp, err := c.LLM.SummarizeTranscript(ctx, &pb.SummarizeRequest{
-			Transcript: transcript,
-			MaxLength:  maxLength,
+	err := resilience.Retry(ctx, c.llmRetryConfig(), func() error {
+		return c.withCallInfo(func(opts ...grpc.CallOption) error {
+			resp, err := c.LLM.SummarizeTranscript(ctx, &pb.SummarizeRequest{
+				Transcript: transcript,
+				MaxLength:  maxLength,
+			}, opts...)
+			if err != nil {
+				return err
+			}
+			result = resp.Summary
+			return nil
 		})
-		if err != nil {
-			return err
-		}
-		result = resp.Summary
-		return nil
 	})
 
 	if err != nil {
@@ -454,5 +694,8 @@ func (c *Client) SummarizeTranscript(ctx context.Context, transcript string, max
 		return "", err
 	}
 	c.cb.Success()
+	if result != "" {
+		c.writeSink(ctx, "llm_output", result)
+	}
 	return result, nil
 }
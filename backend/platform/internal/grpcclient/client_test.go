@@ -4,8 +4,8 @@ import (
 	"testing"
 	"time"
 
-	"github.com/good-listener/platform/internal/resilience"
-	pb "github.com/good-listener/platform/pkg/pb"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+	pb "github.com/GriffinCanCode/good-listener/backend/platform/pkg/pb"
 )
 
 func TestCircuitBreakerInitialState(t *testing.T) {
@@ -111,6 +111,9 @@ func TestDefaultConfig(t *testing.T) {
 	if cfg.HealthCheckInterval != 5*time.Second {
 		t.Errorf("HealthCheckInterval = %v, want 5s", cfg.HealthCheckInterval)
 	}
+	if cfg.BackoffConfig != resilience.DefaultBackoffConfig() {
+		t.Errorf("BackoffConfig = %v, want %v", cfg.BackoffConfig, resilience.DefaultBackoffConfig())
+	}
 }
 
 func TestBackwardsCompatibility(t *testing.T) {
@@ -250,6 +253,44 @@ func TestAnalyzeRequest(t *testing.T) {
 	}
 }
 
+func TestStreamStats(t *testing.T) {
+	stats := StreamStats{ChunksDelivered: 5, Retries: 1, Resumes: 1}
+
+	if stats.ChunksDelivered != 5 {
+		t.Errorf("ChunksDelivered = %d, want 5", stats.ChunksDelivered)
+	}
+	if stats.Retries != 1 {
+		t.Errorf("Retries = %d, want 1", stats.Retries)
+	}
+	if stats.Resumes != 1 {
+		t.Errorf("Resumes = %d, want 1", stats.Resumes)
+	}
+}
+
+func TestChunkAlreadyDelivered(t *testing.T) {
+	tests := []struct {
+		name          string
+		seq           int64
+		lastDelivered int64
+		want          bool
+	}{
+		{"nothing delivered yet, first chunk is seq 0", 0, -1, false},
+		{"nothing delivered yet, seq 0 not redelivered as later chunk", 1, -1, false},
+		{"seq 0 already delivered, resume redelivers seq 0", 0, 0, true},
+		{"seq 0 already delivered, seq 1 is new", 1, 0, false},
+		{"seq already delivered", 2, 3, true},
+		{"seq equal to last delivered", 3, 3, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := chunkAlreadyDelivered(tt.seq, tt.lastDelivered); got != tt.want {
+				t.Errorf("chunkAlreadyDelivered(%d, %d) = %v, want %v", tt.seq, tt.lastDelivered, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAnalyzeChunk(t *testing.T) {
 	chunk := &pb.AnalyzeChunk{
 		Content: "Here's what I see...",
@@ -0,0 +1,57 @@
+package grpcclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// RPCFixture is one reflection-driven E2E test case: call Service.Method
+// with RequestJSON and check that the decoded response contains at least
+// the fields named in ExpectedJSONMatchers, so fixtures stay small and
+// only assert on what the test actually cares about.
+type RPCFixture struct {
+	Name                 string          `json:"name"`
+	Service              string          `json:"service"`
+	Method               string          `json:"method"`
+	RequestJSON          json.RawMessage `json:"request_json"`
+	ExpectedJSONMatchers map[string]any  `json:"expected_json_matchers"`
+}
+
+// RunFixture invokes f against src and reports a non-nil error describing
+// the first mismatch between the response and f.ExpectedJSONMatchers, or
+// any RPC/decoding failure.
+func RunFixture(ctx context.Context, src *DescriptorSource, f RPCFixture) error {
+	respJSON, err := src.InvokeJSON(ctx, f.Service, f.Method, f.RequestJSON)
+	if err != nil {
+		return fmt.Errorf("%s: %w", f.Name, err)
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(respJSON, &resp); err != nil {
+		return fmt.Errorf("%s: decoding response: %w", f.Name, err)
+	}
+
+	for key, want := range f.ExpectedJSONMatchers {
+		got, ok := resp[key]
+		if !ok {
+			return fmt.Errorf("%s: response missing field %q (response: %s)", f.Name, key, respJSON)
+		}
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("%s: field %q = %v, want %v", f.Name, key, got, want)
+		}
+	}
+	return nil
+}
+
+// RunFixtures runs each fixture in order against src, stopping at the
+// first failure and returning its error.
+func RunFixtures(ctx context.Context, src *DescriptorSource, fixtures []RPCFixture) error {
+	for _, f := range fixtures {
+		if err := RunFixture(ctx, src, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package grpcclient
+
+import (
+	"context"
+	"time"
+)
+
+// hedgeDelay bounds how long a call waits on the primary host before firing
+// a second, hedged request to the next-best host.
+const hedgeDelay = 50 * time.Millisecond
+
+// hedgeResult carries a host's outcome back to the race selector.
+type hedgeResult[T any] struct {
+	host  *poolHost
+	value T
+	err   error
+}
+
+// hedge races call against up to two hosts from the pool: it starts on the
+// primary host immediately, and if hedgeDelay passes without a result it
+// also fires call against the next-best available host. Whichever
+// returns first wins; the other is canceled. Latency and error outcomes
+// are recorded on each host's stats for future selection.
+func hedge[T any](ctx context.Context, p *HostPool, delay time.Duration, call func(context.Context, *poolHost) (T, error)) (T, error) {
+	var zero T
+
+	primary, err := p.Next()
+	if err != nil {
+		return zero, err
+	}
+
+	results := make(chan hedgeResult[T], 2)
+	launch := func(h *poolHost) context.CancelFunc {
+		hctx, cancel := context.WithCancel(ctx)
+		start := time.Now()
+		go func() {
+			v, err := call(hctx, h)
+			h.stats.record(time.Since(start), err)
+			results <- hedgeResult[T]{host: h, value: v, err: err}
+		}()
+		return cancel
+	}
+
+	cancelPrimary := launch(primary)
+	defer cancelPrimary()
+
+	if delay <= 0 {
+		delay = hedgeDelay
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case res := <-results:
+		return res.value, res.err
+	case <-timer.C:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+
+	secondary, err := p.NextExcluding(primary)
+	if err != nil {
+		// No hedge target available; fall back to waiting on the primary alone.
+		res := <-results
+		return res.value, res.err
+	}
+	cancelSecondary := launch(secondary)
+	defer cancelSecondary()
+
+	res := <-results
+	return res.value, res.err
+}
+
+// Transcribe hedges Transcribe across the pool's hosts.
+func (p *HostPool) Transcribe(ctx context.Context, audio []byte, sampleRate int32) (string, error) {
+	return hedge(ctx, p, p.cfg.HedgeDelay, func(ctx context.Context, h *poolHost) (string, error) {
+		return h.client.Transcribe(ctx, audio, sampleRate)
+	})
+}
+
+// IsQuestion hedges IsQuestion across the pool's hosts.
+func (p *HostPool) IsQuestion(ctx context.Context, text string) (bool, error) {
+	return hedge(ctx, p, p.cfg.HedgeDelay, func(ctx context.Context, h *poolHost) (bool, error) {
+		return h.client.IsQuestion(ctx, text)
+	})
+}
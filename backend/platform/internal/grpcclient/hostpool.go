@@ -0,0 +1,172 @@
+package grpcclient
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+// statsDecay is the EWMA weight given to each new latency/error sample.
+const statsDecay = 0.2
+
+// hostStats tracks a rolling view of a host's recent latency and error rate
+// for epsilon-greedy selection.
+type hostStats struct {
+	mu         sync.Mutex
+	avgLatency time.Duration
+	errorRate  float64
+}
+
+func (s *hostStats) record(latency time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.avgLatency == 0 {
+		s.avgLatency = latency
+	} else {
+		s.avgLatency = time.Duration(float64(s.avgLatency)*(1-statsDecay) + float64(latency)*statsDecay)
+	}
+	sample := 0.0
+	if err != nil {
+		sample = 1.0
+	}
+	s.errorRate = s.errorRate*(1-statsDecay) + sample*statsDecay
+}
+
+// score returns a lower-is-better figure of merit: latency in milliseconds
+// plus a heavy penalty for recent errors.
+func (s *hostStats) score() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return float64(s.avgLatency.Milliseconds()) + s.errorRate*1000
+}
+
+// poolHost is one backend in a HostPool: its own Client (and therefore its
+// own circuit breaker) plus rolling latency/error stats.
+type poolHost struct {
+	addr    string
+	client  *Client
+	breaker *resilience.Breaker
+	stats   *hostStats
+}
+
+// HostPoolConfig configures a HostPool.
+type HostPoolConfig struct {
+	ClientConfig ClientConfig
+	Epsilon      float64       // probability of picking a random available host instead of the best-scoring one
+	HedgeDelay   time.Duration // how long to wait before firing a hedged request to the next-best host
+}
+
+// DefaultHostPoolConfig returns production-ready defaults.
+func DefaultHostPoolConfig() HostPoolConfig {
+	return HostPoolConfig{ClientConfig: DefaultConfig(), Epsilon: 0.1, HedgeDelay: hedgeDelay}
+}
+
+// ErrNoHostsAvailable is returned when every host's circuit breaker is open.
+var ErrNoHostsAvailable = errors.New("grpcclient: no hosts available")
+
+// HostPool layers epsilon-greedy, latency/error-aware host selection (à la
+// hailocab/go-hostpool) on top of per-host circuit breakers: each backend
+// gets its own Client and Breaker, so one misbehaving host degrades
+// gracefully instead of tripping every request in the pool.
+type HostPool struct {
+	cfg   HostPoolConfig
+	hosts []*poolHost
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+// NewHostPool dials every address in addrs and returns a pool over them.
+func NewHostPool(addrs []string, cfg HostPoolConfig) (*HostPool, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("grpcclient: host pool requires at least one address")
+	}
+
+	hosts := make([]*poolHost, 0, len(addrs))
+	for _, addr := range addrs {
+		c, err := NewWithConfig(addr, cfg.ClientConfig)
+		if err != nil {
+			for _, h := range hosts {
+				_ = h.client.Close()
+			}
+			return nil, err
+		}
+		hosts = append(hosts, &poolHost{addr: addr, client: c, breaker: c.Breaker(), stats: &hostStats{}})
+	}
+
+	return &HostPool{
+		cfg:   cfg,
+		hosts: hosts,
+		rnd:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}, nil
+}
+
+// candidates returns every host whose breaker currently allows requests.
+func (p *HostPool) candidates(exclude *poolHost) []*poolHost {
+	avail := make([]*poolHost, 0, len(p.hosts))
+	for _, h := range p.hosts {
+		if h == exclude {
+			continue
+		}
+		if h.breaker.Allow() == nil {
+			avail = append(avail, h)
+		}
+	}
+	return avail
+}
+
+// pick chooses among cands with epsilon-greedy weighting: usually the
+// lowest-score (latency + error-rate penalty) host, occasionally a random
+// one to keep exploring hosts that haven't been called recently.
+func (p *HostPool) pick(cands []*poolHost) *poolHost {
+	p.mu.Lock()
+	roll := p.rnd.Float64()
+	idx := p.rnd.Intn(len(cands))
+	p.mu.Unlock()
+
+	if roll < p.cfg.Epsilon {
+		return cands[idx]
+	}
+
+	best := cands[0]
+	bestScore := best.stats.score()
+	for _, h := range cands[1:] {
+		if s := h.stats.score(); s < bestScore {
+			best, bestScore = h, s
+		}
+	}
+	return best
+}
+
+// Next selects a host, skipping any whose breaker is currently open.
+func (p *HostPool) Next() (*poolHost, error) {
+	cands := p.candidates(nil)
+	if len(cands) == 0 {
+		return nil, ErrNoHostsAvailable
+	}
+	return p.pick(cands), nil
+}
+
+// NextExcluding is like Next but never returns exclude - used to pick the
+// second target for a hedged request.
+func (p *HostPool) NextExcluding(exclude *poolHost) (*poolHost, error) {
+	cands := p.candidates(exclude)
+	if len(cands) == 0 {
+		return nil, ErrNoHostsAvailable
+	}
+	return p.pick(cands), nil
+}
+
+// Close closes every host's connection and stops its health monitor.
+func (p *HostPool) Close() error {
+	var firstErr error
+	for _, h := range p.hosts {
+		if err := h.client.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
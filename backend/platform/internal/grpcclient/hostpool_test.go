@@ -0,0 +1,110 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+func newTestHost(addr string) *poolHost {
+	return &poolHost{addr: addr, breaker: resilience.New(resilience.FastConfig()), stats: &hostStats{}}
+}
+
+func newTestPool(hosts ...*poolHost) *HostPool {
+	cfg := DefaultHostPoolConfig()
+	cfg.Epsilon = 0 // always pick the best-scoring host so tests are deterministic
+	return &HostPool{cfg: cfg, hosts: hosts, rnd: rand.New(rand.NewSource(1))}
+}
+
+func TestCandidatesSkipsOpenBreakers(t *testing.T) {
+	healthy := newTestHost("healthy:50051")
+	broken := newTestHost("broken:50051")
+	for i := 0; i < resilience.FastConfig().Threshold; i++ {
+		broken.breaker.Failure()
+	}
+	if broken.breaker.State() != resilience.Open {
+		t.Fatalf("expected broken host's breaker to be open, got %s", broken.breaker.State())
+	}
+
+	pool := newTestPool(healthy, broken)
+	cands := pool.candidates(nil)
+	if len(cands) != 1 || cands[0] != healthy {
+		t.Fatalf("candidates() = %v, want only the healthy host", cands)
+	}
+}
+
+func TestNextReturnsErrWhenAllBreakersOpen(t *testing.T) {
+	h := newTestHost("down:50051")
+	for i := 0; i < resilience.FastConfig().Threshold; i++ {
+		h.breaker.Failure()
+	}
+	pool := newTestPool(h)
+	if _, err := pool.Next(); !errors.Is(err, ErrNoHostsAvailable) {
+		t.Fatalf("Next() error = %v, want ErrNoHostsAvailable", err)
+	}
+}
+
+func TestHedgeReturnsPrimaryWhenFast(t *testing.T) {
+	primary := newTestHost("primary:50051")
+	secondary := newTestHost("secondary:50051")
+	pool := newTestPool(primary, secondary)
+
+	var secondaryCalled atomic.Bool
+	result, err := hedge(context.Background(), pool, 50*time.Millisecond, func(_ context.Context, h *poolHost) (string, error) {
+		if h == secondary {
+			secondaryCalled.Store(true)
+		}
+		return h.addr, nil
+	})
+	if err != nil {
+		t.Fatalf("hedge() error = %v", err)
+	}
+	if result != primary.addr {
+		t.Errorf("hedge() = %q, want primary %q", result, primary.addr)
+	}
+	if secondaryCalled.Load() {
+		t.Error("hedge fired the secondary call even though the primary returned immediately")
+	}
+}
+
+func TestHedgeFiresSecondaryAfterDelay(t *testing.T) {
+	primary := newTestHost("primary:50051")
+	secondary := newTestHost("secondary:50051")
+	pool := newTestPool(primary, secondary)
+
+	delay := 10 * time.Millisecond
+	result, err := hedge(context.Background(), pool, delay, func(_ context.Context, h *poolHost) (string, error) {
+		if h == primary {
+			time.Sleep(10 * delay) // never beats the hedge
+			return h.addr, nil
+		}
+		return h.addr, nil
+	})
+	if err != nil {
+		t.Fatalf("hedge() error = %v", err)
+	}
+	if result != secondary.addr {
+		t.Errorf("hedge() = %q, want hedged secondary %q", result, secondary.addr)
+	}
+}
+
+func TestHedgeFallsBackToPrimaryWithNoSecondHost(t *testing.T) {
+	primary := newTestHost("primary:50051")
+	pool := newTestPool(primary)
+
+	result, err := hedge(context.Background(), pool, time.Millisecond, func(_ context.Context, h *poolHost) (string, error) {
+		time.Sleep(5 * time.Millisecond)
+		return h.addr, nil
+	})
+	if err != nil {
+		t.Fatalf("hedge() error = %v", err)
+	}
+	if result != primary.addr {
+		t.Errorf("hedge() = %q, want primary %q", result, primary.addr)
+	}
+}
@@ -0,0 +1,135 @@
+package grpcclient
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+// State represents a Client's lifecycle phase.
+type State uint32
+
+const (
+	StateNew State = iota
+	StateStarting
+	StateReady
+	StateDegraded
+	StateStopping
+	StateStopped
+)
+
+func (s State) String() string {
+	return [...]string{"new", "starting", "ready", "degraded", "stopping", "stopped"}[s]
+}
+
+// Service is the lifecycle contract implemented by Client, letting
+// higher-level packages subscribe to readiness instead of polling
+// IsConnected/CircuitState.
+type Service interface {
+	Start(ctx context.Context) error
+	Stop()
+	Wait()
+	IsRunning() bool
+	OnStateChange(fn func(old, new State))
+}
+
+var _ Service = (*Client)(nil)
+
+// lifecycle holds the state machine shared by every Client: New -> Starting
+// -> Ready/Degraded (driven by health probes and circuit-breaker
+// transitions) -> Stopping -> Stopped.
+type lifecycle struct {
+	state       atomic.Uint32
+	mu          sync.Mutex
+	subscribers []func(old, new State)
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stoppedCh chan struct{}
+	cancel    context.CancelFunc
+}
+
+func newLifecycle() *lifecycle {
+	return &lifecycle{stoppedCh: make(chan struct{})}
+}
+
+// State returns the current lifecycle state.
+func (l *lifecycle) State() State {
+	return State(l.state.Load())
+}
+
+// IsRunning reports whether the service is started and not yet stopping.
+func (l *lifecycle) IsRunning() bool {
+	switch l.State() {
+	case StateStarting, StateReady, StateDegraded:
+		return true
+	default:
+		return false
+	}
+}
+
+// OnStateChange registers fn to be called on every transition. fn is not
+// called for the current state at registration time.
+func (l *lifecycle) OnStateChange(fn func(old, new State)) {
+	l.mu.Lock()
+	l.subscribers = append(l.subscribers, fn)
+	l.mu.Unlock()
+}
+
+// setState transitions to new, notifying subscribers if it actually changed.
+func (l *lifecycle) setState(new State) {
+	old := State(l.state.Swap(uint32(new)))
+	if old == new {
+		return
+	}
+	slog.Info("client lifecycle transition", "from", old, "to", new)
+
+	l.mu.Lock()
+	subs := append([]func(old, new State){}, l.subscribers...)
+	l.mu.Unlock()
+	for _, fn := range subs {
+		fn(old, new)
+	}
+}
+
+// markReady transitions to Ready following a successful health signal,
+// unless the service is already shutting down.
+func (l *lifecycle) markReady() {
+	switch l.State() {
+	case StateStopping, StateStopped, StateNew:
+		return
+	}
+	l.setState(StateReady)
+}
+
+// markDegraded transitions to Degraded following a failed health signal,
+// unless the service is already shutting down.
+func (l *lifecycle) markDegraded() {
+	switch l.State() {
+	case StateStopping, StateStopped, StateNew:
+		return
+	}
+	l.setState(StateDegraded)
+}
+
+// onBreakerTransition maps circuit-breaker state changes onto the service
+// lifecycle: an open breaker means the server is unreachable (Degraded), a
+// closed one means it's healthy again (Ready). This catches failures
+// observed on non-health RPCs between health probes; the probes themselves
+// drive the lifecycle directly via markReady/markDegraded.
+func (l *lifecycle) onBreakerTransition(_, to resilience.State, _ resilience.ErrorClass) {
+	switch to {
+	case resilience.Open, resilience.HalfOpen:
+		l.markDegraded()
+	case resilience.Closed:
+		l.markReady()
+	}
+}
+
+// Wait blocks until the service has fully stopped.
+func (l *lifecycle) Wait() {
+	<-l.stoppedCh
+}
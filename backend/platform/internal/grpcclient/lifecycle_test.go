@@ -0,0 +1,162 @@
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStateString(t *testing.T) {
+	tests := []struct {
+		s    State
+		want string
+	}{
+		{StateNew, "new"},
+		{StateStarting, "starting"},
+		{StateReady, "ready"},
+		{StateDegraded, "degraded"},
+		{StateStopping, "stopping"},
+		{StateStopped, "stopped"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("State(%d).String() = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestLifecycleIsRunning(t *testing.T) {
+	tests := []struct {
+		state State
+		want  bool
+	}{
+		{StateNew, false},
+		{StateStarting, true},
+		{StateReady, true},
+		{StateDegraded, true},
+		{StateStopping, false},
+		{StateStopped, false},
+	}
+	for _, tt := range tests {
+		l := newLifecycle()
+		l.state.Store(uint32(tt.state))
+		if got := l.IsRunning(); got != tt.want {
+			t.Errorf("IsRunning() at %v = %v, want %v", tt.state, got, tt.want)
+		}
+	}
+}
+
+func TestLifecycleOnStateChangeNotifiesSubscribers(t *testing.T) {
+	l := newLifecycle()
+
+	type transition struct{ old, new State }
+	var got []transition
+	l.OnStateChange(func(old, new State) {
+		got = append(got, transition{old, new})
+	})
+
+	l.setState(StateStarting)
+	l.setState(StateReady)
+
+	want := []transition{
+		{StateNew, StateStarting},
+		{StateStarting, StateReady},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d transitions, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("transition[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestLifecycleSetStateNoopWhenUnchanged(t *testing.T) {
+	l := newLifecycle()
+	calls := 0
+	l.OnStateChange(func(old, new State) { calls++ })
+
+	l.setState(StateNew) // already New, should not fire
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0 for a no-op transition", calls)
+	}
+}
+
+func TestLifecycleMarkReadyIgnoredAfterShutdown(t *testing.T) {
+	l := newLifecycle()
+	l.setState(StateStarting)
+	l.setState(StateStopping)
+
+	l.markReady()
+	if l.State() != StateStopping {
+		t.Errorf("State() = %v, want StateStopping (markReady must not resurrect a stopping client)", l.State())
+	}
+}
+
+func TestLifecycleWaitBlocksUntilStopped(t *testing.T) {
+	l := newLifecycle()
+
+	done := make(chan struct{})
+	go func() {
+		l.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Wait() returned before stoppedCh was closed")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(l.stoppedCh)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Wait() did not return after stoppedCh was closed")
+	}
+}
+
+func TestClientStartStopWaitLifecycle(t *testing.T) {
+	c := &Client{lifecycle: newLifecycle(), healthInterval: time.Hour}
+
+	var transitions []State
+	c.OnStateChange(func(old, new State) { transitions = append(transitions, new) })
+
+	if err := c.Start(context.Background()); err != nil {
+		t.Fatalf("Start() = %v, want nil", err)
+	}
+	if !c.IsRunning() {
+		t.Error("IsRunning() = false after Start, want true")
+	}
+
+	// A second Start must be a no-op.
+	if err := c.Start(context.Background()); err != nil {
+		t.Fatalf("second Start() = %v, want nil", err)
+	}
+
+	c.Stop()
+	c.Wait()
+
+	if c.IsRunning() {
+		t.Error("IsRunning() = true after Stop+Wait, want false")
+	}
+	if c.State() != StateStopped {
+		t.Errorf("State() = %v, want StateStopped", c.State())
+	}
+
+	// Stop and Wait must both be safe to call again.
+	c.Stop()
+	c.Wait()
+
+	want := []State{StateStarting, StateStopping, StateStopped}
+	if len(transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", transitions, want)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Errorf("transitions[%d] = %v, want %v", i, transitions[i], want[i])
+		}
+	}
+}
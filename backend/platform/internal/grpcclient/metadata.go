@@ -0,0 +1,129 @@
+package grpcclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Response metadata keys the Python inference server may set, surfaced via
+// LastCallInfo.
+const (
+	modelVersionKey = "x-model-version"
+	gpuNodeKey      = "x-gpu-node"
+	tokenUsageKey   = "x-token-usage"
+)
+
+// WithMetadata attaches md as outgoing gRPC metadata on ctx (e.g.
+// x-session-id, x-user-id, x-request-id, x-locale), merging into whatever
+// outgoing metadata ctx already carries. Per-call keys set here take
+// precedence over ClientConfig.DefaultMetadata for the same key.
+func (c *Client) WithMetadata(ctx context.Context, md map[string]string) context.Context {
+	existing, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		existing = existing.Copy()
+	} else {
+		existing = metadata.New(nil)
+	}
+	for k, v := range md {
+		existing.Set(k, v)
+	}
+	return metadata.NewOutgoingContext(ctx, existing)
+}
+
+// CallInfo reports response metadata from the most recently completed RPC,
+// letting callers surface the Python server's reported model version, GPU
+// node, and token usage without threading them through every return value.
+type CallInfo struct {
+	ModelVersion string
+	GPUNode      string
+	TokenUsage   string
+	Metadata     metadata.MD // raw header+trailer, for anything not promoted to a named field
+}
+
+// LastCallInfo returns metadata captured from the most recently completed
+// RPC. Concurrent calls race on "most recent" the same way any other
+// last-call accessor would; it's meant for debugging/observability, not for
+// correlating a specific call's response.
+func (c *Client) LastCallInfo() CallInfo {
+	v, _ := c.lastCallInfo.Load().(CallInfo)
+	return v
+}
+
+// captureCallInfo records header/trailer metadata from a completed RPC.
+func (c *Client) captureCallInfo(header, trailer metadata.MD) {
+	merged := header.Copy()
+	for k, v := range trailer {
+		merged[k] = v
+	}
+	c.lastCallInfo.Store(CallInfo{
+		ModelVersion: firstValue(merged, modelVersionKey),
+		GPUNode:      firstValue(merged, gpuNodeKey),
+		TokenUsage:   firstValue(merged, tokenUsageKey),
+		Metadata:     merged,
+	})
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if vs := md.Get(key); len(vs) > 0 {
+		return vs[0]
+	}
+	return ""
+}
+
+// withCallInfo runs fn with grpc.Header/grpc.Trailer call options attached
+// and captures whatever response metadata comes back into LastCallInfo,
+// regardless of whether fn itself succeeds.
+func (c *Client) withCallInfo(fn func(opts ...grpc.CallOption) error) error {
+	var header, trailer metadata.MD
+	err := fn(grpc.Header(&header), grpc.Trailer(&trailer))
+	c.captureCallInfo(header, trailer)
+	return err
+}
+
+// toOutgoingMD converts a plain string map into metadata.MD, for static
+// config-sourced metadata (see ClientConfig.DefaultMetadata).
+func toOutgoingMD(m map[string]string) metadata.MD {
+	if len(m) == 0 {
+		return nil
+	}
+	md := make(metadata.MD, len(m))
+	for k, v := range m {
+		md.Set(k, v)
+	}
+	return md
+}
+
+// defaultMetadataUnaryInterceptor attaches md to every outgoing unary call,
+// letting a per-call context (e.g. via WithMetadata) override individual
+// keys rather than being overwritten by it.
+func defaultMetadataUnaryInterceptor(md metadata.MD) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(mergeOutgoingMetadata(ctx, md), method, req, reply, cc, opts...)
+	}
+}
+
+// defaultMetadataStreamInterceptor is the streaming-call counterpart of
+// defaultMetadataUnaryInterceptor.
+func defaultMetadataStreamInterceptor(md metadata.MD) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(mergeOutgoingMetadata(ctx, md), desc, cc, method, opts...)
+	}
+}
+
+// mergeOutgoingMetadata layers md under whatever outgoing metadata ctx
+// already carries, so values already set on ctx (per-call metadata, trace
+// headers) win over the static defaults.
+func mergeOutgoingMetadata(ctx context.Context, md metadata.MD) context.Context {
+	if len(md) == 0 {
+		return ctx
+	}
+	merged := md.Copy()
+	if existing, ok := metadata.FromOutgoingContext(ctx); ok {
+		for k, v := range existing {
+			merged[k] = v
+		}
+	}
+	return metadata.NewOutgoingContext(ctx, merged)
+}
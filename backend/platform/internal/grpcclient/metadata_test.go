@@ -0,0 +1,121 @@
+package grpcclient
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWithMetadataSetsOutgoingMetadata(t *testing.T) {
+	c := &Client{}
+	ctx := c.WithMetadata(context.Background(), map[string]string{
+		"x-session-id": "sess-1",
+		"x-user-id":    "user-1",
+	})
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		t.Fatal("expected outgoing metadata on context")
+	}
+	if got := md.Get("x-session-id"); len(got) != 1 || got[0] != "sess-1" {
+		t.Errorf("x-session-id = %v, want [sess-1]", got)
+	}
+	if got := md.Get("x-user-id"); len(got) != 1 || got[0] != "user-1" {
+		t.Errorf("x-user-id = %v, want [user-1]", got)
+	}
+}
+
+func TestWithMetadataMergesWithExisting(t *testing.T) {
+	c := &Client{}
+	base := metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{
+		"x-locale": "en-US",
+	}))
+
+	ctx := c.WithMetadata(base, map[string]string{"x-session-id": "sess-1"})
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if got := md.Get("x-locale"); len(got) != 1 || got[0] != "en-US" {
+		t.Errorf("x-locale = %v, want [en-US] to survive the merge", got)
+	}
+	if got := md.Get("x-session-id"); len(got) != 1 || got[0] != "sess-1" {
+		t.Errorf("x-session-id = %v, want [sess-1]", got)
+	}
+}
+
+func TestMergeOutgoingMetadataPerCallWinsOverDefault(t *testing.T) {
+	defaults := toOutgoingMD(map[string]string{"x-locale": "en-US", "x-session-id": "default"})
+	perCall := metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{
+		"x-session-id": "explicit",
+	}))
+
+	ctx := mergeOutgoingMetadata(perCall, defaults)
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	if got := md.Get("x-session-id"); len(got) != 1 || got[0] != "explicit" {
+		t.Errorf("x-session-id = %v, want [explicit] (per-call should win over default)", got)
+	}
+	if got := md.Get("x-locale"); len(got) != 1 || got[0] != "en-US" {
+		t.Errorf("x-locale = %v, want [en-US] from defaults", got)
+	}
+}
+
+func TestMergeOutgoingMetadataNoopWhenDefaultsEmpty(t *testing.T) {
+	ctx := context.Background()
+	if got := mergeOutgoingMetadata(ctx, nil); got != ctx {
+		t.Error("mergeOutgoingMetadata with no defaults should return ctx unchanged")
+	}
+}
+
+func TestCaptureCallInfoPromotesKnownKeys(t *testing.T) {
+	c := &Client{}
+	header := metadata.New(map[string]string{
+		modelVersionKey: "v1.2.3",
+		gpuNodeKey:      "gpu-07",
+	})
+	trailer := metadata.New(map[string]string{
+		tokenUsageKey: "128",
+	})
+
+	c.captureCallInfo(header, trailer)
+	info := c.LastCallInfo()
+
+	if info.ModelVersion != "v1.2.3" {
+		t.Errorf("ModelVersion = %q, want v1.2.3", info.ModelVersion)
+	}
+	if info.GPUNode != "gpu-07" {
+		t.Errorf("GPUNode = %q, want gpu-07", info.GPUNode)
+	}
+	if info.TokenUsage != "128" {
+		t.Errorf("TokenUsage = %q, want 128", info.TokenUsage)
+	}
+}
+
+func TestCaptureCallInfoTrailerOverridesHeader(t *testing.T) {
+	c := &Client{}
+	header := metadata.New(map[string]string{modelVersionKey: "from-header"})
+	trailer := metadata.New(map[string]string{modelVersionKey: "from-trailer"})
+
+	c.captureCallInfo(header, trailer)
+
+	if got := c.LastCallInfo().ModelVersion; got != "from-trailer" {
+		t.Errorf("ModelVersion = %q, want from-trailer to win over header", got)
+	}
+}
+
+func TestLastCallInfoZeroValueBeforeAnyCall(t *testing.T) {
+	c := &Client{}
+	info := c.LastCallInfo()
+	if info.ModelVersion != "" || info.GPUNode != "" || info.TokenUsage != "" {
+		t.Errorf("LastCallInfo() before any call = %+v, want zero value", info)
+	}
+}
+
+func TestToOutgoingMDEmptyMapReturnsNil(t *testing.T) {
+	if md := toOutgoingMD(nil); md != nil {
+		t.Errorf("toOutgoingMD(nil) = %v, want nil", md)
+	}
+	if md := toOutgoingMD(map[string]string{}); md != nil {
+		t.Errorf("toOutgoingMD({}) = %v, want nil", md)
+	}
+}
@@ -0,0 +1,66 @@
+package grpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// ReattachEnvVar carries a JSON-encoded ReattachConfig describing an
+// already-running inference server to attach to, e.g.
+// {"addr":"unix:///tmp/gl.sock","protocol":"grpc","pid":12345}. Developers
+// use it to start the Python server under pdb/py-spy/a profiler, or a test
+// harness to host it in-process, without the Go client trying to manage its
+// lifecycle.
+const ReattachEnvVar = "GOODLISTENER_INFERENCE_REATTACH"
+
+// DefaultReattachTimeout bounds WaitReady for a reattached server. It's far
+// shorter than DefaultStartupTimeout because the server is expected to
+// already be serving; a long wait here just masks a genuinely wrong addr.
+const DefaultReattachTimeout = 5 * time.Second
+
+// ReattachConfig describes an out-of-band inference server to attach to
+// instead of dialing one this process is responsible for.
+type ReattachConfig struct {
+	Addr     string `json:"addr"`     // dial target, e.g. "unix:///tmp/gl.sock" or "localhost:50051"
+	Protocol string `json:"protocol"` // only "grpc" is supported today
+	PID      int    `json:"pid"`      // informational; the server's process ID, for logging
+}
+
+// ReattachConfigFromEnv reads and parses ReattachEnvVar. ok is false if the
+// variable is unset, in which case the caller should fall back to New.
+func ReattachConfigFromEnv() (cfg ReattachConfig, ok bool, err error) {
+	raw := os.Getenv(ReattachEnvVar)
+	if raw == "" {
+		return ReattachConfig{}, false, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &cfg); err != nil {
+		return ReattachConfig{}, false, fmt.Errorf("parse %s: %w", ReattachEnvVar, err)
+	}
+	return cfg, true, nil
+}
+
+// NewReattach connects to an inference server that is already running,
+// skipping the normal dial-and-supervise startup path entirely. This repo's
+// Go client never spawns the Python inference process itself (it's started
+// out-of-band), so reattach mode changes two things in practice: it marks
+// the Client so Close never attempts to stop the remote process, and it
+// favors DefaultReattachTimeout over DefaultStartupTimeout for WaitReady,
+// since a server that's supposed to already be up shouldn't need a long
+// startup grace period.
+func NewReattach(cfg ReattachConfig) (*Client, error) {
+	if cfg.Addr == "" {
+		return nil, fmt.Errorf("grpcclient: reattach config missing addr")
+	}
+	if cfg.Protocol != "" && cfg.Protocol != "grpc" {
+		return nil, fmt.Errorf("grpcclient: unsupported reattach protocol %q", cfg.Protocol)
+	}
+
+	c, err := NewWithConfig(cfg.Addr, DefaultConfig())
+	if err != nil {
+		return nil, err
+	}
+	c.reattached = true
+	return c, nil
+}
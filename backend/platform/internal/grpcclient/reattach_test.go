@@ -0,0 +1,61 @@
+package grpcclient
+
+import (
+	"testing"
+)
+
+func TestReattachConfigFromEnvUnset(t *testing.T) {
+	t.Setenv(ReattachEnvVar, "")
+
+	_, ok, err := ReattachConfigFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok {
+		t.Error("ok should be false when env var is unset")
+	}
+}
+
+func TestReattachConfigFromEnvParses(t *testing.T) {
+	t.Setenv(ReattachEnvVar, `{"addr":"unix:///tmp/gl.sock","protocol":"grpc","pid":12345}`)
+
+	cfg, ok, err := ReattachConfigFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ok {
+		t.Fatal("ok should be true when env var is set")
+	}
+	if cfg.Addr != "unix:///tmp/gl.sock" {
+		t.Errorf("Addr = %q, want unix:///tmp/gl.sock", cfg.Addr)
+	}
+	if cfg.Protocol != "grpc" {
+		t.Errorf("Protocol = %q, want grpc", cfg.Protocol)
+	}
+	if cfg.PID != 12345 {
+		t.Errorf("PID = %d, want 12345", cfg.PID)
+	}
+}
+
+func TestReattachConfigFromEnvInvalidJSON(t *testing.T) {
+	t.Setenv(ReattachEnvVar, `not json`)
+
+	_, _, err := ReattachConfigFromEnv()
+	if err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestNewReattachRejectsMissingAddr(t *testing.T) {
+	_, err := NewReattach(ReattachConfig{Protocol: "grpc"})
+	if err == nil {
+		t.Error("expected error for missing addr")
+	}
+}
+
+func TestNewReattachRejectsUnsupportedProtocol(t *testing.T) {
+	_, err := NewReattach(ReattachConfig{Addr: "localhost:50051", Protocol: "http"})
+	if err == nil {
+		t.Error("expected error for unsupported protocol")
+	}
+}
@@ -0,0 +1,82 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/jhump/protoreflect/dynamic/grpcdynamic"
+	"github.com/jhump/protoreflect/grpcreflect"
+	"google.golang.org/grpc"
+	refv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// DescriptorSource resolves service and method descriptors off a live
+// server via gRPC server reflection, the same mechanism grpcurl uses. It
+// lets test harnesses invoke any RPC the server currently exposes without
+// generated pb.* clients, so a new or renamed method is exercised (or
+// flagged missing) without a corresponding Go client regeneration.
+type DescriptorSource struct {
+	refClient *grpcreflect.Client
+	stub      grpcdynamic.Stub
+}
+
+// NewDescriptorSource connects to conn's reflection service. The returned
+// source must be closed with Close when no longer needed.
+func NewDescriptorSource(ctx context.Context, conn *grpc.ClientConn) *DescriptorSource {
+	return &DescriptorSource{
+		refClient: grpcreflect.NewClientV1Alpha(ctx, refv1alpha.NewServerReflectionClient(conn)),
+		stub:      grpcdynamic.NewStub(conn),
+	}
+}
+
+// Close releases the underlying reflection stream.
+func (s *DescriptorSource) Close() {
+	s.refClient.Reset()
+}
+
+// FindMethod resolves a fully-qualified service name (e.g.
+// "goodlistener.TranscriptionService") and method name (e.g. "Transcribe")
+// to its descriptor.
+func (s *DescriptorSource) FindMethod(service, method string) (*desc.MethodDescriptor, error) {
+	svcDesc, err := s.refClient.ResolveService(service)
+	if err != nil {
+		return nil, fmt.Errorf("resolving service %s via reflection: %w", service, err)
+	}
+	methodDesc := svcDesc.FindMethodByName(method)
+	if methodDesc == nil {
+		return nil, fmt.Errorf("service %s has no method %s", service, method)
+	}
+	return methodDesc, nil
+}
+
+// InvokeJSON invokes service.method with requestJSON decoded into the
+// method's request type (per its reflected descriptor), and returns the
+// response re-encoded as JSON. It's the building block fixture-driven E2E
+// tests use in place of a generated pb.*Client call.
+func (s *DescriptorSource) InvokeJSON(ctx context.Context, service, method string, requestJSON []byte) ([]byte, error) {
+	methodDesc, err := s.FindMethod(service, method)
+	if err != nil {
+		return nil, err
+	}
+
+	req := dynamic.NewMessage(methodDesc.GetInputType())
+	if err := req.UnmarshalJSON(requestJSON); err != nil {
+		return nil, fmt.Errorf("decoding request JSON for %s.%s: %w", service, method, err)
+	}
+
+	resp, err := s.stub.InvokeRpc(ctx, methodDesc, req)
+	if err != nil {
+		return nil, fmt.Errorf("invoking %s.%s: %w", service, method, err)
+	}
+
+	respMsg, ok := resp.(*dynamic.Message)
+	if !ok {
+		respMsg = dynamic.NewMessage(methodDesc.GetOutputType())
+		if err := respMsg.ConvertFrom(resp); err != nil {
+			return nil, fmt.Errorf("converting response from %s.%s: %w", service, method, err)
+		}
+	}
+	return respMsg.MarshalJSON()
+}
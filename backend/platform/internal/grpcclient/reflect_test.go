@@ -0,0 +1,99 @@
+package grpcclient
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// startReflectingServer runs a gRPC server exposing the standard health
+// service, with reflection enabled, so tests can drive DescriptorSource
+// against something real without the Python inference server.
+func startReflectingServer(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	srv := grpc.NewServer()
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(srv, healthSrv)
+	reflection.Register(srv)
+
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestDescriptorSourceInvokeJSON(t *testing.T) {
+	conn := startReflectingServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	src := NewDescriptorSource(ctx, conn)
+	defer src.Close()
+
+	respJSON, err := src.InvokeJSON(ctx, "grpc.health.v1.Health", "Check", []byte(`{}`))
+	if err != nil {
+		t.Fatalf("InvokeJSON: %v", err)
+	}
+
+	t.Logf("response: %s", respJSON)
+}
+
+func TestDescriptorSourceInvokeJSON_UnknownMethod(t *testing.T) {
+	conn := startReflectingServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	src := NewDescriptorSource(ctx, conn)
+	defer src.Close()
+
+	if _, err := src.InvokeJSON(ctx, "grpc.health.v1.Health", "NotAMethod", []byte(`{}`)); err == nil {
+		t.Error("expected an error for an unknown method, got nil")
+	}
+}
+
+func TestRunFixtures(t *testing.T) {
+	conn := startReflectingServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	src := NewDescriptorSource(ctx, conn)
+	defer src.Close()
+
+	fixtures := []RPCFixture{
+		{
+			Name:        "health check reports serving",
+			Service:     "grpc.health.v1.Health",
+			Method:      "Check",
+			RequestJSON: []byte(`{}`),
+			ExpectedJSONMatchers: map[string]any{
+				"status": "SERVING",
+			},
+		},
+	}
+
+	if err := RunFixtures(ctx, src, fixtures); err != nil {
+		t.Errorf("RunFixtures: %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/audio"
+	pb "github.com/GriffinCanCode/good-listener/backend/platform/pkg/pb"
+)
+
+// transcribeStreamResultBacklog bounds the channel between the gRPC recv
+// loop and whatever drains Results(), mirroring vadStreamResultBacklog.
+const transcribeStreamResultBacklog = 32
+
+// transcribeStream is the Client-backed audio.TranscribeStream returned by
+// StreamTranscribe.
+type transcribeStream struct {
+	c          *Client
+	deviceID   string
+	sampleRate int32
+
+	mu     sync.Mutex
+	grpc   pb.TranscriptionService_StreamingTranscribeClient
+	closed bool
+
+	results chan audio.TranscribeStreamResult
+	cancel  context.CancelFunc
+}
+
+// StreamTranscribe opens a long-lived bidirectional transcription stream
+// for one utterance on deviceID, modeled on StreamVAD and the same
+// streaming-recognize pattern it follows: a StreamingTranscribeConfig is
+// sent once, then a sequence of AudioChunk messages, with the server
+// pushing back a TranscriptEvent per chunk carrying interim or final text.
+// The circuit breaker gates only the initial connect; once the stream is
+// open, a recv failure is delivered as a TranscribeStreamResult.Err rather
+// than tripping the breaker, leaving reconnect-or-not to the caller
+// (audio.Processor falls back to the batch path instead of reconnecting,
+// since an utterance, unlike a VAD window, can't be replayed after the
+// fact).
+func (c *Client) StreamTranscribe(ctx context.Context, deviceID string, sampleRate int32) (audio.TranscribeStream, error) {
+	if err := c.cb.Allow(); err != nil {
+		return nil, err
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	s := &transcribeStream{
+		c:          c,
+		deviceID:   deviceID,
+		sampleRate: sampleRate,
+		results:    make(chan audio.TranscribeStreamResult, transcribeStreamResultBacklog),
+		cancel:     cancel,
+	}
+
+	if err := s.connect(streamCtx); err != nil {
+		cancel()
+		c.cb.Failure()
+		return nil, err
+	}
+	c.cb.Success()
+
+	go s.recvLoop(streamCtx)
+	return s, nil
+}
+
+// connect opens a fresh gRPC stream and sends the initial config message.
+func (s *transcribeStream) connect(ctx context.Context) error {
+	grpcStream, err := s.c.Transcription.StreamingTranscribe(ctx)
+	if err != nil {
+		return fmt.Errorf("open transcribe stream: %w", err)
+	}
+	err = grpcStream.Send(&pb.StreamingTranscribeRequest{
+		Config: &pb.StreamingTranscribeConfig{
+			DeviceId:   s.deviceID,
+			SampleRate: s.sampleRate,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("send transcribe stream config: %w", err)
+	}
+
+	s.mu.Lock()
+	s.grpc = grpcStream
+	s.mu.Unlock()
+	return nil
+}
+
+// Send implements audio.TranscribeStream.
+func (s *transcribeStream) Send(samples []float32) error {
+	s.mu.Lock()
+	grpcStream, closed := s.grpc, s.closed
+	s.mu.Unlock()
+	if closed {
+		return errors.New("transcribe stream closed")
+	}
+
+	req := &pb.StreamingTranscribeRequest{
+		Chunk: &pb.AudioChunk{Data: audio.Float32ToBytes(samples), DeviceId: s.deviceID},
+	}
+	if err := grpcStream.Send(req); err != nil {
+		return fmt.Errorf("send transcribe chunk: %w", err)
+	}
+	return nil
+}
+
+// Results implements audio.TranscribeStream.
+func (s *transcribeStream) Results() <-chan audio.TranscribeStreamResult {
+	return s.results
+}
+
+// Close implements audio.TranscribeStream. Safe to call more than once.
+func (s *transcribeStream) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	grpcStream := s.grpc
+	s.mu.Unlock()
+
+	s.cancel()
+	if grpcStream != nil {
+		return grpcStream.CloseSend()
+	}
+	return nil
+}
+
+// recvLoop delivers every TranscriptEvent as a TranscribeStreamResult until
+// the stream ends. A non-EOF recv error is delivered once, carrying Err,
+// before the channel is closed.
+func (s *transcribeStream) recvLoop(ctx context.Context) {
+	defer close(s.results)
+
+	for {
+		s.mu.Lock()
+		grpcStream, closed := s.grpc, s.closed
+		s.mu.Unlock()
+		if closed {
+			return
+		}
+
+		resp, err := grpcStream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				s.results <- audio.TranscribeStreamResult{Err: err}
+			}
+			return
+		}
+
+		s.results <- audio.TranscribeStreamResult{
+			Text:      resp.Text,
+			IsFinal:   resp.IsFinal,
+			Stability: resp.Stability,
+		}
+	}
+}
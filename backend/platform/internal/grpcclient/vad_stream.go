@@ -0,0 +1,168 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/audio"
+	pb "github.com/GriffinCanCode/good-listener/backend/platform/pkg/pb"
+)
+
+// vadStreamResultBacklog bounds the channel between the gRPC recv loop and
+// whatever drains Results(), mirroring analyzeStreamBacklog.
+const vadStreamResultBacklog = 32
+
+// vadStream is the Client-backed audio.VADStream returned by StreamVAD.
+type vadStream struct {
+	c          *Client
+	sessionID  string
+	sampleRate int32
+	threshold  float32
+
+	mu     sync.Mutex
+	grpc   pb.VADService_StreamVADClient
+	closed bool
+
+	results chan audio.VADStreamResult
+	cancel  context.CancelFunc
+}
+
+// StreamVAD opens a long-lived bidirectional VAD stream for sessionID,
+// modeled on Google Cloud Speech's streaming recognize: a
+// StreamingVADConfig is sent once, then a sequence of AudioChunk messages,
+// with the server pushing back a VADResult per chunk tagged with its
+// sequence number and whether it's final. The circuit breaker gates only
+// the initial connect; once the stream is open, a recv failure is
+// delivered as a VADStreamResult.Err rather than tripping the breaker,
+// leaving reconnect-or-not to the caller (audio.Processor reconnects and
+// replays unacknowledged chunks). The stream rides the same connection as
+// every other call, so it already gets PermitWithoutStream keepalive pings
+// at ClientConfig.KeepaliveTime (DefaultKeepaliveTime by default); no
+// separate per-stream keepalive is needed.
+func (c *Client) StreamVAD(ctx context.Context, sessionID string, sampleRate int32, threshold float64) (audio.VADStream, error) {
+	if err := c.cb.Allow(); err != nil {
+		return nil, err
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	s := &vadStream{
+		c:          c,
+		sessionID:  sessionID,
+		sampleRate: sampleRate,
+		threshold:  float32(threshold),
+		results:    make(chan audio.VADStreamResult, vadStreamResultBacklog),
+		cancel:     cancel,
+	}
+
+	if err := s.connect(streamCtx); err != nil {
+		cancel()
+		c.cb.Failure()
+		return nil, err
+	}
+	c.cb.Success()
+
+	go s.recvLoop(streamCtx)
+	return s, nil
+}
+
+// connect opens a fresh gRPC stream and sends the initial config message.
+func (s *vadStream) connect(ctx context.Context) error {
+	grpcStream, err := s.c.VAD.StreamVAD(ctx)
+	if err != nil {
+		return fmt.Errorf("open VAD stream: %w", err)
+	}
+	err = grpcStream.Send(&pb.StreamingVADRequest{
+		Config: &pb.StreamingVADConfig{
+			SessionId:  s.sessionID,
+			SampleRate: s.sampleRate,
+			Threshold:  s.threshold,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("send VAD stream config: %w", err)
+	}
+
+	s.mu.Lock()
+	s.grpc = grpcStream
+	s.mu.Unlock()
+	return nil
+}
+
+// Send implements audio.VADStream.
+func (s *vadStream) Send(seq int64, audioData []byte) error {
+	s.mu.Lock()
+	grpcStream, closed := s.grpc, s.closed
+	s.mu.Unlock()
+	if closed {
+		return errors.New("VAD stream closed")
+	}
+
+	req := &pb.StreamingVADRequest{
+		Seq:   seq,
+		Chunk: &pb.AudioChunk{Data: audioData, DeviceId: s.sessionID},
+	}
+	if err := grpcStream.Send(req); err != nil {
+		return fmt.Errorf("send VAD chunk %d: %w", seq, err)
+	}
+	return nil
+}
+
+// Results implements audio.VADStream.
+func (s *vadStream) Results() <-chan audio.VADStreamResult {
+	return s.results
+}
+
+// Close implements audio.VADStream. Safe to call more than once.
+func (s *vadStream) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	grpcStream := s.grpc
+	s.mu.Unlock()
+
+	s.cancel()
+	if grpcStream != nil {
+		return grpcStream.CloseSend()
+	}
+	return nil
+}
+
+// recvLoop delivers every VADResult as a VADStreamResult until the stream
+// ends. A non-EOF recv error is delivered once, carrying Err, before the
+// channel is closed.
+func (s *vadStream) recvLoop(ctx context.Context) {
+	defer close(s.results)
+
+	for {
+		s.mu.Lock()
+		grpcStream, closed := s.grpc, s.closed
+		s.mu.Unlock()
+		if closed {
+			return
+		}
+
+		resp, err := grpcStream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				s.results <- audio.VADStreamResult{Err: err}
+			}
+			return
+		}
+
+		s.results <- audio.VADStreamResult{
+			Seq:         resp.Seq,
+			Probability: resp.SpeechProbability,
+			IsSpeech:    resp.IsSpeech,
+			IsFinal:     resp.IsFinal,
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package health
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServer implements grpc_health_v1.HealthServer over a Registry, so the
+// inference gRPC server can expose the standard health-checking protocol
+// (https://github.com/grpc/grpc/blob/master/doc/health-checking.md)
+// alongside its own services.
+type GRPCServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	registry *Registry
+}
+
+// NewGRPCServer returns a Health service backed by registry.
+func NewGRPCServer(registry *Registry) *GRPCServer {
+	return &GRPCServer{registry: registry}
+}
+
+// Check implements grpc_health_v1.HealthServer. An empty service name checks
+// overall health; any other name checks that one subsystem, and fails with
+// NotFound if it isn't registered.
+func (s *GRPCServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	report := s.registry.Check(ctx)
+
+	if req.GetService() == "" {
+		return &grpc_health_v1.HealthCheckResponse{Status: servingStatus(report.Healthy)}, nil
+	}
+	st, ok := report.Status(req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: servingStatus(st == StatusServing)}, nil
+}
+
+// Watch implements grpc_health_v1.HealthServer by sending a single snapshot;
+// no caller needs a long-lived stream of status changes yet, so it doesn't
+// keep watching after the first response.
+func (s *GRPCServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	resp, err := s.Check(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return stream.Send(resp)
+}
+
+func servingStatus(healthy bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if healthy {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
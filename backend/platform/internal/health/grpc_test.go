@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCServerCheckOverall(t *testing.T) {
+	r := NewRegistry()
+	r.Register("svc", func(ctx context.Context) error { return nil })
+	s := NewGRPCServer(r)
+
+	resp, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("Status = %v, want SERVING", resp.Status)
+	}
+}
+
+func TestGRPCServerCheckNamedServiceNotServing(t *testing.T) {
+	r := NewRegistry()
+	r.Register("svc", func(ctx context.Context) error { return errors.New("down") })
+	s := NewGRPCServer(r)
+
+	resp, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "svc"})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("Status = %v, want NOT_SERVING", resp.Status)
+	}
+}
+
+func TestGRPCServerCheckUnknownServiceNotFound(t *testing.T) {
+	s := NewGRPCServer(NewRegistry())
+
+	_, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "nonexistent"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Check() error = %v, want NotFound", err)
+	}
+}
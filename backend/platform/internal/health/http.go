@@ -0,0 +1,34 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LivezHandler reports whether the process is up at all, independent of
+// subsystem health - a process that can answer this is at least not
+// deadlocked or crashed, which is all an orchestrator's liveness probe
+// should check before deciding to restart it.
+func LivezHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// ReadyzHandler reports whether registry's subsystems are all SERVING,
+// returning 503 if any aren't so a load balancer stops routing traffic here
+// until they recover.
+func ReadyzHandler(registry *Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := registry.Check(r.Context())
+		code := http.StatusOK
+		if !report.Healthy {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, report)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
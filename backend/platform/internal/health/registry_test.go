@@ -0,0 +1,50 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRegistryCheckAggregatesHealthyAndUnhealthy(t *testing.T) {
+	r := NewRegistry()
+	r.Register("ok", func(ctx context.Context) error { return nil })
+	r.Register("broken", func(ctx context.Context) error { return errors.New("down") })
+
+	report := r.Check(context.Background())
+	if report.Healthy {
+		t.Error("Healthy = true, want false with one failing checker")
+	}
+	if status, ok := report.Status("ok"); !ok || status != StatusServing {
+		t.Errorf(`Status("ok") = (%q, %v), want (SERVING, true)`, status, ok)
+	}
+	if status, ok := report.Status("broken"); !ok || status != StatusNotServing {
+		t.Errorf(`Status("broken") = (%q, %v), want (NOT_SERVING, true)`, status, ok)
+	}
+}
+
+func TestRegistryCheckAllHealthyReportsHealthy(t *testing.T) {
+	r := NewRegistry()
+	r.Register("a", func(ctx context.Context) error { return nil })
+	r.Register("b", func(ctx context.Context) error { return nil })
+
+	if report := r.Check(context.Background()); !report.Healthy {
+		t.Error("Healthy = false, want true when every checker passes")
+	}
+}
+
+func TestRegistryCheckUnknownServiceNotOK(t *testing.T) {
+	report := NewRegistry().Check(context.Background())
+	if _, ok := report.Status("nonexistent"); ok {
+		t.Error("Status(nonexistent) ok = true, want false")
+	}
+}
+
+func TestRegisterAndCheckUseDefaultRegistry(t *testing.T) {
+	Register("default-test-subsystem", func(ctx context.Context) error { return nil })
+
+	report := Check(context.Background())
+	if status, ok := report.Status("default-test-subsystem"); !ok || status != StatusServing {
+		t.Errorf(`Status("default-test-subsystem") = (%q, %v), want (SERVING, true)`, status, ok)
+	}
+}
@@ -0,0 +1,50 @@
+package interceptors
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
+)
+
+// ServerChain returns the canonical server.ServerOption for a Go gRPC
+// server in this repo: trace context is hydrated first so it's available
+// to everything after it, then this package's interceptor recovers
+// panics, translates AppErrors, logs, and records metrics.
+//
+//	srv := grpc.NewServer(interceptors.ServerChain())
+func ServerChain() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(
+		trace.UnaryServerInterceptor(),
+		UnaryServerInterceptor(),
+	)
+}
+
+// ServerStreamChain is ServerChain's streaming-call counterpart. Combine
+// both as separate grpc.ServerOptions when constructing the server.
+func ServerStreamChain() grpc.ServerOption {
+	return grpc.ChainStreamInterceptor(
+		trace.StreamServerInterceptor(),
+		StreamServerInterceptor(),
+	)
+}
+
+// ClientChain returns the canonical grpc.DialOption for dialing a Go gRPC
+// service from this repo: trace headers go out first, then this package's
+// interceptor retries calls to a method in retryable and re-hydrates any
+// error into an *errors.AppError.
+//
+//	conn, err := grpc.Dial(addr, interceptors.ClientChain(interceptors.NewRetryableMethods(...)))
+func ClientChain(retryable RetryableMethods) grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(
+		trace.UnaryClientInterceptor(),
+		UnaryClientInterceptor(retryable),
+	)
+}
+
+// ClientStreamChain is ClientChain's streaming-call counterpart.
+func ClientStreamChain() grpc.DialOption {
+	return grpc.WithChainStreamInterceptor(
+		trace.StreamClientInterceptor(),
+		StreamClientInterceptor(),
+	)
+}
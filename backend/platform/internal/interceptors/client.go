@@ -0,0 +1,70 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/errors"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+// RetryableMethods is a set of full gRPC method names (e.g.
+// "/cognition.Inference/Transcribe") that UnaryClientInterceptor retries
+// with resilience.LLMRetryConfig. Methods not in the set are called once.
+type RetryableMethods map[string]bool
+
+// NewRetryableMethods builds a RetryableMethods set from a list of full
+// method names.
+func NewRetryableMethods(methods ...string) RetryableMethods {
+	set := make(RetryableMethods, len(methods))
+	for _, m := range methods {
+		set[m] = true
+	}
+	return set
+}
+
+// UnaryClientInterceptor re-hydrates any gRPC error into an *errors.AppError,
+// retries calls to a method in retryable with resilience.LLMRetryConfig, and
+// records per-method latency/error-code metrics. Chain it after
+// trace.UnaryClientInterceptor so outgoing calls already carry trace headers.
+func UnaryClientInterceptor(retryable RetryableMethods) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		call := func() error { return invoker(ctx, method, req, reply, cc, opts...) }
+
+		var err error
+		if retryable[method] {
+			err = resilience.Retry(ctx, resilience.LLMRetryConfig(), call)
+		} else {
+			err = call()
+		}
+
+		currentMetrics().RecordCall(method, time.Since(start), status.Code(err).String())
+
+		if err != nil {
+			return errors.FromGRPCError(err)
+		}
+		return nil
+	}
+}
+
+// StreamClientInterceptor is the streaming-call counterpart of
+// UnaryClientInterceptor. Streaming calls aren't retried here since a
+// partially-consumed stream can't be safely replayed; it still re-hydrates
+// errors and records metrics for the initial stream setup.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+
+		currentMetrics().RecordCall(method, time.Since(start), status.Code(err).String())
+
+		if err != nil {
+			return nil, errors.FromGRPCError(err)
+		}
+		return stream, nil
+	}
+}
@@ -0,0 +1,66 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/GriffinCanCode/good-listener/backend/platform/internal/errors"
+)
+
+func TestUnaryClientInterceptorRetriesRetryableMethod(t *testing.T) {
+	attempts := 0
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attempts++
+		if attempts < 3 {
+			return status.Error(codes.Unavailable, "not ready yet")
+		}
+		return nil
+	}
+
+	intercept := UnaryClientInterceptor(NewRetryableMethods("/svc/Method"))
+	err := intercept(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+	if err != nil {
+		t.Fatalf("expected eventual success, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestUnaryClientInterceptorSkipsRetryForUnlistedMethod(t *testing.T) {
+	attempts := 0
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attempts++
+		return status.Error(codes.Unavailable, "down")
+	}
+
+	intercept := UnaryClientInterceptor(NewRetryableMethods("/svc/Other"))
+	err := intercept(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1 (no retry for unlisted method)", attempts)
+	}
+}
+
+func TestUnaryClientInterceptorRehydratesAppError(t *testing.T) {
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return status.Error(codes.NotFound, "missing")
+	}
+
+	intercept := UnaryClientInterceptor(nil)
+	err := intercept(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+
+	appErr, ok := err.(*apperrors.AppError)
+	if !ok {
+		t.Fatalf("err = %T, want *errors.AppError", err)
+	}
+	if appErr.GRPCCode() != codes.NotFound {
+		t.Errorf("GRPCCode() = %v, want NotFound", appErr.GRPCCode())
+	}
+}
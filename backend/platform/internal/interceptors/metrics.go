@@ -0,0 +1,43 @@
+// Package interceptors wires the errors, resilience, and trace packages
+// into the gRPC call path so every service picks up consistent error
+// translation, retries, and observability without reimplementing it.
+package interceptors
+
+import (
+	"sync"
+	"time"
+)
+
+// MetricsRecorder records per-method gRPC call outcomes. Implementations
+// ship results to whatever metrics backend the deployment uses; nothing in
+// this repo depends on a specific one, so the default is a no-op.
+type MetricsRecorder interface {
+	RecordCall(method string, duration time.Duration, code string)
+}
+
+// noopMetrics discards every call; it's the default until SetMetrics is called.
+type noopMetrics struct{}
+
+func (noopMetrics) RecordCall(string, time.Duration, string) {}
+
+var (
+	metricsMu sync.RWMutex
+	metrics   MetricsRecorder = noopMetrics{}
+)
+
+// SetMetrics installs the recorder used by the server and client
+// interceptors. Passing nil restores the no-op default.
+func SetMetrics(m MetricsRecorder) {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	if m == nil {
+		m = noopMetrics{}
+	}
+	metrics = m
+}
+
+func currentMetrics() MetricsRecorder {
+	metricsMu.RLock()
+	defer metricsMu.RUnlock()
+	return metrics
+}
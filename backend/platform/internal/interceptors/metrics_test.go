@@ -0,0 +1,34 @@
+package interceptors
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeMetrics struct {
+	method   string
+	duration time.Duration
+	code     string
+}
+
+func (f *fakeMetrics) RecordCall(method string, duration time.Duration, code string) {
+	f.method, f.duration, f.code = method, duration, code
+}
+
+func TestSetMetricsDefaultsToNoop(t *testing.T) {
+	SetMetrics(nil)
+	if _, ok := currentMetrics().(noopMetrics); !ok {
+		t.Errorf("currentMetrics() = %T, want noopMetrics", currentMetrics())
+	}
+}
+
+func TestSetMetricsInstallsRecorder(t *testing.T) {
+	fake := &fakeMetrics{}
+	SetMetrics(fake)
+	defer SetMetrics(nil)
+
+	currentMetrics().RecordCall("/svc/Method", 5*time.Millisecond, "OK")
+	if fake.method != "/svc/Method" || fake.code != "OK" {
+		t.Errorf("RecordCall not forwarded to installed recorder: %+v", fake)
+	}
+}
@@ -0,0 +1,81 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/errors"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
+	"github.com/GriffinCanCode/good-listener/backend/platform/pkg/pb"
+)
+
+// UnaryServerInterceptor recovers panics as internal AppErrors, translates
+// any *errors.AppError the handler returns into its gRPC status, logs the
+// call keyed off the request's trace ID, and records a metrics sample.
+// Chain it after trace.UnaryServerInterceptor so trace.FromContext(ctx) is
+// already populated when this interceptor logs.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		start := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				err = errors.Newf(pb.ErrorCode_INTERNAL, "panic: %v", r).GRPCStatus().Err()
+			}
+			logAndRecordCall(ctx, info.FullMethod, start, err)
+		}()
+
+		resp, err = handler(ctx, req)
+		return resp, toGRPCError(err)
+	}
+}
+
+// StreamServerInterceptor is the streaming-call counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		start := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				err = errors.Newf(pb.ErrorCode_INTERNAL, "panic: %v", r).GRPCStatus().Err()
+			}
+			logAndRecordCall(ss.Context(), info.FullMethod, start, err)
+		}()
+
+		return toGRPCError(handler(srv, ss))
+	}
+}
+
+// toGRPCError converts an *errors.AppError returned by a handler into its
+// gRPC status error, leaving any other error (including nil, and errors
+// already shaped as gRPC status errors) untouched.
+func toGRPCError(err error) error {
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		return err
+	}
+	return appErr.GRPCStatus().Err()
+}
+
+// logAndRecordCall emits a structured log line keyed off the call's trace
+// ID and reports the call's latency/outcome to the current MetricsRecorder.
+func logAndRecordCall(ctx context.Context, method string, start time.Time, err error) {
+	duration := time.Since(start)
+	code := status.Code(err)
+
+	traceID := ""
+	if tc, ok := trace.FromContext(ctx); ok {
+		traceID = tc.TraceID
+	}
+
+	if err != nil {
+		slog.Error("grpc call failed", "method", method, "trace_id", traceID, "duration", duration, "code", code, "error", err)
+	} else {
+		slog.Debug("grpc call completed", "method", method, "trace_id", traceID, "duration", duration, "code", code)
+	}
+
+	currentMetrics().RecordCall(method, duration, code.String())
+}
@@ -0,0 +1,58 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	apperrors "github.com/GriffinCanCode/good-listener/backend/platform/internal/errors"
+	"github.com/GriffinCanCode/good-listener/backend/platform/pkg/pb"
+)
+
+func TestToGRPCErrorConvertsAppError(t *testing.T) {
+	appErr := apperrors.New(pb.ErrorCode_NOT_FOUND, "missing")
+	err := toGRPCError(appErr)
+
+	if grpcstatus.Code(err) != codes.NotFound {
+		t.Fatalf("toGRPCError code = %v, want NotFound", grpcstatus.Code(err))
+	}
+}
+
+func TestToGRPCErrorLeavesOtherErrorsUntouched(t *testing.T) {
+	plain := errors.New("boom")
+	if got := toGRPCError(plain); got != plain {
+		t.Errorf("toGRPCError(plain) = %v, want unchanged", got)
+	}
+	if toGRPCError(nil) != nil {
+		t.Error("toGRPCError(nil) should stay nil")
+	}
+}
+
+func TestUnaryServerInterceptorRecoversPanic(t *testing.T) {
+	intercept := UnaryServerInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) {
+		panic("kaboom")
+	}
+
+	_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Method"}, handler)
+	if err == nil {
+		t.Fatal("expected an error after recovering a panic, got nil")
+	}
+	if grpcstatus.Code(err) != codes.Internal {
+		t.Errorf("recovered panic code = %v, want Internal", grpcstatus.Code(err))
+	}
+}
+
+func TestUnaryServerInterceptorPassesThroughSuccess(t *testing.T) {
+	intercept := UnaryServerInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	resp, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Method"}, handler)
+	if err != nil || resp != "ok" {
+		t.Errorf("got (%v, %v), want (ok, nil)", resp, err)
+	}
+}
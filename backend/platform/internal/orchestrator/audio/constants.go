@@ -13,4 +13,49 @@ const (
 
 	// Float32 byte size for audio conversion
 	Float32ByteSize = 4
+
+	// DefaultUtteranceTimeout forces a streamed utterance to finalize via
+	// the batch path if its TranscribeStream stalls without ever sending a
+	// final result, used when Config.UtteranceTimeoutMs is unset.
+	DefaultUtteranceTimeout = 8 * time.Second
+
+	// DefaultVADEnergyMargin is how many multiples of a device's rolling
+	// noise floor a window's RMS energy must exceed before it's worth
+	// spending an inference call on, used when Config.VADEnergyMargin is
+	// unset.
+	DefaultVADEnergyMargin = 3.0
+
+	// noiseFloorWindowSeconds is the rough span the noise floor's EMA
+	// averages over; see newNoiseFloorAlpha.
+	noiseFloorWindowSeconds = 3.0
+
+	// vadTuningWindow is how often per-device dynamic threshold
+	// adjustments are reconsidered.
+	vadTuningWindow = time.Minute
+
+	// vadTuningMinSamples is the minimum number of utterances finalized in
+	// a tuning window before retuneThreshold acts on it; below this, a
+	// single outlier could swing the threshold on too little evidence.
+	vadTuningMinSamples = 5
+
+	// vadFalsePositiveRateThreshold is the fraction of a tuning window's
+	// finalized utterances discarded as too short (see
+	// Config.MinSpeechSamples) above which the threshold is raised.
+	vadFalsePositiveRateThreshold = 0.3
+
+	// vadClearSpeechProbThreshold is the mean VAD probability, across an
+	// utterance cut off by MaxSilenceChunks, above which that cutoff looks
+	// like clipped speech rather than a real pause, prompting the
+	// threshold to be lowered.
+	vadClearSpeechProbThreshold = 0.7
+
+	// vadThresholdStep is how much a per-device tuned threshold moves on
+	// each retune.
+	vadThresholdStep = 0.05
+
+	// vadMinTunedThreshold and vadMaxTunedThreshold bound how far
+	// per-device tuning can push the threshold from its configured
+	// default.
+	vadMinTunedThreshold = 0.1
+	vadMaxTunedThreshold = 0.95
 )
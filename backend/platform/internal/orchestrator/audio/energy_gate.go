@@ -0,0 +1,153 @@
+package audio
+
+import (
+	"math"
+	"time"
+)
+
+// rmsEnergy returns the root-mean-square amplitude of samples, used to
+// gate whether a window is worth an inference VAD call (see
+// Processor.ProcessChunk).
+func rmsEnergy(samples []float32) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, s := range samples {
+		sum += float64(s) * float64(s)
+	}
+	return math.Sqrt(sum / float64(len(samples)))
+}
+
+// zeroCrossingRate returns the fraction of adjacent sample pairs that
+// cross zero, a cheap secondary signal on a window's spectral content;
+// currently surfaced only through DebugVADThresholds.
+func zeroCrossingRate(samples []float32) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	crossings := 0
+	for i := 1; i < len(samples); i++ {
+		if (samples[i-1] >= 0) != (samples[i] >= 0) {
+			crossings++
+		}
+	}
+	return float64(crossings) / float64(len(samples)-1)
+}
+
+// newNoiseFloorAlpha derives an EMA smoothing factor from sampleRate so
+// vadState.noiseFloor averages over roughly noiseFloorWindowSeconds of
+// windows, independent of sample rate.
+func newNoiseFloorAlpha(sampleRate int) float64 {
+	if sampleRate <= 0 {
+		sampleRate = 16000
+	}
+	windowSec := float64(VADWindowSamples) / float64(sampleRate)
+	windows := noiseFloorWindowSeconds / windowSec
+	return 2 / (windows + 1)
+}
+
+// updateNoiseFloor folds energy into state's noise-floor EMA. Called only
+// for windows ultimately classified non-speech, so speech doesn't drag
+// the floor upward.
+func (p *Processor) updateNoiseFloor(state *vadState, energy float64) {
+	if !state.noiseFloorSet {
+		state.noiseFloor = energy
+		state.noiseFloorSet = true
+		return
+	}
+	state.noiseFloor += p.noiseFloorAlpha * (energy - state.noiseFloor)
+}
+
+// recordUtteranceOutcome tallies a just-finalized utterance against
+// state's current tuning window, and retunes state.tunedThreshold once
+// vadTuningWindow has elapsed with enough samples to act on.
+func (p *Processor) recordUtteranceOutcome(state *vadState) {
+	if state.periodStart.IsZero() {
+		state.periodStart = time.Now()
+	}
+
+	state.periodFinalized++
+	meanProb := 0.0
+	if state.segProbCount > 0 {
+		meanProb = state.segProbSum / float64(state.segProbCount)
+	}
+	switch {
+	case len(state.speechBuffer) <= p.cfg.MinSpeechSamples:
+		state.periodShort++
+	case meanProb >= vadClearSpeechProbThreshold:
+		state.periodCutoff++
+	}
+	state.segProbSum, state.segProbCount = 0, 0
+
+	if time.Since(state.periodStart) < vadTuningWindow {
+		return
+	}
+	if state.periodFinalized >= vadTuningMinSamples {
+		p.retuneThreshold(state)
+	}
+	state.periodStart = time.Time{}
+	state.periodFinalized, state.periodShort, state.periodCutoff = 0, 0, 0
+}
+
+// retuneThreshold nudges state.tunedThreshold by vadThresholdStep: up if
+// too many of the period's utterances were discarded as too short (likely
+// noise tripping the gate), down if long, confident utterances are being
+// cut off (the threshold is clipping real speech). Neither condition
+// leaves it unchanged.
+func (p *Processor) retuneThreshold(state *vadState) {
+	threshold := state.tunedThreshold
+	if threshold == 0 {
+		threshold = p.cfg.VADThreshold
+	}
+
+	falsePositiveRate := float64(state.periodShort) / float64(state.periodFinalized)
+	switch {
+	case falsePositiveRate > vadFalsePositiveRateThreshold:
+		threshold += vadThresholdStep
+	case state.periodCutoff > 0:
+		threshold -= vadThresholdStep
+	default:
+		return
+	}
+	state.tunedThreshold = clampTunedThreshold(threshold)
+}
+
+func clampTunedThreshold(t float64) float64 {
+	if t < vadMinTunedThreshold {
+		return vadMinTunedThreshold
+	}
+	if t > vadMaxTunedThreshold {
+		return vadMaxTunedThreshold
+	}
+	return t
+}
+
+// DebugVADState snapshots one device's adaptively-tuned VAD state.
+type DebugVADState struct {
+	Threshold  float64 // tunedThreshold if set, else Config.VADThreshold
+	NoiseFloor float64
+	LastZCR    float64
+}
+
+// DebugVADThresholds returns a snapshot of every active device's current
+// tuned VAD threshold and noise floor, mirroring normalize.Normalizer.Stats
+// for surfacing on a debug endpoint.
+func (p *Processor) DebugVADThresholds() map[string]DebugVADState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make(map[string]DebugVADState, len(p.vadState))
+	for device, state := range p.vadState {
+		threshold := state.tunedThreshold
+		if threshold == 0 {
+			threshold = p.cfg.VADThreshold
+		}
+		out[device] = DebugVADState{
+			Threshold:  threshold,
+			NoiseFloor: state.noiseFloor,
+			LastZCR:    state.lastZCR,
+		}
+	}
+	return out
+}
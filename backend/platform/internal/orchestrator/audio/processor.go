@@ -11,6 +11,8 @@ import (
 	"time"
 
 	audiocap "github.com/GriffinCanCode/good-listener/backend/platform/internal/audio"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/normalize"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/timeline"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
 )
 
@@ -20,8 +22,13 @@ type VADClient interface {
 	ResetVAD(ctx context.Context) error
 }
 
-// SpeechHandler handles completed speech segments.
-type SpeechHandler func(ctx context.Context, audio []float32, source string)
+// SpeechHandler handles completed speech segments. speaker is the
+// already-known speaker label for chunk.Speaker (e.g. a Mumble username),
+// or "" when the source has no such label and diarization should run.
+type SpeechHandler func(ctx context.Context, audio []float32, source, speaker string)
+
+// VADHandler receives every VAD decision as it's made.
+type VADHandler func(prob float32, isSpeech bool, source string)
 
 // vadState tracks VAD state per device.
 type vadState struct {
@@ -30,42 +37,138 @@ type vadState struct {
 	isSpeaking    bool
 	silenceChunks int
 	lastSeen      time.Time
+	speaker       string // latest chunk.Speaker seen for this device, carried across the speech segment
+	source        string // latest chunk.Source seen for this device, passed through to onSpeech/onVAD
+
+	// Streaming VAD path (see stream.go). stream is nil until the first
+	// window for this device is sent; pending holds windows already sent
+	// on it, in order, awaiting their VADStreamResult, so a reconnect
+	// knows exactly what to replay.
+	stream    VADStream
+	streamSeq int64
+	pending   [][]float32
+
+	// Streaming transcription path (see transcribe_stream.go). transcribeStream
+	// and utteranceID are only valid while streaming is true, for the
+	// current speech segment; they're reset at the start of every new
+	// utterance.
+	transcribeStream TranscribeStream
+	streaming        bool
+	utteranceStart   time.Time
+	utteranceID      int64
+
+	// Adaptive VAD gating (see energy_gate.go). noiseFloor is an EMA of
+	// RMS energy over windows classified non-speech; noiseFloorSet is
+	// false until the first such window, so the energy gate never
+	// triggers on an unseeded floor. tunedThreshold overrides
+	// Config.VADThreshold once retuneThreshold has adjusted it for this
+	// device; 0 means "use Config.VADThreshold" still. lastZCR is the
+	// most recent window's zero-crossing rate, kept for DebugVADThresholds.
+	noiseFloor     float64
+	noiseFloorSet  bool
+	tunedThreshold float64
+	lastZCR        float64
+
+	// Tuning-window bookkeeping for retuneThreshold, reset every
+	// vadTuningWindow.
+	periodStart     time.Time
+	periodFinalized int
+	periodShort     int
+	periodCutoff    int
+	segProbSum      float64
+	segProbCount    int
 }
 
 // Config for audio processor.
 type Config struct {
-	SampleRate       int
-	VADThreshold     float64
-	MaxSilenceChunks int
-	MinSpeechSamples int // Minimum samples for valid speech (e.g., sampleRate/2 for 0.5s)
+	SampleRate         int
+	VADThreshold       float64
+	MaxSilenceChunks   int
+	MinSpeechSamples   int            // Minimum samples for valid speech (e.g., sampleRate/2 for 0.5s)
+	PrerollMs          int            // buffered audio to prepend once speech starts; 0 disables pre-roll
+	TargetLUFS         float64        // loudness level chunks are normalized toward; 0 uses normalize.TargetLUFS
+	NormalizationMode  normalize.Mode // "off", "rms", or "ebur128"; "" defaults to "rms"
+	UtteranceTimeoutMs int            // forces a stalled TranscribeStream to fall back to the batch path; 0 uses DefaultUtteranceTimeout
+	VADEnergyMargin    float64        // multiple of the rolling noise floor a window's RMS energy must clear to run VAD inference while not already speaking; 0 uses DefaultVADEnergyMargin
 }
 
 // Processor handles audio chunks with VAD.
 type Processor struct {
-	vad          VADClient
-	cfg          Config
-	onSpeech     SpeechHandler
-	mu           sync.Mutex
-	vadState     map[string]*vadState
-	staleTimeout time.Duration
+	vad                 VADClient
+	streamingVAD        StreamingVADClient        // non-nil when vad also supports StreamVAD; nil falls back to vad.DetectSpeech
+	streamingTranscribe StreamingTranscribeClient // non-nil when vad also supports StreamTranscribe; nil falls back to the batch SpeechHandler
+	cfg                 Config
+	tl                  *timeline.Timeline // nil disables pre-roll
+	preroll             time.Duration
+	onSpeech            SpeechHandler
+	onVAD               VADHandler
+	onInterim           InterimHandler // set via SetInterimHandler; nil disables the streaming path entirely
+	utteranceTimeout    time.Duration
+	norm                *normalize.Normalizer
+	mu                  sync.Mutex
+	vadState            map[string]*vadState
+	staleTimeout        time.Duration
+	energyMargin        float64
+	noiseFloorAlpha     float64 // EMA smoothing factor for vadState.noiseFloor, derived from cfg.SampleRate
 }
 
-// NewProcessor creates an audio processor.
-func NewProcessor(vad VADClient, cfg Config, onSpeech SpeechHandler) *Processor {
+// NewProcessor creates an audio processor. tl may be nil to disable
+// pre-roll (the processor still performs VAD and speech segmentation).
+//
+// Chunks are loudness-normalized (per cfg.TargetLUFS/cfg.NormalizationMode)
+// before they reach VAD and the speech buffer passed to onSpeech, so
+// heterogeneous sources (a quiet mic, a hot loopback feed, a remote RTSP
+// feed with no capture-side gain control of its own) produce comparable VAD
+// thresholds and transcription input.
+//
+// When vad also implements StreamingVADClient, each device's windows are
+// sent over one long-lived stream instead of a unary call per window (see
+// stream.go); a vad that doesn't implement it always uses the unary path.
+//
+// When vad also implements StreamingTranscribeClient and SetInterimHandler
+// has installed a handler, each utterance is transcribed incrementally
+// over one long-lived stream instead of waiting for MaxSilenceChunks and
+// calling onSpeech with the whole buffer (see transcribe_stream.go).
+func NewProcessor(vad VADClient, cfg Config, tl *timeline.Timeline, onSpeech SpeechHandler, onVAD VADHandler) *Processor {
 	if cfg.MinSpeechSamples == 0 {
 		cfg.MinSpeechSamples = cfg.SampleRate / 2
 	}
+	targetLUFS := cfg.TargetLUFS
+	if targetLUFS == 0 {
+		targetLUFS = normalize.TargetLUFS
+	}
+	utteranceTimeout := time.Duration(cfg.UtteranceTimeoutMs) * time.Millisecond
+	if utteranceTimeout == 0 {
+		utteranceTimeout = DefaultUtteranceTimeout
+	}
+	energyMargin := cfg.VADEnergyMargin
+	if energyMargin == 0 {
+		energyMargin = DefaultVADEnergyMargin
+	}
+	streamingVAD, _ := vad.(StreamingVADClient)
+	streamingTranscribe, _ := vad.(StreamingTranscribeClient)
 	return &Processor{
-		vad:          vad,
-		cfg:          cfg,
-		onSpeech:     onSpeech,
-		vadState:     make(map[string]*vadState),
-		staleTimeout: StaleStateTimeout,
+		vad:                 vad,
+		streamingVAD:        streamingVAD,
+		streamingTranscribe: streamingTranscribe,
+		cfg:                 cfg,
+		tl:                  tl,
+		preroll:             time.Duration(cfg.PrerollMs) * time.Millisecond,
+		onSpeech:            onSpeech,
+		onVAD:               onVAD,
+		utteranceTimeout:    utteranceTimeout,
+		norm:                normalize.NewWithConfig(targetLUFS, cfg.NormalizationMode, cfg.SampleRate),
+		vadState:            make(map[string]*vadState),
+		staleTimeout:        StaleStateTimeout,
+		energyMargin:        energyMargin,
+		noiseFloorAlpha:     newNoiseFloorAlpha(cfg.SampleRate),
 	}
 }
 
-// ProcessChunk processes an audio chunk through VAD.
+// ProcessChunk normalizes chunk's loudness, then processes it through VAD.
 func (p *Processor) ProcessChunk(ctx context.Context, chunk audiocap.Chunk) {
+	p.norm.Apply(chunk.DeviceID, chunk.Data, chunk.Timestamp)
+
 	p.mu.Lock()
 	state, ok := p.vadState[chunk.DeviceID]
 	if !ok {
@@ -74,6 +177,10 @@ func (p *Processor) ProcessChunk(ctx context.Context, chunk audiocap.Chunk) {
 	} else {
 		state.lastSeen = time.Now()
 	}
+	state.source = chunk.Source
+	if chunk.Speaker != "" {
+		state.speaker = chunk.Speaker
+	}
 	p.mu.Unlock()
 
 	state.buffer = append(state.buffer, chunk.Data...)
@@ -83,6 +190,24 @@ func (p *Processor) ProcessChunk(ctx context.Context, chunk audiocap.Chunk) {
 		vadChunk := state.buffer[:VADWindowSamples]
 		state.buffer = state.buffer[VADWindowSamples:]
 
+		if p.streamingVAD != nil && p.sendStreamed(ctx, chunk.DeviceID, state, vadChunk) {
+			continue
+		}
+
+		energy := rmsEnergy(vadChunk)
+		state.lastZCR = zeroCrossingRate(vadChunk)
+
+		// Skip the inference call entirely on a window that's almost
+		// certainly silence: not already mid-utterance, and quiet relative
+		// to this device's established noise floor. A window while
+		// state.isSpeaking is still sent, to catch the tail end of an
+		// utterance trailing off into silence.
+		if !state.isSpeaking && state.noiseFloorSet && energy <= state.noiseFloor*p.energyMargin {
+			p.updateNoiseFloor(state, energy)
+			p.applyVADDecision(ctx, chunk.DeviceID, state, vadChunk, 0, false)
+			continue
+		}
+
 		audioBytes := Float32ToBytes(vadChunk)
 		prob, isSpeech, err := p.vad.DetectSpeech(ctx, audioBytes, int32(p.cfg.SampleRate))
 		if err != nil {
@@ -91,25 +216,63 @@ func (p *Processor) ProcessChunk(ctx context.Context, chunk audiocap.Chunk) {
 			}
 			continue
 		}
+		if !isSpeech {
+			p.updateNoiseFloor(state, energy)
+		}
+		p.applyVADDecision(ctx, chunk.DeviceID, state, vadChunk, prob, isSpeech)
+	}
+}
+
+// applyVADDecision runs the speech-segmentation state machine for one VAD
+// window's result. Both the unary path (ProcessChunk, synchronously) and
+// the streaming path (stream.go's result drain goroutine, asynchronously)
+// funnel through here so they can't drift apart.
+func (p *Processor) applyVADDecision(ctx context.Context, deviceID string, state *vadState, vadChunk []float32, prob float32, isSpeech bool) {
+	threshold := p.cfg.VADThreshold
+	if state.tunedThreshold != 0 {
+		threshold = state.tunedThreshold
+	}
 
-		if isSpeech || prob > float32(p.cfg.VADThreshold) {
-			state.isSpeaking = true
-			state.silenceChunks = 0
-			state.speechBuffer = append(state.speechBuffer, vadChunk...)
-		} else if state.isSpeaking {
-			state.speechBuffer = append(state.speechBuffer, vadChunk...)
-			state.silenceChunks++
-
-			if state.silenceChunks > p.cfg.MaxSilenceChunks {
-				state.isSpeaking = false
-				if len(state.speechBuffer) > p.cfg.MinSpeechSamples {
-					go p.onSpeech(ctx, state.speechBuffer, chunk.Source)
+	if isSpeech || prob > float32(threshold) {
+		if !state.isSpeaking {
+			if p.tl != nil && p.preroll > 0 {
+				for _, pre := range p.tl.PreRoll(p.preroll, deviceID) {
+					state.speechBuffer = append(state.speechBuffer, pre.Data...)
 				}
-				state.speechBuffer = nil
-				_ = p.vad.ResetVAD(ctx)
 			}
+			state.utteranceStart = time.Now()
+			state.streaming = p.startUtteranceStream(ctx, deviceID, state)
+		}
+		state.isSpeaking = true
+		state.silenceChunks = 0
+		state.speechBuffer = append(state.speechBuffer, vadChunk...)
+		state.segProbSum += float64(prob)
+		state.segProbCount++
+		if state.streaming {
+			p.sendUtteranceAudio(deviceID, state, vadChunk)
+		}
+	} else if state.isSpeaking {
+		state.speechBuffer = append(state.speechBuffer, vadChunk...)
+		state.silenceChunks++
+		state.segProbSum += float64(prob)
+		state.segProbCount++
+		if state.streaming {
+			p.sendUtteranceAudio(deviceID, state, vadChunk)
+		}
+
+		if state.silenceChunks > p.cfg.MaxSilenceChunks || p.utteranceTimedOut(state) {
+			state.isSpeaking = false
+			p.finalizeUtterance(ctx, state)
+			p.recordUtteranceOutcome(state)
+			state.speechBuffer = nil
+			state.streaming = false
+			_ = p.vad.ResetVAD(ctx)
 		}
 	}
+
+	if p.onVAD != nil {
+		p.onVAD(prob, isSpeech, state.source)
+	}
 }
 
 // CleanupStale removes stale VAD state entries.
@@ -120,6 +283,12 @@ func (p *Processor) CleanupStale() {
 	threshold := time.Now().Add(-p.staleTimeout)
 	for key, state := range p.vadState {
 		if state.lastSeen.Before(threshold) {
+			if state.stream != nil {
+				_ = state.stream.Close()
+			}
+			if state.transcribeStream != nil {
+				_ = state.transcribeStream.Close()
+			}
 			delete(p.vadState, key)
 			slog.Debug("cleaned up stale VAD state", "device", key)
 		}
@@ -130,6 +299,14 @@ func (p *Processor) CleanupStale() {
 func (p *Processor) Reset() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	for _, state := range p.vadState {
+		if state.stream != nil {
+			_ = state.stream.Close()
+		}
+		if state.transcribeStream != nil {
+			_ = state.transcribeStream.Close()
+		}
+	}
 	p.vadState = make(map[string]*vadState)
 }
 
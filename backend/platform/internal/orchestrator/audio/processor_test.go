@@ -3,19 +3,25 @@ package audio
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"math"
+	"sync"
 	"testing"
+	"time"
 
 	audiocap "github.com/GriffinCanCode/good-listener/backend/platform/internal/audio"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/timeline"
 )
 
 type mockVAD struct {
-	prob    float32
-	speech  bool
-	resetCt int
+	prob     float32
+	speech   bool
+	resetCt  int
+	detectCt int
 }
 
 func (m *mockVAD) DetectSpeech(_ context.Context, _ []byte, _ int32) (float32, bool, error) {
+	m.detectCt++
 	return m.prob, m.speech, nil
 }
 
@@ -24,6 +30,52 @@ func (m *mockVAD) ResetVAD(_ context.Context) error {
 	return nil
 }
 
+// mockVADStream is a fake VADStream that answers every Send synchronously
+// by running seq through verdict, so a test can script a sequence of
+// speech/silence decisions without racing on shared mutable state.
+type mockVADStream struct {
+	results chan VADStreamResult
+	verdict func(seq int64) (prob float32, speech bool)
+
+	mu   sync.Mutex
+	sent []int64
+}
+
+func (s *mockVADStream) Send(seq int64, _ []byte) error {
+	s.mu.Lock()
+	s.sent = append(s.sent, seq)
+	s.mu.Unlock()
+	prob, speech := s.verdict(seq)
+	s.results <- VADStreamResult{Seq: seq, Probability: prob, IsSpeech: speech}
+	return nil
+}
+
+func (s *mockVADStream) Results() <-chan VADStreamResult { return s.results }
+
+func (s *mockVADStream) Close() error {
+	close(s.results)
+	return nil
+}
+
+// mockStreamingVAD is a mockVAD that also implements StreamingVADClient.
+// streamErr, when set, makes StreamVAD fail so tests can exercise the
+// fallback to the unary path.
+type mockStreamingVAD struct {
+	mockVAD
+	streamErr error
+	verdict   func(seq int64) (prob float32, speech bool)
+	streams   []*mockVADStream
+}
+
+func (m *mockStreamingVAD) StreamVAD(_ context.Context, _ string, _ int32, _ float64) (VADStream, error) {
+	if m.streamErr != nil {
+		return nil, m.streamErr
+	}
+	s := &mockVADStream{results: make(chan VADStreamResult, 8), verdict: m.verdict}
+	m.streams = append(m.streams, s)
+	return s, nil
+}
+
 func TestFloat32ToBytes(t *testing.T) {
 	samples := []float32{0.0, 1.0, -1.0, 0.5}
 	bytes := Float32ToBytes(samples)
@@ -49,7 +101,7 @@ func TestProcessorCreation(t *testing.T) {
 	vad := &mockVAD{}
 	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 15}
 	called := false
-	p := NewProcessor(vad, cfg, func(_ context.Context, _ []float32, _ string) { called = true }, func(_ float32, _ bool, _ string) {})
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) { called = true }, func(_ float32, _ bool, _ string) {})
 
 	if p == nil {
 		t.Fatal("expected processor, got nil")
@@ -65,7 +117,7 @@ func TestProcessorCreation(t *testing.T) {
 func TestProcessorReset(t *testing.T) {
 	vad := &mockVAD{}
 	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 15}
-	p := NewProcessor(vad, cfg, func(_ context.Context, _ []float32, _ string) {}, func(_ float32, _ bool, _ string) {})
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(_ float32, _ bool, _ string) {})
 
 	// Add some state
 	p.mu.Lock()
@@ -84,7 +136,7 @@ func TestProcessorReset(t *testing.T) {
 func TestProcessChunkCreatesState(t *testing.T) {
 	vad := &mockVAD{}
 	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 15}
-	p := NewProcessor(vad, cfg, func(_ context.Context, _ []float32, _ string) {}, func(_ float32, _ bool, _ string) {})
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(_ float32, _ bool, _ string) {})
 
 	chunk := audiocap.Chunk{
 		Data:     make([]float32, 100),
@@ -100,3 +152,328 @@ func TestProcessChunkCreatesState(t *testing.T) {
 	}
 	p.mu.Unlock()
 }
+
+func TestProcessChunkPrependsPrerollOnSpeechOnset(t *testing.T) {
+	vad := &mockVAD{prob: 1.0, speech: true}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 1, PrerollMs: 1000, MinSpeechSamples: 1}
+
+	tl := timeline.New(30*time.Second, 0)
+	now := time.Now()
+	tl.Add(timeline.Chunk{Data: []float32{9, 9, 9}, DeviceID: "test-device", Source: "user", Timestamp: now.Add(-500 * time.Millisecond).UnixNano()})
+	tl.Add(timeline.Chunk{Data: []float32{1, 1, 1}, DeviceID: "other-device", Source: "user", Timestamp: now.UnixNano()})
+
+	var gotSpeech []float32
+	done := make(chan struct{})
+	onSpeech := func(_ context.Context, samples []float32, _, _ string) {
+		gotSpeech = samples
+		close(done)
+	}
+
+	p := NewProcessor(vad, cfg, tl, onSpeech, func(_ float32, _ bool, _ string) {})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user", Timestamp: now.UnixNano()}
+	p.ProcessChunk(context.Background(), chunk) // speech starts: pre-roll prepended
+
+	vad.speech = false
+	vad.prob = 0
+	p.ProcessChunk(context.Background(), chunk) // one silent window closes the segment
+	p.ProcessChunk(context.Background(), chunk) // exceeds MaxSilenceChunks, flushes
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("onSpeech was not called")
+	}
+
+	if len(gotSpeech) < 3 || gotSpeech[0] != 9 {
+		t.Errorf("speech segment does not start with pre-roll from matching device: %v", gotSpeech[:min(3, len(gotSpeech))])
+	}
+}
+
+func TestProcessChunkInvokesVADHandler(t *testing.T) {
+	vad := &mockVAD{prob: 0.9, speech: true}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 15}
+
+	var gotProb float32
+	var gotSpeech bool
+	var gotSource string
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(prob float32, isSpeech bool, source string) {
+		gotProb, gotSpeech, gotSource = prob, isSpeech, source
+	})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "system"}
+	p.ProcessChunk(context.Background(), chunk)
+
+	if gotProb != 0.9 || !gotSpeech || gotSource != "system" {
+		t.Errorf("onVAD got (%v, %v, %v), want (0.9, true, system)", gotProb, gotSpeech, gotSource)
+	}
+}
+
+func TestProcessChunkUsesStreamingVADWhenAvailable(t *testing.T) {
+	// seq 0 is speech, everything after is silence, so the third window
+	// exceeds MaxSilenceChunks and flushes the segment.
+	vad := &mockStreamingVAD{verdict: func(seq int64) (float32, bool) { return 0, seq == 0 }}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 1, MinSpeechSamples: 1}
+
+	done := make(chan struct{})
+	onSpeech := func(_ context.Context, _ []float32, _, _ string) { close(done) }
+	p := NewProcessor(vad, cfg, nil, onSpeech, func(_ float32, _ bool, _ string) {})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user"}
+	p.ProcessChunk(context.Background(), chunk) // speech starts over the stream
+	p.ProcessChunk(context.Background(), chunk) // silence
+	p.ProcessChunk(context.Background(), chunk) // exceeds MaxSilenceChunks, flushes
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("onSpeech was not called via the streaming path")
+	}
+
+	if vad.detectCt != 0 {
+		t.Errorf("DetectSpeech called %d times, want 0 when streaming is available", vad.detectCt)
+	}
+	if len(vad.streams) != 1 {
+		t.Fatalf("expected exactly one stream to be opened, got %d", len(vad.streams))
+	}
+	if got := vad.streams[0].sent; len(got) != 3 || got[0] != 0 || got[2] != 2 {
+		t.Errorf("sent seqs = %v, want [0 1 2]", got)
+	}
+}
+
+func TestProcessChunkFallsBackToUnaryWhenStreamUnavailable(t *testing.T) {
+	vad := &mockStreamingVAD{mockVAD: mockVAD{prob: 0.9, speech: true}, streamErr: errors.New("server does not support streaming VAD")}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 15}
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(_ float32, _ bool, _ string) {})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user"}
+	p.ProcessChunk(context.Background(), chunk)
+
+	if vad.detectCt == 0 {
+		t.Error("DetectSpeech was never called; fallback to the unary path did not happen")
+	}
+}
+
+// mockTranscribeStream answers every Send by pushing results off a
+// scripted queue, letting a test drive exactly one result per window sent.
+type mockTranscribeStream struct {
+	results chan TranscribeStreamResult
+	script  []TranscribeStreamResult
+	sent    int
+}
+
+func (s *mockTranscribeStream) Send(_ []float32) error {
+	if s.sent < len(s.script) {
+		s.results <- s.script[s.sent]
+	}
+	s.sent++
+	return nil
+}
+
+func (s *mockTranscribeStream) Results() <-chan TranscribeStreamResult { return s.results }
+
+func (s *mockTranscribeStream) Close() error {
+	close(s.results)
+	return nil
+}
+
+// mockStreamingTranscribe is a mockVAD that also implements
+// StreamingTranscribeClient. streamErr, when set, makes StreamTranscribe
+// fail so tests can exercise the fallback to the batch path.
+type mockStreamingTranscribe struct {
+	mockVAD
+	streamErr error
+	script    []TranscribeStreamResult
+	streams   []*mockTranscribeStream
+}
+
+func (m *mockStreamingTranscribe) StreamTranscribe(_ context.Context, _ string, _ int32) (TranscribeStream, error) {
+	if m.streamErr != nil {
+		return nil, m.streamErr
+	}
+	s := &mockTranscribeStream{results: make(chan TranscribeStreamResult, 8), script: m.script}
+	m.streams = append(m.streams, s)
+	return s, nil
+}
+
+func TestProcessChunkStreamsTranscriptionWhenAvailable(t *testing.T) {
+	vad := &mockStreamingTranscribe{
+		mockVAD: mockVAD{prob: 1.0, speech: true},
+		script: []TranscribeStreamResult{
+			{Text: "hel", Stability: 0.2},
+			{Text: "hello", IsFinal: true},
+		},
+	}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 1, MinSpeechSamples: 1}
+
+	batchCalled := false
+	var interim []TranscribeStreamResult
+	var mu sync.Mutex
+	done := make(chan struct{})
+	p := NewProcessor(vad, cfg, nil,
+		func(_ context.Context, _ []float32, _, _ string) { batchCalled = true },
+		func(_ float32, _ bool, _ string) {})
+	p.SetInterimHandler(func(_ context.Context, _ string, _ int64, result TranscribeStreamResult, _, _ string) {
+		mu.Lock()
+		interim = append(interim, result)
+		if result.IsFinal {
+			close(done)
+		}
+		mu.Unlock()
+	})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user"}
+	p.ProcessChunk(context.Background(), chunk) // speech starts over the stream
+
+	vad.speech, vad.prob = false, 0
+	p.ProcessChunk(context.Background(), chunk) // silence
+	p.ProcessChunk(context.Background(), chunk) // exceeds MaxSilenceChunks, finalizes
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("onInterim was never called with a final result")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(interim) != 2 || interim[0].IsFinal || !interim[1].IsFinal || interim[1].Text != "hello" {
+		t.Errorf("interim results = %+v, want an interim then a final \"hello\"", interim)
+	}
+	if batchCalled {
+		t.Error("batch onSpeech should not run when the stream delivers its own final result")
+	}
+}
+
+func TestProcessChunkFallsBackToBatchWhenTranscribeStreamUnavailable(t *testing.T) {
+	vad := &mockStreamingTranscribe{
+		mockVAD:   mockVAD{prob: 1.0, speech: true},
+		streamErr: errors.New("server does not support streaming transcription"),
+	}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 1, MinSpeechSamples: 1}
+
+	done := make(chan struct{})
+	p := NewProcessor(vad, cfg, nil,
+		func(_ context.Context, _ []float32, _, _ string) { close(done) },
+		func(_ float32, _ bool, _ string) {})
+	p.SetInterimHandler(func(context.Context, string, int64, TranscribeStreamResult, string, string) {})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user"}
+	p.ProcessChunk(context.Background(), chunk)
+
+	vad.speech, vad.prob = false, 0
+	p.ProcessChunk(context.Background(), chunk)
+	p.ProcessChunk(context.Background(), chunk)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("batch onSpeech should have run when the transcribe stream could not open")
+	}
+}
+
+func TestProcessChunkEnergyGateSkipsDetectSpeechOnceFloorIsSeeded(t *testing.T) {
+	vad := &mockVAD{prob: 0, speech: false}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 15}
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(_ float32, _ bool, _ string) {})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user"}
+
+	// The first silent window always reaches DetectSpeech (the noise floor
+	// isn't seeded yet); every window after that is as quiet as the first,
+	// so once the floor is seeded the gate should skip the rest.
+	for i := 0; i < 5; i++ {
+		p.ProcessChunk(context.Background(), chunk)
+	}
+
+	if vad.detectCt != 1 {
+		t.Errorf("DetectSpeech called %d times, want 1 (gated after the noise floor seeds)", vad.detectCt)
+	}
+}
+
+func TestProcessChunkEnergyGateDoesNotSkipWhileSpeaking(t *testing.T) {
+	vad := &mockVAD{prob: 0.9, speech: true}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 15}
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(_ float32, _ bool, _ string) {})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user"}
+	for i := 0; i < 3; i++ {
+		p.ProcessChunk(context.Background(), chunk)
+	}
+
+	if vad.detectCt != 3 {
+		t.Errorf("DetectSpeech called %d times, want 3 (gate must not skip while isSpeaking)", vad.detectCt)
+	}
+}
+
+func TestDebugVADThresholdsReportsConfiguredDefault(t *testing.T) {
+	vad := &mockVAD{prob: 0.1, speech: false}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 15}
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(_ float32, _ bool, _ string) {})
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user"}
+	p.ProcessChunk(context.Background(), chunk)
+
+	thresholds := p.DebugVADThresholds()
+	state, ok := thresholds["test-device"]
+	if !ok {
+		t.Fatal("expected an entry for test-device")
+	}
+	if state.Threshold != 0.5 {
+		t.Errorf("Threshold = %v, want 0.5 (cfg.VADThreshold, untuned)", state.Threshold)
+	}
+}
+
+func TestRetuneThresholdRaisesOnHighFalsePositiveRate(t *testing.T) {
+	vad := &mockVAD{}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MinSpeechSamples: 100}
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(_ float32, _ bool, _ string) {})
+
+	state := &vadState{periodFinalized: 10, periodShort: 5} // 50% > vadFalsePositiveRateThreshold
+	p.retuneThreshold(state)
+
+	if state.tunedThreshold != 0.55 {
+		t.Errorf("tunedThreshold = %v, want 0.55 (0.5 + vadThresholdStep)", state.tunedThreshold)
+	}
+}
+
+func TestRetuneThresholdLowersWhenClearSpeechIsCutOff(t *testing.T) {
+	vad := &mockVAD{}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MinSpeechSamples: 100}
+	p := NewProcessor(vad, cfg, nil, func(_ context.Context, _ []float32, _, _ string) {}, func(_ float32, _ bool, _ string) {})
+
+	state := &vadState{periodFinalized: 10, periodShort: 0, periodCutoff: 2}
+	p.retuneThreshold(state)
+
+	if state.tunedThreshold != 0.45 {
+		t.Errorf("tunedThreshold = %v, want 0.45 (0.5 - vadThresholdStep)", state.tunedThreshold)
+	}
+}
+
+func TestProcessChunkSkipsStreamingWithoutInterimHandler(t *testing.T) {
+	vad := &mockStreamingTranscribe{mockVAD: mockVAD{prob: 1.0, speech: true}}
+	cfg := Config{SampleRate: 16000, VADThreshold: 0.5, MaxSilenceChunks: 1, MinSpeechSamples: 1}
+
+	done := make(chan struct{})
+	p := NewProcessor(vad, cfg, nil,
+		func(_ context.Context, _ []float32, _, _ string) { close(done) },
+		func(_ float32, _ bool, _ string) {})
+	// No SetInterimHandler call: streaming stays disabled even though vad
+	// implements StreamingTranscribeClient.
+
+	chunk := audiocap.Chunk{Data: make([]float32, VADWindowSamples), DeviceID: "test-device", Source: "user"}
+	p.ProcessChunk(context.Background(), chunk)
+
+	vad.speech, vad.prob = false, 0
+	p.ProcessChunk(context.Background(), chunk)
+	p.ProcessChunk(context.Background(), chunk)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("batch onSpeech should have run when no interim handler is installed")
+	}
+	if len(vad.streams) != 0 {
+		t.Error("no stream should have been opened without an interim handler")
+	}
+}
@@ -0,0 +1,148 @@
+package audio
+
+import (
+	"context"
+	"log/slog"
+)
+
+// StreamingVADClient is an optional capability a VADClient may also
+// implement: a single long-lived bidirectional stream avoids a gRPC round
+// trip per 512-sample window, the way Google Cloud Speech's streaming
+// recognize avoids one per audio frame. Processor uses it when available
+// (see NewProcessor) and otherwise falls back to plain VADClient.DetectSpeech.
+type StreamingVADClient interface {
+	VADClient
+	// StreamVAD opens one stream for sessionID, sending sampleRate and
+	// threshold once as its initial config. The caller owns the returned
+	// VADStream's lifecycle: send windows with Send, consume results from
+	// Results, and Close it once the device goes stale.
+	StreamVAD(ctx context.Context, sessionID string, sampleRate int32, threshold float64) (VADStream, error)
+}
+
+// VADStream is one long-lived bidirectional VAD session for a single
+// device.
+type VADStream interface {
+	// Send pushes one window's raw audio, tagged with seq so the matching
+	// VADStreamResult can be correlated against it on the way back.
+	Send(seq int64, audio []byte) error
+	// Results delivers one VADStreamResult per Send, in order. It's closed
+	// when the stream ends, after a final result carrying Err if the
+	// stream ended abnormally.
+	Results() <-chan VADStreamResult
+	Close() error
+}
+
+// VADStreamResult is one server response on a VADStream.
+type VADStreamResult struct {
+	Seq         int64
+	Probability float32
+	IsSpeech    bool
+	IsFinal     bool
+	Err         error // set, with the other fields zero, when the stream itself failed
+}
+
+// sendStreamed sends vadChunk on deviceID's stream, opening it lazily on
+// first use. It reports whether the window was actually handed off to the
+// stream; on false, the stream is unavailable (e.g. the breaker is open,
+// or the server doesn't implement it) and ProcessChunk falls back to a
+// unary DetectSpeech call for that same window.
+func (p *Processor) sendStreamed(ctx context.Context, deviceID string, state *vadState, vadChunk []float32) bool {
+	stream, err := p.getOrCreateStream(ctx, deviceID, state)
+	if err != nil {
+		slog.Debug("VAD stream unavailable, falling back to unary VAD", "device", deviceID, "error", err)
+		return false
+	}
+
+	p.mu.Lock()
+	seq := state.streamSeq
+	state.streamSeq++
+	state.pending = append(state.pending, vadChunk)
+	p.mu.Unlock()
+
+	if err := stream.Send(seq, Float32ToBytes(vadChunk)); err != nil {
+		slog.Debug("VAD stream send failed, reconnecting", "device", deviceID, "error", err)
+		p.reconnectStream(ctx, deviceID, state)
+	}
+	return true
+}
+
+// getOrCreateStream returns state's stream, opening one if this is the
+// device's first window.
+func (p *Processor) getOrCreateStream(ctx context.Context, deviceID string, state *vadState) (VADStream, error) {
+	p.mu.Lock()
+	if state.stream != nil {
+		stream := state.stream
+		p.mu.Unlock()
+		return stream, nil
+	}
+	p.mu.Unlock()
+
+	stream, err := p.streamingVAD.StreamVAD(ctx, deviceID, int32(p.cfg.SampleRate), p.cfg.VADThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	state.stream = stream
+	p.mu.Unlock()
+
+	go p.drainStreamResults(ctx, deviceID, state, stream)
+	return stream, nil
+}
+
+// drainStreamResults feeds every VADStreamResult from stream through the
+// same state machine the unary path uses, popping the matching window off
+// state.pending in arrival order (the server is expected to respond in the
+// order chunks were sent). A result carrying Err means the stream ended
+// abnormally; drainStreamResults reconnects and replays whatever windows
+// were still unacknowledged so the in-flight speech segment isn't dropped.
+func (p *Processor) drainStreamResults(ctx context.Context, deviceID string, state *vadState, stream VADStream) {
+	for result := range stream.Results() {
+		if result.Err != nil {
+			slog.Debug("VAD stream ended, reconnecting", "device", deviceID, "error", result.Err)
+			p.reconnectStream(ctx, deviceID, state)
+			return
+		}
+
+		p.mu.Lock()
+		if len(state.pending) == 0 {
+			p.mu.Unlock()
+			continue
+		}
+		vadChunk := state.pending[0]
+		state.pending = state.pending[1:]
+		p.mu.Unlock()
+
+		p.applyVADDecision(ctx, deviceID, state, vadChunk, result.Probability, result.IsSpeech)
+	}
+}
+
+// reconnectStream drops state's stream and opens a new one, replaying
+// every window still in state.pending so the partial speech segment that
+// was in flight survives the reconnect.
+func (p *Processor) reconnectStream(ctx context.Context, deviceID string, state *vadState) {
+	p.mu.Lock()
+	state.stream = nil
+	replay := state.pending
+	state.pending = nil
+	p.mu.Unlock()
+
+	stream, err := p.getOrCreateStream(ctx, deviceID, state)
+	if err != nil {
+		slog.Debug("VAD stream reconnect failed, will retry on next chunk", "device", deviceID, "error", err)
+		return
+	}
+
+	for _, vadChunk := range replay {
+		p.mu.Lock()
+		seq := state.streamSeq
+		state.streamSeq++
+		state.pending = append(state.pending, vadChunk)
+		p.mu.Unlock()
+
+		if err := stream.Send(seq, Float32ToBytes(vadChunk)); err != nil {
+			slog.Debug("VAD stream replay send failed", "device", deviceID, "error", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package audio
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// StreamingTranscribeClient is an optional capability the inference client
+// may implement: a single long-lived bidirectional stream transcribes an
+// utterance incrementally as its audio arrives, instead of waiting for
+// MaxSilenceChunks and shipping the whole speechBuffer to Transcribe in one
+// call, the same tradeoff StreamingVADClient makes for VAD. Processor uses
+// it when available and SetInterimHandler has installed a handler;
+// otherwise every utterance uses the batch SpeechHandler as before.
+type StreamingTranscribeClient interface {
+	// StreamTranscribe opens one stream for a single utterance on
+	// deviceID, sending sampleRate once as its initial config. The caller
+	// owns the returned TranscribeStream's lifecycle: send audio with
+	// Send, consume results from Results, and Close it once the utterance
+	// ends.
+	StreamTranscribe(ctx context.Context, deviceID string, sampleRate int32) (TranscribeStream, error)
+}
+
+// TranscribeStream is one long-lived transcription session for a single
+// utterance.
+type TranscribeStream interface {
+	// Send pushes the next window of speech audio.
+	Send(audio []float32) error
+	// Results delivers interim and final TranscribeStreamResults, in
+	// order, as the server produces them. It's closed when the stream
+	// ends, after a final result carrying Err if it ended abnormally.
+	Results() <-chan TranscribeStreamResult
+	Close() error
+}
+
+// TranscribeStreamResult is one server response on a TranscribeStream.
+// Stability is the server's confidence the text won't change on a later,
+// more-final hypothesis (0 for a fresh interim guess, rising toward 1 as
+// the utterance settles); it's meaningless once IsFinal is true.
+type TranscribeStreamResult struct {
+	Text      string
+	IsFinal   bool
+	Stability float32
+	Err       error // set, with the other fields zero, when the stream itself failed
+}
+
+// InterimHandler receives every TranscribeStreamResult for deviceID's
+// current utterance, identified by utteranceID for that utterance's
+// lifetime: a later result with the same ID supersedes an earlier one, and
+// a new utterance always gets a new ID.
+type InterimHandler func(ctx context.Context, deviceID string, utteranceID int64, result TranscribeStreamResult, source, speaker string)
+
+// nextUtteranceID hands out process-unique, strictly decreasing IDs for
+// in-flight utterances, so they can never collide with transcript.Store's
+// strictly-increasing, persisted entry IDs.
+var nextUtteranceID atomic.Int64
+
+// SetInterimHandler installs h as the receiver for interim/final streaming
+// transcription results. Nil (the default) disables the streaming path
+// entirely, even when the processor's vad also implements
+// StreamingTranscribeClient, so every utterance takes the batch path.
+func (p *Processor) SetInterimHandler(h InterimHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onInterim = h
+}
+
+// startUtteranceStream opens a TranscribeStream for state's utterance,
+// returning false if streaming isn't available (no streamingTranscribe
+// client, no onInterim handler, or opening the stream itself failed), so
+// the caller buffers audio for the batch path instead.
+func (p *Processor) startUtteranceStream(ctx context.Context, deviceID string, state *vadState) bool {
+	if p.streamingTranscribe == nil || p.onInterim == nil {
+		return false
+	}
+
+	stream, err := p.streamingTranscribe.StreamTranscribe(ctx, deviceID, int32(p.cfg.SampleRate))
+	if err != nil {
+		slog.Debug("transcribe stream unavailable, falling back to batch transcription", "device", deviceID, "error", err)
+		return false
+	}
+
+	state.transcribeStream = stream
+	state.utteranceID = nextUtteranceID.Add(-1)
+	go p.drainTranscribeResults(ctx, deviceID, stream, state.utteranceID, state.source, state.speaker)
+	return true
+}
+
+// sendUtteranceAudio sends vadChunk on state's active transcribe stream. A
+// send failure ends this utterance's streaming attempt; applyVADDecision's
+// finalization falls back to the batch path with whatever's already in
+// state.speechBuffer.
+func (p *Processor) sendUtteranceAudio(deviceID string, state *vadState, vadChunk []float32) {
+	if err := state.transcribeStream.Send(vadChunk); err != nil {
+		slog.Debug("transcribe stream send failed, falling back to batch transcription", "device", deviceID, "error", err)
+		_ = state.transcribeStream.Close()
+		state.transcribeStream = nil
+		state.streaming = false
+	}
+}
+
+// drainTranscribeResults feeds every TranscribeStreamResult from stream to
+// onInterim until the stream ends. A result carrying Err means the stream
+// ended abnormally; drainTranscribeResults simply stops, leaving the
+// in-flight utterance to finalize via the batch path same as any other
+// stream failure.
+func (p *Processor) drainTranscribeResults(ctx context.Context, deviceID string, stream TranscribeStream, utteranceID int64, source, speaker string) {
+	for result := range stream.Results() {
+		if result.Err != nil {
+			slog.Debug("transcribe stream ended, falling back to batch transcription", "device", deviceID, "error", result.Err)
+			return
+		}
+		p.onInterim(ctx, deviceID, utteranceID, result, source, speaker)
+	}
+}
+
+// finalizeUtterance ends state's utterance. If it was streamed and hasn't
+// stalled, the stream's own final TranscribeStreamResult (delivered
+// asynchronously by drainTranscribeResults) is the authoritative
+// transcription, so no batch call is made. Otherwise - no stream was ever
+// opened, or utteranceTimedOut reports it stalled - it falls back to the
+// batch SpeechHandler with whatever audio is already buffered.
+func (p *Processor) finalizeUtterance(ctx context.Context, state *vadState) {
+	timedOut := p.utteranceTimedOut(state)
+
+	if state.transcribeStream != nil {
+		_ = state.transcribeStream.Close()
+		state.transcribeStream = nil
+	}
+
+	if state.streaming && !timedOut {
+		return
+	}
+
+	if len(state.speechBuffer) > p.cfg.MinSpeechSamples {
+		go p.onSpeech(ctx, state.speechBuffer, state.source, state.speaker)
+	}
+}
+
+// utteranceTimedOut reports whether state's streamed utterance has run
+// longer than p.utteranceTimeout without finalizing, so finalizeUtterance
+// can force a batch fallback instead of waiting on a stalled stream.
+func (p *Processor) utteranceTimedOut(state *vadState) bool {
+	return state.streaming && p.utteranceTimeout > 0 && time.Since(state.utteranceStart) > p.utteranceTimeout
+}
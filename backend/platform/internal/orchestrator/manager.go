@@ -3,19 +3,32 @@ package orchestrator
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
 
 	audiocap "github.com/GriffinCanCode/good-listener/backend/platform/internal/audio"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/diarization"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/mumble"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/normalize"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/audio/timeline"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/broadcast"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture/icy"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture/whip"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/config"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/events"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/grpcclient"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/health"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/audio"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/autoanswer"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/memory"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/replay"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/screen"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/transcript"
-	screencap "github.com/GriffinCanCode/good-listener/backend/platform/internal/screen"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/sinks"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
 	pb "github.com/GriffinCanCode/good-listener/backend/platform/pkg/pb"
 )
@@ -45,62 +58,230 @@ type Manager struct {
 	inference *grpcclient.Client
 	cfg       *config.Config
 
-	audioCap       *audiocap.Capturer
+	audioSrc       capture.AudioSource
+	whipSrc        *whip.Source // non-nil when cfg.Platform.WHIPEnabled; exposed via WHIPHandler
+	streamSrc      *icy.Source  // always present; empty until AddStreamSource is called
+	screenSrc      capture.ScreenSource
+	audioFrames    <-chan capture.Frame
 	audioProc      *audio.Processor
+	audioTimeline  *timeline.Timeline
 	screenProc     *screen.Processor
 	transcripts    *transcript.MemoryStore
+	diarization    *diarization.Tracker
 	autoAnswer     *autoanswer.Detector
 	autoAnswerChan chan AutoAnswerEvent
 	vadChan        chan VADEvent
 	memBatcher     *memory.Batcher
+	broadcaster    *broadcast.Manager
+	events         *events.Dispatcher
+	replay         *replay.Manager // nil unless cfg.Replay.Enabled
 
 	mu        sync.RWMutex
 	recording bool
 	stopCh    chan struct{}
 }
 
-// New creates a new manager.
-func New(inference *grpcclient.Client, cfg *config.Config) *Manager {
+// New creates a new manager. audioSources and screenSources let a caller
+// inject capture backends (e.g. an RTSP feed, or a fake source for
+// deterministic tests); when either is empty, New builds the default local
+// source(s) from cfg, combining local capture with any configured
+// cfg.Platform.RemoteAudioSources.
+func New(inference *grpcclient.Client, cfg *config.Config, audioSources []capture.AudioSource, screenSources []capture.ScreenSource) *Manager {
 	log := trace.Logger(context.Background())
-	audioCap, err := audiocap.NewCapturer(cfg.Audio.SampleRate, AudioBufferSize, cfg.Audio.CaptureSystemAudio, cfg.Audio.ExcludedDevices)
+
+	audioSrc, err := buildAudioSource(audioSources, cfg)
+	if err != nil {
+		log.Error("failed to create audio source", "error", err)
+	}
+
+	var whipSrc *whip.Source
+	if cfg.Platform.WHIPEnabled {
+		whipSrc = whip.NewSource(cfg.Audio.SampleRate)
+		if audioSrc != nil {
+			audioSrc = capture.CombineAudio(audioSrc, whipSrc)
+		} else {
+			audioSrc = whipSrc
+		}
+	}
+
+	if cfg.Platform.MumbleEnabled {
+		mumbleSrc := mumble.NewSource(mumble.Config{
+			Server:     cfg.Platform.MumbleServer,
+			Username:   cfg.Platform.MumbleUsername,
+			Channel:    cfg.Platform.MumbleChannel,
+			Cert:       cfg.Platform.MumbleCert,
+			SampleRate: cfg.Audio.SampleRate,
+		})
+		if audioSrc != nil {
+			audioSrc = capture.CombineAudio(audioSrc, mumbleSrc)
+		} else {
+			audioSrc = mumbleSrc
+		}
+	}
+
+	screenSrc, err := buildScreenSource(screenSources, cfg)
 	if err != nil {
-		log.Error("failed to create audio capturer", "error", err)
+		log.Error("failed to create screen source", "error", err)
 	}
 
 	transcripts := transcript.NewStore(TranscriptMaxEntries, TranscriptEventBuffer)
 	autoAnswerDet := autoanswer.NewDetector(inference, cfg.AutoAnswer.CooldownSeconds, cfg.AutoAnswer.Enabled)
-	memBatcher := memory.NewBatcher(inference, MemoryBatcherMaxSize, MemoryBatcherFlushDelay)
+	memBatcher := memory.NewBatcher(inference, MemoryBatcherMaxSize, MemoryBatcherFlushDelay, cfg.Memory.DLQDir)
+
+	sink := sinks.New(sinks.Config{
+		Type:       cfg.Sinks.Type,
+		Dir:        cfg.Sinks.Dir,
+		MaxSizeMB:  cfg.Sinks.MaxSizeMB,
+		MaxBackups: cfg.Sinks.MaxBackups,
+		MaxAge:     time.Duration(cfg.Sinks.MaxAgeSeconds) * time.Second,
+	})
+	inference.SetSink(sink)
+
+	eventDispatcher := events.NewDispatcher()
+	buildEventSinks(eventDispatcher, cfg)
+
+	var replayMgr *replay.Manager
+	if cfg.Replay.Enabled {
+		var err error
+		replayMgr, err = replay.NewManager(replay.Config{
+			Dir:             cfg.Replay.Dir,
+			Window:          time.Duration(cfg.Replay.WindowSeconds) * time.Second,
+			SegmentDuration: time.Duration(cfg.Replay.SegmentSeconds) * time.Second,
+		}, cfg.Audio.SampleRate)
+		if err != nil {
+			log.Error("failed to create replay manager", "error", err)
+		}
+	}
 
 	m := &Manager{
-		inference:      inference,
-		cfg:            cfg,
-		audioCap:       audioCap,
-		transcripts:    transcripts,
+		inference:     inference,
+		cfg:           cfg,
+		audioSrc:      audioSrc,
+		whipSrc:       whipSrc,
+		screenSrc:     screenSrc,
+		audioTimeline: timeline.New(timeline.DefaultWindow, 0),
+		transcripts:   transcripts,
+		diarization: func() *diarization.Tracker {
+			t := diarization.New(inference, diarization.Config{
+				Threshold:   cfg.Audio.DiarizationThreshold,
+				MaxSpeakers: cfg.Audio.MaxSpeakers,
+			})
+			if err := t.Load(cfg.Audio.RegistryDir); err != nil {
+				log.Error("failed to load speaker registry, starting empty", "dir", cfg.Audio.RegistryDir, "error", err)
+			}
+			return t
+		}(),
 		autoAnswer:     autoAnswerDet,
 		autoAnswerChan: make(chan AutoAnswerEvent, AutoAnswerChannelBuffer),
 		vadChan:        make(chan VADEvent, VADChannelBuffer),
 		memBatcher:     memBatcher,
+		broadcaster:    broadcast.NewManager(broadcast.NewFLVPipeline),
+		events:         eventDispatcher,
+		replay:         replayMgr,
 		recording:      true,
 		stopCh:         make(chan struct{}),
 	}
 
+	m.streamSrc = icy.NewSource(cfg.Audio.SampleRate, m.handleStreamMeta)
+	if audioSrc != nil {
+		audioSrc = capture.CombineAudio(audioSrc, m.streamSrc)
+	} else {
+		audioSrc = m.streamSrc
+	}
+	m.audioSrc = audioSrc
+
 	// Create audio processor with speech and VAD handlers
-	if audioCap != nil {
+	if audioSrc != nil {
 		m.audioProc = audio.NewProcessor(inference, audio.Config{
-			SampleRate:       cfg.Audio.SampleRate,
-			VADThreshold:     cfg.Audio.VADThreshold,
-			MaxSilenceChunks: cfg.Audio.MaxSilenceChunks,
-		}, m.handleSpeech, m.handleVAD)
+			SampleRate:        cfg.Audio.SampleRate,
+			VADThreshold:      cfg.Audio.VADThreshold,
+			MaxSilenceChunks:  cfg.Audio.MaxSilenceChunks,
+			PrerollMs:         cfg.Audio.PrerollMs,
+			TargetLUFS:        cfg.Audio.TargetLUFS,
+			NormalizationMode: normalize.Mode(cfg.Audio.NormalizationMode),
+			VADEnergyMargin:   cfg.Audio.VADEnergyMargin,
+		}, m.audioTimeline, m.handleSpeech, m.handleVAD)
+		m.audioProc.SetInterimHandler(m.handleInterim)
 	}
 
 	// Create screen processor with batched memory client
-	m.screenProc = screen.NewProcessor(screencap.New(), inference, m)
+	m.screenProc = screen.NewProcessor(inference, m, sink)
+	if m.replay != nil {
+		m.screenProc.SetFrameHandler(m.replay.PushScreenFrame)
+	}
 
 	return m
 }
 
+// buildAudioSource returns sources combined into one AudioSource, or (when
+// sources is empty) the default local capture backend named by
+// cfg.Audio.Backend combined with any cfg.Platform.RemoteAudioSources.
+func buildAudioSource(sources []capture.AudioSource, cfg *config.Config) (capture.AudioSource, error) {
+	if len(sources) > 0 {
+		if len(sources) == 1 {
+			return sources[0], nil
+		}
+		return capture.CombineAudio(sources...), nil
+	}
+
+	local, err := capture.NewAudioSource(cfg.Audio.Backend, capture.AudioSourceConfig{
+		SampleRate:         cfg.Audio.SampleRate,
+		BufferSize:         AudioBufferSize,
+		CaptureSystemAudio: cfg.Audio.CaptureSystemAudio,
+		SystemAudioBackend: cfg.Audio.SystemAudioBackend,
+		ExcludedDevices:    cfg.Audio.ExcludedDevices,
+		GStreamerPipeline:  cfg.Audio.GStreamerPipeline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	remote := capture.NewRemoteAudioSources(cfg.Platform.RemoteAudioSources, cfg.Audio.SampleRate)
+	if len(remote) == 0 {
+		return local, nil
+	}
+	return capture.CombineAudio(append([]capture.AudioSource{local}, remote...)...), nil
+}
+
+// buildScreenSource returns sources combined into one ScreenSource, or (when
+// sources is empty) the default local capture backend named by
+// cfg.Screen.Backend.
+func buildScreenSource(sources []capture.ScreenSource, cfg *config.Config) (capture.ScreenSource, error) {
+	if len(sources) > 0 {
+		if len(sources) == 1 {
+			return sources[0], nil
+		}
+		return capture.CombineScreen(sources...), nil
+	}
+	return capture.NewScreenSource(cfg.Screen.Backend, capture.ScreenSourceConfig{CaptureRate: cfg.Screen.CaptureRate})
+}
+
+// buildEventSinks registers every sink enabled in cfg.EventSinks on d, so
+// transcript/screen-text/auto-answer events reach external systems (Kafka,
+// NATS, a webhook) without every consumer needing gRPC access to this
+// process.
+func buildEventSinks(d *events.Dispatcher, cfg *config.Config) {
+	var sinkCfg events.FactoryConfig
+	sinkCfg.Kafka.Enabled = cfg.EventSinks.Kafka.Enabled
+	sinkCfg.Kafka.Brokers = cfg.EventSinks.Kafka.Brokers
+	sinkCfg.Kafka.Topic = cfg.EventSinks.Kafka.Topic
+	sinkCfg.NATS.Enabled = cfg.EventSinks.NATS.Enabled
+	sinkCfg.NATS.URL = cfg.EventSinks.NATS.URL
+	sinkCfg.NATS.Subject = cfg.EventSinks.NATS.Subject
+	sinkCfg.Webhook.Enabled = cfg.EventSinks.Webhook.Enabled
+	sinkCfg.Webhook.URL = cfg.EventSinks.Webhook.URL
+	sinkCfg.Webhook.TimeoutMs = cfg.EventSinks.Webhook.TimeoutMs
+	events.BuildSinks(d, sinkCfg)
+}
+
+// AddSink registers an additional event sink under name, e.g. one built by a
+// caller embedding this package rather than configured through cfg.EventSinks.
+func (m *Manager) AddSink(name string, sink events.Sink) {
+	m.events.AddSink(name, sink)
+}
+
 // StoreMemory implements screen.MemoryClient using the batcher.
-func (m *Manager) StoreMemory(_ context.Context, text, source string) error {
+func (m *Manager) StoreMemory(ctx context.Context, text, source string) error {
 	m.mu.RLock()
 	recording := m.recording
 	m.mu.RUnlock()
@@ -108,40 +289,166 @@ func (m *Manager) StoreMemory(_ context.Context, text, source string) error {
 		return nil
 	}
 	m.memBatcher.Add(text, source)
+
+	if source == "screen" {
+		m.events.Publish(ctx, "screen_text", events.Event{
+			Ts:     time.Now(),
+			Source: source,
+			Kind:   "screen_text",
+			Text:   text,
+		})
+	}
 	return nil
 }
 
-// handleSpeech processes completed speech segments.
-func (m *Manager) handleSpeech(ctx context.Context, samples []float32, source string) {
+// handleSpeech processes completed speech segments. knownSpeaker is the
+// speaker label already carried on the triggering chunk (e.g. a Mumble
+// username); when set, it's used as-is and diarization (including boundary
+// splitting) is skipped entirely, since the source already guarantees one
+// speaker per chunk.
+func (m *Manager) handleSpeech(ctx context.Context, samples []float32, source, knownSpeaker string) {
 	ctx, span := trace.StartSpan(ctx, "handle_speech")
 	defer span.End()
 	span.SetAttr("source", source)
 	span.SetAttr("samples", len(samples))
 
-	log := trace.Logger(ctx)
 	audioBytes := audio.Float32ToBytes(samples)
+
+	if knownSpeaker == "" {
+		if segments := m.diarizeBoundaries(ctx, audioBytes); len(segments) > 1 {
+			span.SetAttr("speakers", len(segments))
+			m.handleMultiSpeakerSpeech(ctx, samples, source, segments)
+			return
+		}
+	}
+
 	text, err := m.inference.Transcribe(ctx, audioBytes, int32(m.cfg.Audio.SampleRate))
 	if err != nil {
 		span.SetAttr("error", err.Error())
-		log.Error("transcription error", "error", err)
+		trace.Logger(ctx).Error("transcription error", "error", err)
+		return
+	}
+
+	m.finalizeTranscript(ctx, text, source, knownSpeaker, audioBytes)
+}
+
+// diarizeBoundaries asks the inference server where speaker turns change
+// within a completed utterance. It returns nil (treat the utterance as a
+// single speaker, the common case) on any error, rather than failing the
+// whole utterance over a diarization hiccup; the server's own per-call
+// Speaker labels on each segment are discarded; finalizeTranscript
+// re-identifies each slice against this session's diarization.Tracker so
+// the label stays stable across utterances.
+func (m *Manager) diarizeBoundaries(ctx context.Context, audioBytes []byte) []*pb.SpeakerSegment {
+	segments, err := m.inference.Diarize(ctx, audioBytes, int32(m.cfg.Audio.SampleRate), 1, int32(m.cfg.Audio.MaxSpeakers))
+	if err != nil {
+		trace.Logger(ctx).Debug("diarize boundary detection failed, treating utterance as single-speaker", "error", err)
+		return nil
+	}
+	return segments
+}
+
+// handleMultiSpeakerSpeech transcribes and finalizes each diarized segment
+// of a multi-speaker utterance independently, so a single transcriptEntry
+// never mixes more than one voice.
+func (m *Manager) handleMultiSpeakerSpeech(ctx context.Context, samples []float32, source string, segments []*pb.SpeakerSegment) {
+	sampleRate := m.cfg.Audio.SampleRate
+	for _, seg := range segments {
+		start := clampSampleIndex(int(seg.StartSec*float64(sampleRate)), len(samples))
+		end := clampSampleIndex(int(seg.EndSec*float64(sampleRate)), len(samples))
+		if end <= start {
+			continue
+		}
+
+		clip := audio.Float32ToBytes(samples[start:end])
+		text, err := m.inference.Transcribe(ctx, clip, int32(sampleRate))
+		if err != nil {
+			trace.Logger(ctx).Error("transcription error", "error", err)
+			continue
+		}
+		m.finalizeTranscript(ctx, text, source, "", clip)
+	}
+}
+
+// clampSampleIndex bounds n to [0, max], for converting a diarization
+// segment's float64 second offsets into a safe samples[] slice index.
+func clampSampleIndex(n, max int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
+
+// handleInterim receives every TranscribeStreamResult from the streaming
+// transcription path (see audio.InterimHandler). An interim result is
+// published through transcripts.Emit tagged Interim so live captioning can
+// show and replace it, but never reaches Add/StoreMemory; a final result
+// runs the same tail as a batch-transcribed utterance, via
+// finalizeTranscript. audioClip is unavailable here (the audio already went
+// out over the stream), so auto-answer runs without it.
+func (m *Manager) handleInterim(ctx context.Context, deviceID string, utteranceID int64, result audio.TranscribeStreamResult, source, speaker string) {
+	if result.Err != nil {
 		return
 	}
 
+	text := strings.TrimSpace(result.Text)
+	if !result.IsFinal {
+		if text == "" {
+			return
+		}
+		m.transcripts.Emit(TranscriptEvent{ID: utteranceID, Text: text, Source: source, Speaker: speaker, Interim: true})
+		return
+	}
+
+	m.finalizeTranscript(ctx, text, source, speaker, nil)
+}
+
+// finalizeTranscript runs the shared tail once an utterance's text is known,
+// whether it came from a single batch Transcribe call (handleSpeech) or a
+// streaming TranscribeStream's final result (handleInterim): resolve the
+// speaker if not already known, store and broadcast the entry, batch it for
+// memory, and check for an auto-answerable question. audioClip is the raw
+// PCM of the utterance for diarization and auto-answer context; nil when
+// unavailable (the streaming path has already sent it to the inference
+// server and doesn't keep a copy).
+func (m *Manager) finalizeTranscript(ctx context.Context, text, source, knownSpeaker string, audioClip []byte) {
 	text = strings.TrimSpace(text)
 	if text == "" {
 		return
 	}
 
-	// Derive speaker label from source
-	speaker := "Speaker"
-	if source == "user" {
-		speaker = "You"
+	log := trace.Logger(ctx)
+	speaker := knownSpeaker
+	if speaker == "" {
+		// Derive a stable per-source speaker label by clustering this segment's
+		// voice embedding against ones seen earlier in the session. Falls back
+		// to the old routing-only label if the inference server can't embed it.
+		var err error
+		speaker, err = m.diarization.Identify(ctx, source, audioClip, int32(m.cfg.Audio.SampleRate))
+		if err != nil {
+			log.Debug("diarization failed, falling back to source-based label", "error", err)
+			speaker = "Speaker"
+			if source == "user" {
+				speaker = "You"
+			}
+		}
 	}
 
 	log.Info("transcribed", "source", source, "speaker", speaker, "text", text)
 
-	m.transcripts.Add(text, source, speaker)
-	m.transcripts.Emit(TranscriptEvent{Text: text, Source: source, Speaker: speaker})
+	id := m.transcripts.Add(text, source, speaker)
+	m.transcripts.Emit(TranscriptEvent{ID: id, Text: text, Source: source, Speaker: speaker})
+	m.events.Publish(ctx, "transcript", events.Event{ID: id, Ts: time.Now(), Source: source, Kind: "transcript", Text: text})
+	if m.replay != nil {
+		m.replay.AddCaption(text, speaker, time.Now())
+	}
+
+	if m.IsBroadcasting() {
+		m.broadcaster.PushCaption(text, source)
+	}
 
 	// Store to vector DB if recording (batched for efficiency)
 	m.mu.RLock()
@@ -154,15 +461,48 @@ func (m *Manager) handleSpeech(ctx context.Context, samples []float32, source st
 
 	// Check for auto-answer on system audio
 	if source == "system" && m.autoAnswer.Check(ctx, text) {
-		go m.streamAutoAnswer(ctx, text)
+		go m.streamAutoAnswer(ctx, text, audioClip)
 	}
 }
 
+// handleStreamMeta publishes a synthetic transcript event carrying a stream
+// source's latest in-band StreamTitle, so downstream summarization sees the
+// same track/show boundary a listener would hear, the way handleSpeech
+// publishes a real transcribed segment.
+func (m *Manager) handleStreamMeta(url, title string) {
+	id := m.transcripts.Add(title, "stream-meta", url)
+	m.transcripts.Emit(TranscriptEvent{ID: id, Text: title, Source: "stream-meta", Speaker: url})
+}
+
 // TranscriptEvents returns channel for transcript events.
 func (m *Manager) TranscriptEvents() <-chan TranscriptEvent {
 	return m.transcripts.Events()
 }
 
+// TranscriptBroker returns the broker backing the transcript.vtt/srt/jsonl
+// endpoints, so server handlers can Subscribe without reaching into the
+// store directly.
+func (m *Manager) TranscriptBroker() *transcript.Broker {
+	return m.transcripts.Broker()
+}
+
+// EnrollSpeaker labels the "user" source's voice with name, so future
+// segments that match it are identified as name instead of an
+// auto-assigned "Speaker N" label. samples is a short clip of the
+// speaker's voice, e.g. recorded once during setup.
+func (m *Manager) EnrollSpeaker(ctx context.Context, name string, samples []float32) error {
+	audioBytes := audio.Float32ToBytes(samples)
+	return m.diarization.Enroll(ctx, "user", name, audioBytes, int32(m.cfg.Audio.SampleRate))
+}
+
+// RenameSpeaker relabels an auto-assigned speaker cluster (e.g.
+// "Speaker 2") to newLabel across every source, so that speaker's future
+// segments are identified as newLabel. It does not rewrite transcriptEntrys
+// already stored under the old label.
+func (m *Manager) RenameSpeaker(oldLabel, newLabel string) error {
+	return m.diarization.RenameSpeaker(oldLabel, newLabel)
+}
+
 // AutoAnswerEvents returns channel for auto-answer events.
 func (m *Manager) AutoAnswerEvents() <-chan AutoAnswerEvent {
 	return m.autoAnswerChan
@@ -181,8 +521,11 @@ func (m *Manager) handleVAD(prob float32, isSpeech bool, source string) {
 	}
 }
 
-// streamAutoAnswer generates and streams an LLM response for a detected question.
-func (m *Manager) streamAutoAnswer(ctx context.Context, question string) {
+// streamAutoAnswer generates and streams an LLM response for a detected
+// question. audioClip is the raw PCM audio of the question window (including
+// any pre-roll), attached so the model can draw on the speaker's tone/intent
+// beyond the transcribed text.
+func (m *Manager) streamAutoAnswer(ctx context.Context, question string, audioClip []byte) {
 	ctx, span := trace.StartSpan(ctx, "stream_auto_answer")
 	defer span.End()
 	span.SetAttr("question", question)
@@ -197,9 +540,12 @@ func (m *Manager) streamAutoAnswer(ctx context.Context, question string) {
 		UserQuery:   "Answer this question concisely: " + question,
 		Transcript:  m.GetRecentTranscript(AutoAnswerTranscriptSeconds),
 		ContextText: m.GetLatestScreenText(),
+		AudioClip:   audioClip,
 	}
 
-	err := m.inference.AnalyzeStream(ctx, req, func(chunk string) {
+	var answer strings.Builder
+	_, err := m.inference.AnalyzeStream(ctx, req, func(chunk string) {
+		answer.WriteString(chunk)
 		m.autoAnswerChan <- AutoAnswerEvent{Type: "chunk", Content: chunk}
 	})
 
@@ -211,25 +557,42 @@ func (m *Manager) streamAutoAnswer(ctx context.Context, question string) {
 
 	// Emit done event
 	m.autoAnswerChan <- AutoAnswerEvent{Type: "done"}
+	m.events.Publish(ctx, "auto_answer", events.Event{Ts: time.Now(), Source: "system", Kind: "auto_answer", Text: answer.String()})
 }
 
 // Start begins orchestration.
 func (m *Manager) Start(ctx context.Context) error {
 	log := trace.Logger(ctx)
-	if m.audioCap != nil {
-		if err := m.audioCap.Start(ctx); err != nil {
+	if m.audioSrc != nil {
+		frames, err := m.audioSrc.Start(ctx)
+		if err != nil {
 			log.Warn("audio capture start failed", "error", err)
+		} else {
+			m.audioFrames = frames
+			go m.audioLoop(ctx)
 		}
-		go m.audioLoop(ctx)
 	}
 
-	go m.screenProc.Run(ctx, m.cfg.Screen.CaptureRate, m.stopCh)
+	var screenFrames <-chan capture.Frame
+	if m.screenSrc != nil {
+		frames, err := m.screenSrc.Start(ctx)
+		if err != nil {
+			log.Warn("screen capture start failed", "error", err)
+		} else {
+			screenFrames = frames
+		}
+	}
+	go m.screenProc.Run(ctx, screenFrames, m.stopCh)
 	go m.vadCleanupLoop(ctx)
 	go m.summarizationLoop(ctx)
 
 	return nil
 }
 
+// audioLoop converts each capture.Frame into an audiocap.Chunk so the
+// existing VAD/transcription pipeline (keyed by Chunk.DeviceID) keeps
+// working unchanged; capture.Frame has no DeviceID of its own, so Source is
+// reused as the per-device key.
 func (m *Manager) audioLoop(ctx context.Context) {
 	for {
 		select {
@@ -237,8 +600,25 @@ func (m *Manager) audioLoop(ctx context.Context) {
 			return
 		case <-m.stopCh:
 			return
-		case chunk := <-m.audioCap.Output():
+		case frame, ok := <-m.audioFrames:
+			if !ok {
+				return
+			}
+			chunk := audiocap.Chunk{
+				Data:      frame.Audio,
+				DeviceID:  frame.Source,
+				Source:    frame.Source,
+				Speaker:   frame.Speaker,
+				Timestamp: frame.Timestamp,
+			}
+			m.audioTimeline.Add(chunk)
 			m.audioProc.ProcessChunk(ctx, chunk)
+			if m.IsBroadcasting() {
+				m.broadcaster.PushAudio(chunk.Data)
+			}
+			if m.replay != nil {
+				m.replay.PushAudio(chunk.Data, time.Unix(0, chunk.Timestamp))
+			}
 		}
 	}
 }
@@ -322,8 +702,11 @@ func (m *Manager) summarizeOldTranscripts(ctx context.Context) {
 // Stop stops orchestration.
 func (m *Manager) Stop() {
 	close(m.stopCh)
-	if m.audioCap != nil {
-		m.audioCap.Stop()
+	if m.audioSrc != nil {
+		m.audioSrc.Stop()
+	}
+	if m.screenSrc != nil {
+		m.screenSrc.Stop()
 	}
 	if m.audioProc != nil {
 		m.audioProc.Reset()
@@ -331,6 +714,34 @@ func (m *Manager) Stop() {
 	if m.memBatcher != nil {
 		m.memBatcher.Stop()
 	}
+	m.broadcaster.Stop()
+	m.events.Close()
+	if m.replay != nil {
+		m.replay.Stop()
+	}
+	if err := m.diarization.Save(m.cfg.Audio.RegistryDir); err != nil {
+		trace.Logger(context.Background()).Error("failed to persist speaker registry", "dir", m.cfg.Audio.RegistryDir, "error", err)
+	}
+}
+
+// GetReplayManifest returns the current rolling HLS-style manifest of
+// captured audio/screen segments and captions, or a zero Manifest if replay
+// is disabled (cfg.Replay.Enabled is false).
+func (m *Manager) GetReplayManifest() replay.Manifest {
+	if m.replay == nil {
+		return replay.Manifest{}
+	}
+	return m.replay.Manifest()
+}
+
+// GetReplaySegment returns the absolute path backing a replay segment
+// previously listed in GetReplayManifest's playlists, or "" if replay is
+// disabled or the segment has aged out of the window.
+func (m *Manager) GetReplaySegment(kind string, seq uint64) string {
+	if m.replay == nil {
+		return ""
+	}
+	return m.replay.SegmentPath(kind, seq)
 }
 
 // GetRecentTranscript returns transcript from last N seconds.
@@ -343,6 +754,15 @@ func (m *Manager) GetLatestScreenText() string {
 	return m.screenProc.Text()
 }
 
+// WHIPHandler returns the HTTP handler for the WHIP ingest endpoint, or nil
+// if WHIP ingestion is disabled (cfg.Platform.WHIPEnabled is false).
+func (m *Manager) WHIPHandler() http.Handler {
+	if m.whipSrc == nil {
+		return nil
+	}
+	return m.whipSrc.Handler()
+}
+
 // GetLatestScreenImage returns the latest screenshot.
 func (m *Manager) GetLatestScreenImage() []byte {
 	return m.screenProc.Image()
@@ -357,11 +777,71 @@ func (m *Manager) SetRecording(enabled bool) {
 	trace.Logger(context.Background()).Info("recording state changed", "enabled", enabled)
 }
 
+// StartBroadcast tees mixed audio and transcript captions to url (an RTMP
+// or WHIP endpoint) until StopBroadcast is called.
+func (m *Manager) StartBroadcast(url string) error {
+	return m.broadcaster.Start(url)
+}
+
+// StopBroadcast ends the active broadcast, if any.
+func (m *Manager) StopBroadcast() {
+	m.broadcaster.Stop()
+}
+
+// IsBroadcasting reports whether a broadcast is currently active.
+func (m *Manager) IsBroadcasting() bool {
+	return m.broadcaster.IsActive()
+}
+
+// AddStreamSource connects to url (an ICY/Shoutcast internet radio stream)
+// and begins transcribing its audio as an additional source, tagged with
+// url, until RemoveStreamSource is called.
+func (m *Manager) AddStreamSource(url string) error {
+	return m.streamSrc.Add(url)
+}
+
+// RemoveStreamSource disconnects a stream source added earlier via
+// AddStreamSource.
+func (m *Manager) RemoveStreamSource(url string) error {
+	return m.streamSrc.Remove(url)
+}
+
 // SetAutoAnswer enables/disables auto-answering.
 func (m *Manager) SetAutoAnswer(enabled bool) {
 	m.autoAnswer.SetEnabled(enabled)
 }
 
+// RegisterHealthChecks wires this Manager's subsystems into registry as
+// named checkers (audio_capture, screen_capture, memory_batcher), so
+// /readyz and the gRPC Health service can report which one, if any, never
+// came up. Capture.AudioSource/ScreenSource don't expose a richer liveness
+// signal than "configured", so these are coarse checks - configured and
+// non-nil - rather than a deep connectivity probe.
+func (m *Manager) RegisterHealthChecks(registry *health.Registry) {
+	registry.Register("audio_capture", func(ctx context.Context) error {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+		if m.audioSrc == nil {
+			return fmt.Errorf("audio source not configured")
+		}
+		return nil
+	})
+	registry.Register("screen_capture", func(ctx context.Context) error {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+		if m.screenSrc == nil {
+			return fmt.Errorf("screen source not configured")
+		}
+		return nil
+	})
+	registry.Register("memory_batcher", func(ctx context.Context) error {
+		if m.memBatcher == nil {
+			return fmt.Errorf("memory batcher not configured")
+		}
+		return nil
+	})
+}
+
 // Analyze sends a query to the LLM.
 func (m *Manager) Analyze(ctx context.Context, query string, onChunk func(string)) error {
 	ctx, span := trace.StartSpan(ctx, "orchestrator_analyze")
@@ -374,5 +854,6 @@ func (m *Manager) Analyze(ctx context.Context, query string, onChunk func(string
 		ContextText: m.GetLatestScreenText(),
 		ImageData:   m.GetLatestScreenImage(),
 	}
-	return m.inference.AnalyzeStream(ctx, req, onChunk)
+	_, err := m.inference.AnalyzeStream(ctx, req, onChunk)
+	return err
 }
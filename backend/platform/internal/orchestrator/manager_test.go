@@ -0,0 +1,85 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/config"
+)
+
+func TestBuildAudioSourceReturnsSingleInjectedSource(t *testing.T) {
+	fake := capture.NewFakeAudioSource()
+	src, err := buildAudioSource([]capture.AudioSource{fake}, &config.Config{})
+	if err != nil {
+		t.Fatalf("buildAudioSource: %v", err)
+	}
+	if src != fake {
+		t.Error("expected the single injected source to be returned unchanged")
+	}
+}
+
+func TestBuildAudioSourceCombinesMultipleInjectedSources(t *testing.T) {
+	a := capture.NewFakeAudioSource(capture.Frame{Source: "a"})
+	b := capture.NewFakeAudioSource(capture.Frame{Source: "b"})
+	src, err := buildAudioSource([]capture.AudioSource{a, b}, &config.Config{})
+	if err != nil {
+		t.Fatalf("buildAudioSource: %v", err)
+	}
+	if src == a || src == b {
+		t.Error("expected injected sources to be combined, not returned as-is")
+	}
+}
+
+func TestBuildAudioSourceUnknownBackend(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Audio.Backend = "nonexistent"
+
+	if _, err := buildAudioSource(nil, cfg); err == nil {
+		t.Fatal("expected error for unknown audio backend")
+	}
+}
+
+func TestBuildScreenSourceReturnsSingleInjectedSource(t *testing.T) {
+	fake := capture.NewFakeScreenSource()
+	src, err := buildScreenSource([]capture.ScreenSource{fake}, &config.Config{})
+	if err != nil {
+		t.Fatalf("buildScreenSource: %v", err)
+	}
+	if src != fake {
+		t.Error("expected the single injected source to be returned unchanged")
+	}
+}
+
+func TestBuildScreenSourceCombinesMultipleInjectedSources(t *testing.T) {
+	a := capture.NewFakeScreenSource(capture.Frame{Image: []byte("a")})
+	b := capture.NewFakeScreenSource(capture.Frame{Image: []byte("b")})
+	src, err := buildScreenSource([]capture.ScreenSource{a, b}, &config.Config{})
+	if err != nil {
+		t.Fatalf("buildScreenSource: %v", err)
+	}
+	if src == a || src == b {
+		t.Error("expected injected sources to be combined, not returned as-is")
+	}
+}
+
+func TestBuildScreenSourceUnknownBackend(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Screen.Backend = "nonexistent"
+
+	if _, err := buildScreenSource(nil, cfg); err == nil {
+		t.Fatal("expected error for unknown screen backend")
+	}
+}
+
+func TestBuildScreenSourceDefaultBackend(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Screen.CaptureRate = 1.0
+
+	src, err := buildScreenSource(nil, cfg)
+	if err != nil {
+		t.Fatalf("buildScreenSource: %v", err)
+	}
+	if src == nil {
+		t.Fatal("expected a default screen source, got nil")
+	}
+}
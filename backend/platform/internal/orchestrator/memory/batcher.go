@@ -3,107 +3,312 @@ package memory
 
 import (
 	"context"
+	"log/slog"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/grpcclient"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
 )
 
-// Batcher accumulates memory items and flushes them in batches.
+// Priority ranks queued memory items so higher-value ones flush sooner.
+// Audio transcripts outrank screen OCR, which outranks passive context.
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityMedium
+	PriorityHigh
+
+	numPriorities = int(PriorityHigh) + 1
+)
+
+// priorityForSource derives a Priority from the source tag callers already
+// pass to Add, so tiering doesn't require changing Add's signature.
+func priorityForSource(source string) Priority {
+	switch source {
+	case "audio":
+		return PriorityHigh
+	case "screen":
+		return PriorityMedium
+	default:
+		return PriorityLow
+	}
+}
+
+// tier holds one priority level's pending items and its own flush timer, so
+// high-priority items flush on a shorter delay without waiting on lower
+// tiers.
+type tier struct {
+	mu    sync.Mutex
+	items []grpcclient.MemoryItem
+	timer *time.Timer
+	delay time.Duration
+}
+
+// Batcher accumulates memory items and flushes them in priority-tiered
+// batches. It deduplicates near-identical items per source, caps how many
+// flushes run concurrently, retries a failing flush with backoff, and
+// persists a batch that exhausts its retry budget to an on-disk dead-letter
+// queue instead of losing it.
 type Batcher struct {
-	client     *grpcclient.Client
-	maxSize    int
-	flushDelay time.Duration
-	mu         sync.Mutex
-	items      []grpcclient.MemoryItem
-	timer      *time.Timer
-	stopCh     chan struct{}
-	wg         sync.WaitGroup
+	store   func(ctx context.Context, items []grpcclient.MemoryItem) (int32, error)
+	maxSize int
+
+	tiers [numPriorities]*tier
+	dedup *dedupTracker
+	dlq   *dlq
+
+	sem     chan struct{}
+	latency *resilience.LatencyTracker
+
+	queued       int64
+	droppedDupes int64
+	retriesTotal int64
+	droppedTotal int64
+
+	wg sync.WaitGroup
 }
 
-// NewBatcher creates a memory batcher.
-func NewBatcher(client *grpcclient.Client, maxSize int, flushDelay time.Duration) *Batcher {
+// NewBatcher creates a memory batcher. maxSize bounds each priority tier,
+// flushDelay sets the low-priority (passive-context) flush delay (the
+// medium and high priority tiers flush sooner, scaled off it), and dlqDir
+// is the directory its dead-letter queue persists undeliverable batches to.
+// Any batch left over from a prior run's dead-letter queue is re-enqueued
+// before NewBatcher returns, so it's retried ahead of anything Add queues.
+func NewBatcher(client *grpcclient.Client, maxSize int, flushDelay time.Duration, dlqDir string) *Batcher {
 	if maxSize <= 0 {
 		maxSize = DefaultBatcherMaxSize
 	}
 	if flushDelay <= 0 {
 		flushDelay = DefaultBatcherFlushDelay
 	}
-	return &Batcher{
-		client:     client,
-		maxSize:    maxSize,
-		flushDelay: flushDelay,
-		items:      make([]grpcclient.MemoryItem, 0, maxSize),
-		stopCh:     make(chan struct{}),
+
+	q, err := newDLQ(dlqDir)
+	if err != nil {
+		slog.Error("failed to open memory dead-letter queue, undeliverable batches will be dropped", "error", err, "dir", dlqDir)
+		q = nil
+	}
+
+	b := &Batcher{
+		store:   client.BatchStoreMemory,
+		maxSize: maxSize,
+		dedup:   newDedupTracker(DefaultDedupWindow, DefaultDedupThreshold),
+		dlq:     q,
+		sem:     make(chan struct{}, DefaultMaxInFlightFlushes),
+		latency: resilience.NewLatencyTracker(resilience.DefaultLatencyWindow),
+	}
+	b.tiers[PriorityHigh] = &tier{delay: flushDelay / 4, items: make([]grpcclient.MemoryItem, 0, maxSize)}
+	b.tiers[PriorityMedium] = &tier{delay: flushDelay / 2, items: make([]grpcclient.MemoryItem, 0, maxSize)}
+	b.tiers[PriorityLow] = &tier{delay: flushDelay, items: make([]grpcclient.MemoryItem, 0, maxSize)}
+
+	b.reenqueueDLQ()
+
+	return b
+}
+
+// reenqueueDLQ drains any batches a previous run couldn't deliver and
+// resubmits them through the normal flush path.
+func (b *Batcher) reenqueueDLQ() {
+	if b.dlq == nil {
+		return
+	}
+	batches, err := b.dlq.Drain()
+	if err != nil {
+		slog.Error("failed to drain memory dead-letter queue", "error", err)
+		return
+	}
+	if len(batches) == 0 {
+		return
+	}
+	slog.Info("re-enqueuing memory batches persisted by a previous run", "batches", len(batches))
+	for _, items := range batches {
+		atomic.AddInt64(&b.queued, int64(len(items)))
+		b.flushAsync(items)
 	}
 }
 
-// Add queues an item for batched storage.
+// Add queues an item for batched storage, tiering it by the priority its
+// source implies and dropping it if it's a near-duplicate of something
+// recently queued from the same source.
 func (b *Batcher) Add(text, source string) {
-	b.mu.Lock()
-	defer b.mu.Unlock()
+	if b.dedup.seen(source, text) {
+		atomic.AddInt64(&b.droppedDupes, 1)
+		return
+	}
 
-	b.items = append(b.items, grpcclient.MemoryItem{Text: text, Source: source})
+	t := b.tiers[priorityForSource(source)]
 
-	if len(b.items) >= b.maxSize {
-		b.flushLocked()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.items = append(t.items, grpcclient.MemoryItem{Text: text, Source: source})
+	atomic.AddInt64(&b.queued, 1)
+
+	if len(t.items) >= b.maxSize {
+		b.flushTierLocked(t)
 		return
 	}
 
-	// Start or reset timer for delayed flush
-	if b.timer == nil {
-		b.timer = time.AfterFunc(b.flushDelay, b.timerFlush)
+	if t.timer == nil {
+		t.timer = time.AfterFunc(t.delay, func() { b.timerFlush(t) })
 	} else {
-		b.timer.Reset(b.flushDelay)
+		t.timer.Reset(t.delay)
 	}
 }
 
-func (b *Batcher) timerFlush() {
-	b.mu.Lock()
-	defer b.mu.Unlock()
-	b.flushLocked()
+func (b *Batcher) timerFlush(t *tier) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b.flushTierLocked(t)
 }
 
-func (b *Batcher) flushLocked() {
-	if len(b.items) == 0 {
+// flushTierLocked drains t's pending items and hands them off for async
+// storage. t must already be locked by the caller.
+func (b *Batcher) flushTierLocked(t *tier) {
+	if len(t.items) == 0 {
 		return
 	}
-	if b.timer != nil {
-		b.timer.Stop()
-		b.timer = nil
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
 	}
-	items := b.items
-	b.items = make([]grpcclient.MemoryItem, 0, b.maxSize)
+	items := t.items
+	t.items = make([]grpcclient.MemoryItem, 0, b.maxSize)
+	b.flushAsync(items)
+}
 
+// flushAsync stores items in the background, bounded by a semaphore so a
+// burst of tier flushes can't stampede the inference server with unbounded
+// concurrent RPCs.
+func (b *Batcher) flushAsync(items []grpcclient.MemoryItem) {
 	b.wg.Add(1)
 	go func() {
 		defer b.wg.Done()
-		ctx, span := trace.StartSpan(context.Background(), "memory_batch_flush")
-		defer span.End()
-		span.SetAttr("count", len(items))
-
-		log := trace.Logger(ctx)
-		stored, err := b.client.BatchStoreMemory(ctx, items)
-		if err != nil {
-			span.SetAttr("error", err.Error())
-			log.Warn("batch memory store failed", "error", err, "count", len(items))
-		} else {
-			log.Debug("batch memory stored", "stored", stored, "submitted", len(items))
-		}
+
+		b.sem <- struct{}{}
+		b.attemptFlush(items)
+		<-b.sem
+
+		atomic.AddInt64(&b.queued, -int64(len(items)))
 	}()
 }
 
-// Flush forces immediate flush of pending items.
+// attemptFlush stores items, retrying transient errors with exponential
+// backoff (base 100ms, factor 2, capped at 30s, +/-20% jitter, up to
+// DefaultFlushMaxRetries retries), and records the flush's latency and
+// outcome on a memory_batch_flush trace span. A batch that still fails once
+// the retry budget is spent is persisted to the dead-letter queue rather
+// than dropped. It reports whether the flush ultimately succeeded.
+func (b *Batcher) attemptFlush(items []grpcclient.MemoryItem) bool {
+	ctx, span := trace.StartSpan(context.Background(), "memory_batch_flush")
+	defer span.End()
+	span.SetAttr("count", len(items))
+	span.SetAttr("queued", atomic.LoadInt64(&b.queued))
+	span.SetAttr("dropped_dupes", atomic.LoadInt64(&b.droppedDupes))
+
+	log := trace.Logger(ctx)
+	start := time.Now()
+
+	retryCfg := resilience.RetryConfig{
+		MaxRetries: DefaultFlushMaxRetries,
+		Strategy: resilience.NewBackoff(resilience.BackoffConfig{
+			BaseDelay: DefaultFlushRetryBaseDelay,
+			Factor:    DefaultFlushRetryFactor,
+			Jitter:    DefaultFlushRetryJitter,
+			MaxDelay:  DefaultFlushRetryMaxDelay,
+		}),
+		IsRetryable: resilience.IsRetryableGRPC,
+	}
+
+	attempts := 0
+	var stored int32
+	err := resilience.Retry(ctx, retryCfg, func() error {
+		if attempts > 0 {
+			atomic.AddInt64(&b.retriesTotal, 1)
+		}
+		attempts++
+		var err error
+		stored, err = b.store(ctx, items)
+		return err
+	})
+
+	b.latency.Record(time.Since(start))
+	span.SetAttr("flush_latency_p50_ms", b.latency.Percentile(0.5).Milliseconds())
+	span.SetAttr("flush_latency_p99_ms", b.latency.Percentile(0.99).Milliseconds())
+
+	if err != nil {
+		span.SetAttr("error", err.Error())
+		log.Warn("batch memory store exhausted its retry budget", "error", err, "count", len(items), "attempts", attempts)
+		b.deadLetter(items, log)
+		return false
+	}
+	log.Debug("batch memory stored", "stored", stored, "submitted", len(items))
+	return true
+}
+
+// deadLetter persists a batch that exhausted its retry budget so a
+// restarted Batcher can redeliver it, counting it as dropped only if the
+// dead-letter queue itself is unavailable or full.
+func (b *Batcher) deadLetter(items []grpcclient.MemoryItem, log *slog.Logger) {
+	if b.dlq == nil {
+		atomic.AddInt64(&b.droppedTotal, 1)
+		return
+	}
+	if err := b.dlq.Append(items, DefaultDLQMaxEntries); err != nil {
+		atomic.AddInt64(&b.droppedTotal, 1)
+		log.Error("memory dead-letter queue rejected batch, dropping", "error", err, "count", len(items))
+		return
+	}
+	log.Warn("persisted undeliverable batch to memory dead-letter queue", "count", len(items))
+}
+
+// Flush forces immediate flush of pending items across all priority tiers.
 func (b *Batcher) Flush() {
-	b.mu.Lock()
-	defer b.mu.Unlock()
-	b.flushLocked()
+	for _, t := range b.tiers {
+		t.mu.Lock()
+		b.flushTierLocked(t)
+		t.mu.Unlock()
+	}
 }
 
-// Stop stops the batcher and flushes remaining items.
+// Stop stops the batcher, flushing remaining items (persisting them to the
+// dead-letter queue instead of dropping them if the flush fails) and
+// waiting for all in-flight flushes to finish.
 func (b *Batcher) Stop() {
-	close(b.stopCh)
 	b.Flush()
 	b.wg.Wait()
 }
+
+// Stats is a point-in-time snapshot of a Batcher's internal metrics,
+// suitable for exporting as Prometheus-style counters/gauges
+// (memory_batch_retries_total, memory_batch_dropped_total, memory_dlq_depth).
+type Stats struct {
+	Queued          int64
+	DroppedDupes    int64
+	RetriesTotal    int64
+	DroppedTotal    int64
+	DLQDepth        int64
+	FlushLatencyP50 time.Duration
+	FlushLatencyP99 time.Duration
+}
+
+// Stats reports queue depth, dropped-item counts, retry count, dead-letter
+// queue depth, and recent flush latency percentiles.
+func (b *Batcher) Stats() Stats {
+	var dlqDepth int64
+	if b.dlq != nil {
+		dlqDepth = b.dlq.Depth()
+	}
+	return Stats{
+		Queued:          atomic.LoadInt64(&b.queued),
+		DroppedDupes:    atomic.LoadInt64(&b.droppedDupes),
+		RetriesTotal:    atomic.LoadInt64(&b.retriesTotal),
+		DroppedTotal:    atomic.LoadInt64(&b.droppedTotal),
+		DLQDepth:        dlqDepth,
+		FlushLatencyP50: b.latency.Percentile(0.5),
+		FlushLatencyP99: b.latency.Percentile(0.99),
+	}
+}
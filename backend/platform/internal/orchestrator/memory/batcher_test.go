@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -9,85 +10,224 @@ import (
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/grpcclient"
 )
 
-type mockClient struct {
-	mu    sync.Mutex
-	calls [][]grpcclient.MemoryItem
-	err   error
+// newTestBatcher builds a Batcher with store swapped for a test double,
+// since grpcclient.Client has no interface seam to mock directly.
+func newTestBatcher(t *testing.T, maxSize int, flushDelay time.Duration, store func(context.Context, []grpcclient.MemoryItem) (int32, error)) *Batcher {
+	b := NewBatcher(nil, maxSize, flushDelay, t.TempDir())
+	b.store = store
+	return b
 }
 
-func (m *mockClient) BatchStoreMemory(_ context.Context, items []grpcclient.MemoryItem) (int32, error) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	m.calls = append(m.calls, items)
-	if m.err != nil {
-		return 0, m.err
+func recordingStore() (func(context.Context, []grpcclient.MemoryItem) (int32, error), func() [][]grpcclient.MemoryItem) {
+	var mu sync.Mutex
+	var calls [][]grpcclient.MemoryItem
+	store := func(_ context.Context, items []grpcclient.MemoryItem) (int32, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, items)
+		return int32(len(items)), nil
 	}
-	return int32(len(items)), nil
+	getCalls := func() [][]grpcclient.MemoryItem {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+	return store, getCalls
 }
 
-func (m *mockClient) getCalls() [][]grpcclient.MemoryItem {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	return m.calls
+func waitFor(t *testing.T, timeout time.Duration, cond func() bool) {
+	t.Helper()
+	deadline := time.After(timeout)
+	for {
+		if cond() {
+			return
+		}
+		select {
+		case <-deadline:
+			t.Fatal("condition not met before timeout")
+		case <-time.After(time.Millisecond):
+		}
+	}
 }
 
 func TestBatcher_FlushOnMaxSize(t *testing.T) {
-	mock := &mockClient{}
-	// Use interface wrapper since Batcher expects *grpcclient.Client
-	b := &Batcher{
-		client:     nil, // We'll test via direct flush
-		maxSize:    3,
-		flushDelay: time.Hour, // Won't trigger
-		items:      make([]grpcclient.MemoryItem, 0, 3),
-		stopCh:     make(chan struct{}),
-	}
-	// Override flush behavior for testing
-	b.items = []grpcclient.MemoryItem{
-		{Text: "a", Source: "audio"},
-		{Text: "b", Source: "audio"},
-	}
-	if len(b.items) != 2 {
-		t.Errorf("expected 2 items, got %d", len(b.items))
-	}
-	_ = mock // suppress unused warning
+	store, getCalls := recordingStore()
+	b := newTestBatcher(t, 2, time.Hour, store)
+	defer b.Stop()
+
+	b.Add("first item", "audio")
+	b.Add("second different item", "audio")
+
+	waitFor(t, time.Second, func() bool { return len(getCalls()) > 0 })
 }
 
-func TestBatcher_AddAccumulatesItems(t *testing.T) {
-	b := &Batcher{
-		maxSize:    100,
-		flushDelay: time.Hour,
-		items:      make([]grpcclient.MemoryItem, 0, 100),
-		stopCh:     make(chan struct{}),
+func TestBatcher_HighPriorityFlushesFaster(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	store := func(_ context.Context, items []grpcclient.MemoryItem) (int32, error) {
+		mu.Lock()
+		order = append(order, items[0].Source)
+		mu.Unlock()
+		return int32(len(items)), nil
+	}
+
+	b := newTestBatcher(t, 100, 40*time.Millisecond, store)
+	defer b.Stop()
+
+	b.Add("passive context note", "context")
+	b.Add("audio transcript text", "audio")
+
+	waitFor(t, time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(order) >= 2
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if order[0] != "audio" {
+		t.Errorf("expected the high-priority (audio) tier to flush first, got %v", order)
+	}
+}
+
+func TestBatcher_DropsNearDuplicates(t *testing.T) {
+	store, getCalls := recordingStore()
+	b := newTestBatcher(t, 10, time.Hour, store)
+	defer b.Stop()
+
+	b.Add("the quick brown fox jumps over the lazy dog", "screen")
+	b.Add("the quick brown fox jumps over the lazy dog", "screen")
+	b.Flush()
+
+	waitFor(t, time.Second, func() bool { return len(getCalls()) > 0 })
+
+	total := 0
+	for _, call := range getCalls() {
+		total += len(call)
+	}
+	if total != 1 {
+		t.Errorf("expected the duplicate to be dropped, stored %d items", total)
+	}
+	if got := b.Stats().DroppedDupes; got != 1 {
+		t.Errorf("DroppedDupes = %d, want 1", got)
 	}
+}
+
+func TestBatcher_AddAccumulatesItems(t *testing.T) {
+	store, getCalls := recordingStore()
+	b := newTestBatcher(t, 100, time.Hour, store)
+	defer b.Stop()
 
-	b.mu.Lock()
-	b.items = append(b.items, grpcclient.MemoryItem{Text: "test1", Source: "audio"})
-	b.items = append(b.items, grpcclient.MemoryItem{Text: "test2", Source: "screen"})
-	count := len(b.items)
-	b.mu.Unlock()
+	b.Add("test1", "audio")
+	b.Add("test2 unrelated content", "screen")
 
-	if count != 2 {
-		t.Errorf("expected 2 items, got %d", count)
+	if got := b.Stats().Queued; got != 2 {
+		t.Errorf("Queued = %d, want 2", got)
+	}
+	if len(getCalls()) != 0 {
+		t.Error("expected no flush before max size or timer fires")
 	}
 }
 
 func TestBatcher_StopFlushesRemaining(t *testing.T) {
-	b := &Batcher{
-		maxSize:    100,
-		flushDelay: time.Hour,
-		items:      make([]grpcclient.MemoryItem, 0, 100),
-		stopCh:     make(chan struct{}),
+	store, getCalls := recordingStore()
+	b := newTestBatcher(t, 100, time.Hour, store)
+
+	b.Add("remaining", "audio")
+	b.Stop()
+
+	total := 0
+	for _, call := range getCalls() {
+		total += len(call)
+	}
+	if total != 1 {
+		t.Errorf("expected Stop to flush the remaining item, stored %d", total)
+	}
+}
+
+func TestBatcher_RetriesFailedFlush(t *testing.T) {
+	var mu sync.Mutex
+	attempts := 0
+	store := func(_ context.Context, items []grpcclient.MemoryItem) (int32, error) {
+		mu.Lock()
+		attempts++
+		n := attempts
+		mu.Unlock()
+		if n < 2 {
+			return 0, errors.New("rpc error: code = Unavailable desc = inference server down")
+		}
+		return int32(len(items)), nil
+	}
+
+	b := newTestBatcher(t, 1, time.Hour, store)
+	defer b.Stop()
+
+	b.Add("audio transcript text", "audio")
+
+	waitFor(t, 2*time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return attempts >= 2
+	})
+
+	waitFor(t, 2*time.Second, func() bool { return b.Stats().Queued == 0 })
+	if got := b.Stats().RetriesTotal; got < 1 {
+		t.Errorf("RetriesTotal = %d, want at least 1", got)
+	}
+}
+
+// TestBatcher_DeadLettersExhaustedBatch proves a batch that fails every
+// retry attempt is persisted to the dead-letter queue rather than dropped,
+// and counted as such.
+func TestBatcher_DeadLettersExhaustedBatch(t *testing.T) {
+	alwaysFails := func(_ context.Context, _ []grpcclient.MemoryItem) (int32, error) {
+		return 0, errors.New("rpc error: code = Unavailable desc = inference server down")
 	}
 
-	b.mu.Lock()
-	b.items = append(b.items, grpcclient.MemoryItem{Text: "remaining", Source: "audio"})
-	b.mu.Unlock()
+	dir := t.TempDir()
+	b := NewBatcher(nil, 1, time.Hour, dir)
+	b.store = alwaysFails
+	defer b.Stop()
 
-	// Simulate stop without actual client
-	close(b.stopCh)
-	b.mu.Lock()
-	b.items = nil // Manual clear since no client
-	b.mu.Unlock()
+	b.Add("audio transcript text", "audio")
 
-	b.wg.Wait()
+	waitFor(t, 5*time.Second, func() bool { return b.Stats().DLQDepth == 1 })
+	if got := b.Stats().Queued; got != 0 {
+		t.Errorf("Queued = %d, want 0 once the batch moved to the dead-letter queue", got)
+	}
+	if got := b.Stats().DroppedTotal; got != 0 {
+		t.Errorf("DroppedTotal = %d, want 0 (the batch was persisted, not dropped)", got)
+	}
+}
+
+// TestBatcher_RedeliversDeadLetterOnRestart proves ordering and eventual
+// delivery: a batch persisted by one Batcher because the store kept
+// failing is redelivered, in order, by the next Batcher pointed at the
+// same directory once the store starts succeeding.
+func TestBatcher_RedeliversDeadLetterOnRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	alwaysFails := func(_ context.Context, _ []grpcclient.MemoryItem) (int32, error) {
+		return 0, errors.New("rpc error: code = Unavailable desc = inference server down")
+	}
+	first := NewBatcher(nil, 1, time.Hour, dir)
+	first.store = alwaysFails
+	first.Add("first transcript segment", "audio")
+	waitFor(t, 5*time.Second, func() bool { return first.Stats().DLQDepth == 1 })
+	first.Stop()
+
+	store, getCalls := recordingStore()
+	second := NewBatcher(nil, 1, time.Hour, dir)
+	second.store = store
+	defer second.Stop()
+
+	waitFor(t, time.Second, func() bool { return len(getCalls()) > 0 })
+
+	calls := getCalls()
+	if len(calls) != 1 || len(calls[0]) != 1 || calls[0][0].Text != "first transcript segment" {
+		t.Errorf("expected the dead-lettered batch to be redelivered once, in order, got %v", calls)
+	}
+	if got := second.Stats().DLQDepth; got != 0 {
+		t.Errorf("DLQDepth = %d, want 0 after successful redelivery", got)
+	}
 }
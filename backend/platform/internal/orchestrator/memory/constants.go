@@ -7,4 +7,27 @@ import "time"
 const (
 	DefaultBatcherMaxSize    = 50
 	DefaultBatcherFlushDelay = 2 * time.Second
+
+	// DefaultDedupWindow is how many recent per-source SimHash fingerprints
+	// a Batcher compares new items against before queuing them.
+	DefaultDedupWindow = 8
+	// DefaultDedupThreshold is the SimHash similarity (see simhash.go) at or
+	// above which an item is treated as a duplicate and dropped.
+	DefaultDedupThreshold = 0.9
+
+	// DefaultMaxInFlightFlushes caps how many flush RPCs a Batcher runs
+	// concurrently, across all priority tiers.
+	DefaultMaxInFlightFlushes = 4
+
+	// Flush retry backoff: base 100ms, doubling, capped at 30s, +/-20%
+	// jitter, giving up after 5 attempts per batch.
+	DefaultFlushRetryBaseDelay = 100 * time.Millisecond
+	DefaultFlushRetryFactor    = 2.0
+	DefaultFlushRetryMaxDelay  = 30 * time.Second
+	DefaultFlushRetryJitter    = 0.2
+	DefaultFlushMaxRetries     = 5
+
+	// DefaultDLQMaxEntries bounds how many exhausted-retry batches the
+	// on-disk dead-letter queue holds before refusing new ones.
+	DefaultDLQMaxEntries = 1000
 )
@@ -0,0 +1,47 @@
+package memory
+
+import "sync"
+
+// dedupTracker collapses near-identical items per source using a rolling
+// window of SimHash fingerprints, so e.g. repeated OCR of an unchanged
+// screen region only queues once.
+type dedupTracker struct {
+	mu        sync.Mutex
+	window    int
+	threshold float64
+	recent    map[string][]uint64
+}
+
+// newDedupTracker creates a tracker keeping the last window fingerprints per
+// source, treating an item as a duplicate once its SimHash similarity to
+// any of them meets threshold (a value in [0, 1]).
+func newDedupTracker(window int, threshold float64) *dedupTracker {
+	return &dedupTracker{
+		window:    window,
+		threshold: threshold,
+		recent:    make(map[string][]uint64),
+	}
+}
+
+// seen reports whether text is a near-duplicate of something recently seen
+// from source, recording its fingerprint either way.
+func (d *dedupTracker) seen(source, text string) bool {
+	h := simhash64(text)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	hashes := d.recent[source]
+	for _, prev := range hashes {
+		if simhashSimilarity(prev, h) >= d.threshold {
+			return true
+		}
+	}
+
+	hashes = append(hashes, h)
+	if len(hashes) > d.window {
+		hashes = hashes[len(hashes)-d.window:]
+	}
+	d.recent[source] = hashes
+	return false
+}
@@ -0,0 +1,126 @@
+package memory
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/grpcclient"
+)
+
+// dlqFileName is the append-only NDJSON file a dlq writes batches to.
+const dlqFileName = "memory_batches.ndjson"
+
+// dlqRecord is one line of the dead-letter file: a batch that exhausted its
+// retry budget, kept verbatim so it can be re-submitted unchanged later.
+type dlqRecord struct {
+	Items []grpcclient.MemoryItem `json:"items"`
+}
+
+// dlq is a bounded, on-disk dead-letter queue for memory batches that a
+// Batcher couldn't store after exhausting its retry budget. It survives
+// process restarts: Drain reads everything back so a Batcher can re-enqueue
+// it on startup before accepting new items.
+type dlq struct {
+	path string
+
+	mu    sync.Mutex
+	depth int64
+}
+
+// newDLQ opens (creating if needed) a dead-letter queue rooted at dir and
+// counts any entries already on disk from a previous run.
+func newDLQ(dir string) (*dlq, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating dlq dir %s: %w", dir, err)
+	}
+	d := &dlq{path: filepath.Join(dir, dlqFileName)}
+
+	f, err := os.Open(d.path)
+	if os.IsNotExist(err) {
+		return d, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening dlq file %s: %w", d.path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		d.depth++
+	}
+	return d, nil
+}
+
+// Append writes items as one NDJSON line, refusing (without writing) once
+// depth reaches maxEntries so a prolonged outage can't grow the file
+// without bound.
+func (d *dlq) Append(items []grpcclient.MemoryItem, maxEntries int64) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.depth >= maxEntries {
+		return fmt.Errorf("dead-letter queue full (%d entries)", d.depth)
+	}
+
+	line, err := json.Marshal(dlqRecord{Items: items})
+	if err != nil {
+		return fmt.Errorf("encoding dlq record: %w", err)
+	}
+
+	f, err := os.OpenFile(d.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening dlq file %s: %w", d.path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing dlq record: %w", err)
+	}
+	d.depth++
+	return nil
+}
+
+// Drain reads back every batch currently on disk and truncates the file, so
+// a caller can re-submit them for delivery exactly once.
+func (d *dlq) Drain() ([][]grpcclient.MemoryItem, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	f, err := os.Open(d.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening dlq file %s: %w", d.path, err)
+	}
+
+	var batches [][]grpcclient.MemoryItem
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var rec dlqRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			continue
+		}
+		batches = append(batches, rec.Items)
+	}
+	f.Close()
+
+	if err := os.Truncate(d.path, 0); err != nil {
+		return nil, fmt.Errorf("truncating dlq file %s: %w", d.path, err)
+	}
+	d.depth = 0
+	return batches, nil
+}
+
+// Depth reports how many batches currently sit on disk.
+func (d *dlq) Depth() int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.depth
+}
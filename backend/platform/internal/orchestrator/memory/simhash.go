@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+)
+
+// simhash64 computes a 64-bit SimHash fingerprint of text over its
+// whitespace-separated tokens, so near-duplicate text (e.g. OCR of a mostly
+// unchanged screen region) hashes to a fingerprint with a small Hamming
+// distance from the original.
+func simhash64(text string) uint64 {
+	var weights [64]int
+	for _, tok := range strings.Fields(text) {
+		h := fnv.New64a()
+		h.Write([]byte(tok))
+		sum := h.Sum64()
+		for i := 0; i < 64; i++ {
+			if sum&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for i, w := range weights {
+		if w > 0 {
+			fingerprint |= 1 << uint(i)
+		}
+	}
+	return fingerprint
+}
+
+// simhashSimilarity approximates the Jaccard similarity of the token sets
+// behind two SimHash fingerprints as one minus their normalized Hamming
+// distance.
+func simhashSimilarity(a, b uint64) float64 {
+	return 1 - float64(bits.OnesCount64(a^b))/64
+}
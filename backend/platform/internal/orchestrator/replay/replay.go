@@ -0,0 +1,346 @@
+// Package replay keeps a rolling, on-disk window of the session's captured
+// audio and screen frames and exposes it as an HLS-style chunked manifest,
+// so a client can scrub back through what the assistant heard and saw
+// without running its own recorder.
+package replay
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hraban/opus"
+)
+
+// DefaultWindow is how far back the manifest reaches by default.
+const DefaultWindow = 10 * time.Minute
+
+// DefaultSegmentDuration is the length of each audio segment by default.
+const DefaultSegmentDuration = 2 * time.Second
+
+// opusFrameDuration is the size of each frame handed to the Opus encoder.
+// libopus only accepts 2.5/5/10/20/40/60ms frames per encode call, so a
+// segment's audio is sliced into frames of this length rather than encoded
+// in one call.
+const opusFrameDuration = 20 * time.Millisecond
+
+// Config controls where Manager writes segments and how large the rolling
+// window is.
+type Config struct {
+	Dir             string        // segment root directory; required
+	Window          time.Duration // 0 uses DefaultWindow
+	SegmentDuration time.Duration // audio segment length; 0 uses DefaultSegmentDuration
+}
+
+func (c Config) withDefaults() Config {
+	if c.Window <= 0 {
+		c.Window = DefaultWindow
+	}
+	if c.SegmentDuration <= 0 {
+		c.SegmentDuration = DefaultSegmentDuration
+	}
+	return c
+}
+
+// segment is one emitted chunk of the rolling audio or screen buffer.
+type segment struct {
+	seq      uint64
+	path     string
+	start    time.Time
+	duration time.Duration
+}
+
+// caption is a finalized transcript entry rendered as a WebVTT cue, kept
+// around only as long as its covering segments.
+type caption struct {
+	start, end time.Time
+	source     string
+	text       string
+}
+
+// Manager buffers captured audio into fixed-duration Opus segments, screen
+// frames into a parallel low-fps JPEG segment track, and finalized
+// transcript entries into WebVTT cues, pruning everything older than
+// cfg.Window as new segments arrive.
+type Manager struct {
+	cfg        Config
+	sampleRate int
+
+	mu           sync.Mutex
+	encoder      *opus.Encoder
+	frameSamples int
+	audioBuf     []float32
+	audioStart   time.Time
+	audioSeq     uint64
+	screenSeq    uint64
+	audioSegs    []segment
+	screenSegs   []segment
+	captions     []caption
+}
+
+// NewManager creates cfg.Dir (and audio/screen subdirectories within it) and
+// returns a Manager ready to receive PushAudio/PushScreenFrame/AddCaption
+// calls for a capture running at sampleRate.
+func NewManager(cfg Config, sampleRate int) (*Manager, error) {
+	cfg = cfg.withDefaults()
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("replay: Dir is required")
+	}
+	for _, sub := range []string{"audio", "screen"} {
+		if err := os.MkdirAll(filepath.Join(cfg.Dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("replay: create dir %s: %w", sub, err)
+		}
+	}
+
+	enc, err := opus.NewEncoder(sampleRate, 1, opus.AppVoIP)
+	if err != nil {
+		return nil, fmt.Errorf("replay: new opus encoder: %w", err)
+	}
+
+	return &Manager{
+		cfg:          cfg,
+		sampleRate:   sampleRate,
+		encoder:      enc,
+		frameSamples: int(opusFrameDuration.Seconds() * float64(sampleRate)),
+	}, nil
+}
+
+// PushAudio appends samples captured at ts to the rolling audio buffer,
+// flushing a complete segment to disk once SegmentDuration worth of audio
+// has accumulated.
+func (m *Manager) PushAudio(samples []float32, ts time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.audioBuf == nil {
+		m.audioStart = ts
+	}
+	m.audioBuf = append(m.audioBuf, samples...)
+
+	segFrames := int(m.cfg.SegmentDuration.Seconds() * float64(m.sampleRate))
+	for len(m.audioBuf) >= segFrames {
+		m.flushAudioSegmentLocked(m.audioBuf[:segFrames])
+		m.audioBuf = append([]float32(nil), m.audioBuf[segFrames:]...)
+		m.audioStart = m.audioStart.Add(m.cfg.SegmentDuration)
+	}
+
+	m.pruneLocked(ts)
+}
+
+// flushAudioSegmentLocked encodes samples as a sequence of fixed-size Opus
+// frames (libopus rejects anything longer than 60ms per encode call) and
+// writes them to the segment file as consecutive [uint32 length][packet]
+// records. The final partial frame, if any, is zero-padded to frameSamples
+// since Opus requires every encoded frame to be one of its fixed durations.
+func (m *Manager) flushAudioSegmentLocked(samples []float32) {
+	if len(samples) == 0 {
+		return
+	}
+
+	var data []byte
+	buf := make([]byte, 4000)
+	frame := make([]float32, m.frameSamples)
+	for off := 0; off < len(samples); off += m.frameSamples {
+		end := off + m.frameSamples
+		if end > len(samples) {
+			n := copy(frame, samples[off:])
+			for i := n; i < len(frame); i++ {
+				frame[i] = 0
+			}
+			n, err := m.encoder.EncodeFloat32(frame, buf)
+			if err != nil {
+				return
+			}
+			data = appendPacket(data, buf[:n])
+			break
+		}
+
+		n, err := m.encoder.EncodeFloat32(samples[off:end], buf)
+		if err != nil {
+			return
+		}
+		data = appendPacket(data, buf[:n])
+	}
+
+	seq := m.audioSeq
+	m.audioSeq++
+	path := filepath.Join(m.cfg.Dir, "audio", fmt.Sprintf("%d.opus", seq))
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return
+	}
+
+	m.audioSegs = append(m.audioSegs, segment{
+		seq: seq, path: path, start: m.audioStart, duration: m.cfg.SegmentDuration,
+	})
+}
+
+// appendPacket appends packet to data as a [uint32 big-endian length][bytes]
+// record, the framing flushAudioSegmentLocked uses to store multiple Opus
+// packets in a single segment file.
+func appendPacket(data, packet []byte) []byte {
+	var lenBuf [4]byte
+	binary.BigEndian.PutUint32(lenBuf[:], uint32(len(packet)))
+	data = append(data, lenBuf[:]...)
+	return append(data, packet...)
+}
+
+// PushScreenFrame stores jpeg as the next segment of the low-fps screen
+// track, captured at ts.
+func (m *Manager) PushScreenFrame(jpeg []byte, ts time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seq := m.screenSeq
+	m.screenSeq++
+	path := filepath.Join(m.cfg.Dir, "screen", fmt.Sprintf("%d.jpg", seq))
+	if err := os.WriteFile(path, jpeg, 0o644); err != nil {
+		return
+	}
+
+	duration := m.cfg.SegmentDuration
+	if n := len(m.screenSegs); n > 0 {
+		if d := ts.Sub(m.screenSegs[n-1].start); d > 0 {
+			duration = d
+		}
+	}
+
+	m.screenSegs = append(m.screenSegs, segment{seq: seq, path: path, start: ts, duration: duration})
+	m.pruneLocked(ts)
+}
+
+// AddCaption records a finalized transcript entry as a WebVTT cue covering
+// [ts, ts+cueDuration).
+func (m *Manager) AddCaption(text, source string, ts time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.captions = append(m.captions, caption{
+		start: ts, end: ts.Add(m.cfg.SegmentDuration), source: source, text: text,
+	})
+	m.pruneLocked(ts)
+}
+
+// pruneLocked drops segments and captions older than cfg.Window relative to
+// now, removing their backing files. Must be called with mu held.
+func (m *Manager) pruneLocked(now time.Time) {
+	cutoff := now.Add(-m.cfg.Window)
+
+	m.audioSegs = pruneSegments(m.audioSegs, cutoff)
+	m.screenSegs = pruneSegments(m.screenSegs, cutoff)
+
+	kept := m.captions[:0]
+	for _, c := range m.captions {
+		if c.end.Before(cutoff) {
+			continue
+		}
+		kept = append(kept, c)
+	}
+	m.captions = kept
+}
+
+func pruneSegments(segs []segment, cutoff time.Time) []segment {
+	kept := segs[:0]
+	for _, s := range segs {
+		if s.start.Add(s.duration).Before(cutoff) {
+			_ = os.Remove(s.path)
+			continue
+		}
+		kept = append(kept, s)
+	}
+	return kept
+}
+
+// Manifest is the rolling HLS-style playlists and caption track returned by
+// Manifest.
+type Manifest struct {
+	AudioPlaylist  string // #EXTM3U text referencing audio segment files
+	ScreenPlaylist string // #EXTM3U text referencing screen segment files
+	Captions       string // WebVTT text covering the current window
+}
+
+// Manifest builds the current rolling manifest from buffered segments and
+// captions.
+func (m *Manager) Manifest() Manifest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return Manifest{
+		AudioPlaylist:  buildPlaylist(m.audioSegs, "audio"),
+		ScreenPlaylist: buildPlaylist(m.screenSegs, "screen"),
+		Captions:       buildVTT(m.captions),
+	}
+}
+
+func buildPlaylist(segs []segment, kind string) string {
+	target := DefaultSegmentDuration
+	for _, s := range segs {
+		if s.duration > target {
+			target = s.duration
+		}
+	}
+
+	out := "#EXTM3U\n#EXT-X-VERSION:3\n"
+	out += fmt.Sprintf("#EXT-X-TARGETDURATION:%d\n", int(target.Seconds()+1))
+	if len(segs) > 0 {
+		out += fmt.Sprintf("#EXT-X-MEDIA-SEQUENCE:%d\n", segs[0].seq)
+	}
+	for _, s := range segs {
+		out += fmt.Sprintf("#EXTINF:%.3f,\n%s/%d\n", s.duration.Seconds(), kind, s.seq)
+	}
+	return out
+}
+
+func buildVTT(captions []caption) string {
+	out := "WEBVTT\n\n"
+	for _, c := range captions {
+		out += fmt.Sprintf("%s --> %s\n%s: %s\n\n", vttTimestamp(c.start), vttTimestamp(c.end), c.source, c.text)
+	}
+	return out
+}
+
+func vttTimestamp(t time.Time) string {
+	return t.UTC().Format("15:04:05.000")
+}
+
+// SegmentPath returns the absolute path backing segment seq of the given
+// kind ("audio" or "screen"), or "" if it has aged out of the window or
+// never existed.
+func (m *Manager) SegmentPath(kind string, seq uint64) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	segs := m.audioSegs
+	if kind == "screen" {
+		segs = m.screenSegs
+	}
+	for _, s := range segs {
+		if s.seq == seq {
+			return s.path
+		}
+	}
+	return ""
+}
+
+// Stop flushes any partial audio segment and removes every remaining
+// segment file, tearing down the replay buffer on shutdown.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.audioBuf) > 0 {
+		m.flushAudioSegmentLocked(m.audioBuf)
+		m.audioBuf = nil
+	}
+	for _, s := range m.audioSegs {
+		_ = os.Remove(s.path)
+	}
+	for _, s := range m.screenSegs {
+		_ = os.Remove(s.path)
+	}
+	m.audioSegs = nil
+	m.screenSegs = nil
+	m.captions = nil
+}
@@ -0,0 +1,51 @@
+//go:build darwin
+
+package ocr
+
+/*
+#cgo LDFLAGS: -framework Vision -framework Foundation -framework CoreImage
+#include <stdlib.h>
+
+char *gl_vision_recognize_text(const void *data, long length, char **error_out);
+*/
+import "C"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// AppleVisionProvider runs OCR via the Vision framework's
+// VNRecognizeTextRequest, calling into a small Objective-C shim
+// (applevision_darwin.m) since Vision has no C entry point of its own.
+type AppleVisionProvider struct{}
+
+// NewAppleVisionProvider creates a Vision-backed OCR provider. The non-darwin
+// build of this type (applevision_other.go) always reports unavailable.
+func NewAppleVisionProvider() *AppleVisionProvider {
+	return &AppleVisionProvider{}
+}
+
+func (a *AppleVisionProvider) Name() string { return "applevision" }
+
+func (a *AppleVisionProvider) Available() bool { return true }
+
+func (a *AppleVisionProvider) ExtractText(ctx context.Context, img []byte, format string) (string, error) {
+	if len(img) == 0 {
+		return "", errors.New("applevision: empty image")
+	}
+
+	var cErr *C.char
+	cText := C.gl_vision_recognize_text(unsafe.Pointer(&img[0]), C.long(len(img)), &cErr)
+	if cErr != nil {
+		defer C.free(unsafe.Pointer(cErr))
+		return "", fmt.Errorf("applevision: %s", C.GoString(cErr))
+	}
+	if cText == nil {
+		return "", nil
+	}
+	defer C.free(unsafe.Pointer(cText))
+	return C.GoString(cText), nil
+}
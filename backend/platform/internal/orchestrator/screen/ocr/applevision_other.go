@@ -0,0 +1,26 @@
+//go:build !darwin
+
+package ocr
+
+import (
+	"context"
+	"errors"
+)
+
+// AppleVisionProvider is a non-functional stand-in on platforms other than
+// macOS; Available reports false so Chain skips it without ever calling
+// ExtractText. The real implementation lives in applevision_darwin.go.
+type AppleVisionProvider struct{}
+
+// NewAppleVisionProvider returns a provider that always reports unavailable.
+func NewAppleVisionProvider() *AppleVisionProvider {
+	return &AppleVisionProvider{}
+}
+
+func (a *AppleVisionProvider) Name() string { return "applevision" }
+
+func (a *AppleVisionProvider) Available() bool { return false }
+
+func (a *AppleVisionProvider) ExtractText(ctx context.Context, img []byte, format string) (string, error) {
+	return "", errors.New("applevision: only supported on macOS")
+}
@@ -0,0 +1,95 @@
+// Package ocr provides an in-process OCR layer with pluggable local and
+// remote providers, so screen text extraction isn't stuck behind a single
+// remote LLM call.
+package ocr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+// Provider extracts text from a single captured frame.
+type Provider interface {
+	Name() string
+	Available() bool
+	ExtractText(ctx context.Context, img []byte, format string) (string, error)
+}
+
+// Config controls how Chain combines provider results.
+type Config struct {
+	// Merge concatenates every provider's non-empty result instead of
+	// returning the first one, useful when providers catch different text
+	// (e.g. Tesseract struggles with a font Vision reads fine).
+	Merge bool
+}
+
+// guardedProvider pairs a Provider with its own breaker so one wedged
+// provider can't take the others down with it.
+type guardedProvider struct {
+	Provider
+	breaker *resilience.Breaker
+}
+
+// Chain tries providers in order, each behind its own circuit breaker, so a
+// wedged provider (e.g. a hung Tesseract process) doesn't stall every
+// capture tick -- once its breaker opens, Chain skips it until it recovers.
+type Chain struct {
+	cfg       Config
+	providers []*guardedProvider
+}
+
+// NewChain builds a Chain over providers, tried in the given order.
+func NewChain(cfg Config, providers ...Provider) *Chain {
+	guarded := make([]*guardedProvider, len(providers))
+	for i, p := range providers {
+		guarded[i] = &guardedProvider{Provider: p, breaker: resilience.New(resilience.DefaultConfig())}
+	}
+	return &Chain{cfg: cfg, providers: guarded}
+}
+
+// ExtractText implements screen.OCRClient, trying each available provider in
+// order and returning the first non-empty result, or every non-empty result
+// concatenated (newline-joined) when Config.Merge is set.
+func (c *Chain) ExtractText(ctx context.Context, img []byte, format string) (string, error) {
+	var parts []string
+	var lastErr error
+
+	for _, gp := range c.providers {
+		if !gp.Available() {
+			continue
+		}
+
+		text, err := resilience.ExecuteWithResult(gp.breaker, func() (string, error) {
+			return gp.ExtractText(ctx, img, format)
+		})
+		if err != nil {
+			if errors.Is(err, resilience.ErrOpen) {
+				slog.Debug("ocr provider circuit open, skipping", "provider", gp.Name())
+			} else {
+				slog.Debug("ocr provider failed", "provider", gp.Name(), "error", err)
+			}
+			lastErr = err
+			continue
+		}
+		if text == "" {
+			continue
+		}
+		if !c.cfg.Merge {
+			return text, nil
+		}
+		parts = append(parts, text)
+	}
+
+	if len(parts) > 0 {
+		return strings.Join(parts, "\n"), nil
+	}
+	if lastErr != nil {
+		return "", fmt.Errorf("all ocr providers failed: %w", lastErr)
+	}
+	return "", nil
+}
@@ -0,0 +1,145 @@
+package ocr
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeProvider struct {
+	name      string
+	available bool
+	text      string
+	err       error
+	calls     int
+}
+
+func (f *fakeProvider) Name() string    { return f.name }
+func (f *fakeProvider) Available() bool { return f.available }
+func (f *fakeProvider) ExtractText(ctx context.Context, img []byte, format string) (string, error) {
+	f.calls++
+	return f.text, f.err
+}
+
+func TestChainReturnsFirstNonEmptyResult(t *testing.T) {
+	first := &fakeProvider{name: "first", available: true, text: "hello"}
+	second := &fakeProvider{name: "second", available: true, text: "world"}
+	c := NewChain(Config{}, first, second)
+
+	text, err := c.ExtractText(context.Background(), []byte("img"), "jpeg")
+	if err != nil {
+		t.Fatalf("ExtractText: %v", err)
+	}
+	if text != "hello" {
+		t.Errorf("ExtractText() = %q, want %q", text, "hello")
+	}
+	if second.calls != 0 {
+		t.Error("second provider should not be called once first succeeds")
+	}
+}
+
+func TestChainSkipsUnavailableProviders(t *testing.T) {
+	unavailable := &fakeProvider{name: "unavailable", available: false, text: "should not see this"}
+	fallback := &fakeProvider{name: "fallback", available: true, text: "fallback text"}
+	c := NewChain(Config{}, unavailable, fallback)
+
+	text, err := c.ExtractText(context.Background(), []byte("img"), "jpeg")
+	if err != nil {
+		t.Fatalf("ExtractText: %v", err)
+	}
+	if text != "fallback text" {
+		t.Errorf("ExtractText() = %q, want fallback text", text)
+	}
+	if unavailable.calls != 0 {
+		t.Error("unavailable provider should never be called")
+	}
+}
+
+func TestChainSkipsEmptyResultAndTriesNext(t *testing.T) {
+	empty := &fakeProvider{name: "empty", available: true, text: ""}
+	fallback := &fakeProvider{name: "fallback", available: true, text: "found it"}
+	c := NewChain(Config{}, empty, fallback)
+
+	text, err := c.ExtractText(context.Background(), []byte("img"), "jpeg")
+	if err != nil {
+		t.Fatalf("ExtractText: %v", err)
+	}
+	if text != "found it" {
+		t.Errorf("ExtractText() = %q, want found it", text)
+	}
+}
+
+func TestChainMergesAllNonEmptyResults(t *testing.T) {
+	a := &fakeProvider{name: "a", available: true, text: "alpha"}
+	b := &fakeProvider{name: "b", available: true, text: "beta"}
+	c := NewChain(Config{Merge: true}, a, b)
+
+	text, err := c.ExtractText(context.Background(), []byte("img"), "jpeg")
+	if err != nil {
+		t.Fatalf("ExtractText: %v", err)
+	}
+	if text != "alpha\nbeta" {
+		t.Errorf("ExtractText() = %q, want merged result", text)
+	}
+}
+
+func TestChainReturnsErrorWhenAllFail(t *testing.T) {
+	boom := errors.New("boom")
+	a := &fakeProvider{name: "a", available: true, err: boom}
+	c := NewChain(Config{}, a)
+
+	_, err := c.ExtractText(context.Background(), []byte("img"), "jpeg")
+	if err == nil {
+		t.Fatal("expected error when every provider fails")
+	}
+}
+
+func TestChainBreakerSkipsWedgedProvider(t *testing.T) {
+	boom := errors.New("boom")
+	wedged := &fakeProvider{name: "wedged", available: true, err: boom}
+	fallback := &fakeProvider{name: "fallback", available: true, text: "recovered"}
+	c := NewChain(Config{}, wedged, fallback)
+
+	// Trip the wedged provider's breaker past its default threshold.
+	for i := 0; i < 15; i++ {
+		_, _ = c.ExtractText(context.Background(), []byte("img"), "jpeg")
+	}
+
+	calls := wedged.calls
+	text, err := c.ExtractText(context.Background(), []byte("img"), "jpeg")
+	if err != nil {
+		t.Fatalf("ExtractText: %v", err)
+	}
+	if text != "recovered" {
+		t.Errorf("ExtractText() = %q, want recovered once wedged provider is skipped", text)
+	}
+	if wedged.calls != calls {
+		t.Error("wedged provider should not be called once its breaker is open")
+	}
+}
+
+func TestRemoteProviderWrapsClient(t *testing.T) {
+	client := &fakeProvider{name: "unused", available: true, text: "remote text"}
+	p := NewRemoteProvider(client)
+
+	if p.Name() != "remote" {
+		t.Errorf("Name() = %q, want remote", p.Name())
+	}
+	if !p.Available() {
+		t.Error("Available() should be true for a non-nil client")
+	}
+	text, err := p.ExtractText(context.Background(), []byte("img"), "jpeg")
+	if err != nil {
+		t.Fatalf("ExtractText: %v", err)
+	}
+	if text != "remote text" {
+		t.Errorf("ExtractText() = %q, want remote text", text)
+	}
+}
+
+func TestRemoteProviderUnavailableWithNilClient(t *testing.T) {
+	p := NewRemoteProvider(nil)
+	if p.Available() {
+		t.Error("Available() should be false with a nil client")
+	}
+}
@@ -0,0 +1,28 @@
+package ocr
+
+import "context"
+
+// RemoteClient is the method set of the existing remote OCR client (e.g. an
+// LLM vision call) that RemoteProvider wraps. screen.OCRClient satisfies it.
+type RemoteClient interface {
+	ExtractText(ctx context.Context, imageData []byte, format string) (string, error)
+}
+
+// RemoteProvider wraps an existing remote OCR client as a last-resort
+// Provider, for use once the faster local engines have been tried.
+type RemoteProvider struct {
+	client RemoteClient
+}
+
+// NewRemoteProvider wraps client as a Provider.
+func NewRemoteProvider(client RemoteClient) *RemoteProvider {
+	return &RemoteProvider{client: client}
+}
+
+func (r *RemoteProvider) Name() string { return "remote" }
+
+func (r *RemoteProvider) Available() bool { return r.client != nil }
+
+func (r *RemoteProvider) ExtractText(ctx context.Context, img []byte, format string) (string, error) {
+	return r.client.ExtractText(ctx, img, format)
+}
@@ -0,0 +1,51 @@
+package ocr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/otiai10/gosseract/v2"
+)
+
+// TesseractProvider runs OCR locally via the Tesseract engine (a cgo binding
+// to libtesseract), avoiding a network round trip for screens that don't
+// need an LLM's judgment.
+type TesseractProvider struct {
+	langs []string
+}
+
+// NewTesseractProvider creates a Tesseract provider using the given language
+// packs (e.g. []string{"eng"}). It defaults to English if none are given.
+func NewTesseractProvider(langs []string) *TesseractProvider {
+	if len(langs) == 0 {
+		langs = []string{"eng"}
+	}
+	return &TesseractProvider{langs: langs}
+}
+
+func (t *TesseractProvider) Name() string { return "tesseract" }
+
+// Available reports whether the tesseract engine and its language data are
+// reachable, so Chain can skip this provider on a machine without it
+// installed rather than failing (and tripping its breaker) on every call.
+func (t *TesseractProvider) Available() bool {
+	_, err := gosseract.GetAvailableLanguages()
+	return err == nil
+}
+
+func (t *TesseractProvider) ExtractText(ctx context.Context, img []byte, format string) (string, error) {
+	client := gosseract.NewClient()
+	defer client.Close()
+
+	if err := client.SetLanguage(t.langs...); err != nil {
+		return "", fmt.Errorf("tesseract: setting languages %v: %w", t.langs, err)
+	}
+	if err := client.SetImageFromBytes(img); err != nil {
+		return "", fmt.Errorf("tesseract: loading image: %w", err)
+	}
+	text, err := client.Text()
+	if err != nil {
+		return "", fmt.Errorf("tesseract: extracting text: %w", err)
+	}
+	return text, nil
+}
@@ -11,7 +11,8 @@ import (
 	"sync"
 	"time"
 
-	screencap "github.com/GriffinCanCode/good-listener/backend/platform/internal/screen"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/sinks"
 	"github.com/corona10/goimagehash"
 )
 
@@ -25,34 +26,42 @@ type MemoryClient interface {
 	StoreMemory(ctx context.Context, text, source string) error
 }
 
+// FrameHandler receives every captured screen frame alongside the time it
+// was captured, e.g. to feed a replay buffer. It must not block.
+type FrameHandler func(jpeg []byte, ts time.Time)
+
 // Processor handles screen capture and OCR.
 type Processor struct {
-	capturer  screencap.Capturer
 	ocr       OCRClient
 	memory    MemoryClient
+	sink      sinks.Sink
 	mu        sync.RWMutex
 	text      string
 	image     []byte
 	recording bool
 	lastHash  *goimagehash.ImageHash
+	onFrame   FrameHandler
 }
 
-// NewProcessor creates a screen processor.
-func NewProcessor(capturer screencap.Capturer, ocr OCRClient, memory MemoryClient) *Processor {
+// NewProcessor creates a screen processor. sink may be nil, in which case
+// captured frames are discarded (equivalent to sinks.NewNullSink()).
+func NewProcessor(ocr OCRClient, memory MemoryClient, sink sinks.Sink) *Processor {
+	if sink == nil {
+		sink = sinks.NewNullSink()
+	}
 	return &Processor{
-		capturer:  capturer,
 		ocr:       ocr,
 		memory:    memory,
+		sink:      sink,
 		recording: true,
 	}
 }
 
-// Run starts the screen capture loop.
-func (p *Processor) Run(ctx context.Context, captureRate float64, stopCh <-chan struct{}) {
-	interval := time.Duration(float64(time.Second) / captureRate)
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
+// Run consumes frames from one or more capture.ScreenSources, fanned into a
+// single channel by the caller (see orchestrator.Manager), running each
+// through change detection, OCR, and memory storage. It returns once frames
+// is closed, ctx is canceled, or stopCh fires.
+func (p *Processor) Run(ctx context.Context, frames <-chan capture.Frame, stopCh <-chan struct{}) {
 	var lastStoredText string
 	stableCount := 0
 
@@ -62,16 +71,28 @@ func (p *Processor) Run(ctx context.Context, captureRate float64, stopCh <-chan
 			return
 		case <-stopCh:
 			return
-		case <-ticker.C:
-			imgData, changed := p.capturer.Capture()
-			if !changed || imgData == nil {
+		case frame, ok := <-frames:
+			if !ok {
+				return
+			}
+			imgData := frame.Image
+			if imgData == nil {
 				continue
 			}
 
+			now := time.Now()
 			p.mu.Lock()
 			p.image = imgData
+			onFrame := p.onFrame
 			p.mu.Unlock()
 
+			if err := p.sink.Write(ctx, sinks.Artifact{Kind: "screen_frame", Data: imgData, Timestamp: now}); err != nil {
+				slog.Debug("screen frame sink write failed", "error", err)
+			}
+			if onFrame != nil {
+				onFrame(imgData, now)
+			}
+
 			// Skip OCR if perceptual hash similarity > 95%
 			if p.shouldSkipOCR(imgData) {
 				continue
@@ -138,6 +159,14 @@ func (p *Processor) shouldSkipOCR(imgData []byte) bool {
 	return false
 }
 
+// SetFrameHandler registers fn to receive every captured frame. Replacing a
+// handler already set discards the previous one.
+func (p *Processor) SetFrameHandler(fn FrameHandler) {
+	p.mu.Lock()
+	p.onFrame = fn
+	p.mu.Unlock()
+}
+
 // Text returns latest OCR text.
 func (p *Processor) Text() string {
 	p.mu.RLock()
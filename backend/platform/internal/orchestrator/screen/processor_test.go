@@ -7,16 +7,10 @@ import (
 	"image/color"
 	"image/jpeg"
 	"testing"
-)
-
-type mockCapturer struct {
-	img     []byte
-	changed bool
-}
+	"time"
 
-func (m *mockCapturer) Capture() ([]byte, bool) { return m.img, m.changed }
-func (m *mockCapturer) CaptureAlways() []byte   { return m.img }
-func (m *mockCapturer) Close()                  {}
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/capture"
+)
 
 type mockOCR struct {
 	text string
@@ -59,10 +53,9 @@ func TestProcessorSetRecording(t *testing.T) {
 }
 
 func TestNewProcessor(t *testing.T) {
-	cap := &mockCapturer{}
 	ocr := &mockOCR{}
 
-	p := NewProcessor(cap, ocr)
+	p := NewProcessor(ocr, nil, nil)
 
 	if p == nil {
 		t.Fatal("expected processor, got nil")
@@ -70,6 +63,38 @@ func TestNewProcessor(t *testing.T) {
 	if !p.recording {
 		t.Error("recording should be true by default")
 	}
+	if p.sink == nil {
+		t.Error("sink should default to a non-nil NullSink")
+	}
+}
+
+func TestProcessorRunConsumesFrames(t *testing.T) {
+	ocr := &mockOCR{text: "hello"}
+	p := NewProcessor(ocr, nil, nil)
+
+	frames := make(chan capture.Frame, 1)
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		p.Run(context.Background(), frames, stopCh)
+		close(done)
+	}()
+
+	img := makePatternJPEG(0)
+	frames <- capture.Frame{Image: img, Source: "native", Timestamp: time.Now().UnixNano()}
+
+	deadline := time.After(time.Second)
+	for p.Image() == nil {
+		select {
+		case <-deadline:
+			t.Fatal("timed out waiting for Run to process frame")
+		default:
+		}
+	}
+
+	close(stopCh)
+	<-done
 }
 
 // makePatternJPEG creates test images with distinct patterns for pHash testing.
@@ -0,0 +1,127 @@
+package transcript
+
+import (
+	"sync"
+	"time"
+)
+
+// mountBufferSize bounds how many pending records a slow Mount subscriber
+// can queue before new ones are dropped, the same non-blocking tradeoff
+// MemoryStore.eventsCh already makes.
+const mountBufferSize = 64
+
+// Format identifies how a Mount serializes entries and summaries for its
+// subscriber.
+type Format string
+
+const (
+	FormatPlain  Format = "plain"  // "SOURCE: text" lines, for simple log tailing
+	FormatJSONL  Format = "jsonl"  // one Entry/Summary-shaped JSON object per line
+	FormatVTT    Format = "vtt"    // WebVTT cues, for captioning overlays
+	FormatSRT    Format = "srt"    // SubRip cues
+	FormatNDJSON Format = "ndjson" // newline-delimited {type, ...} records with speaker+source
+)
+
+// ReplayWindow bounds which already-stored entries and summaries a new Mount
+// receives before switching to live events. The zero value requests no
+// replay. SinceID takes priority over Since when both are set; summaries
+// carry no ID of their own, so only a time-based window replays them.
+type ReplayWindow struct {
+	Since   time.Time
+	SinceID int64
+}
+
+// Mount is one subscriber to a Broker: a live feed of pre-serialized records
+// in its requested Format.
+type Mount struct {
+	format Format
+	ch     chan []byte
+}
+
+// C returns the channel of newly published, already-formatted records.
+func (m *Mount) C() <-chan []byte { return m.ch }
+
+// Broker fans transcript entries and summaries out to Mounts, serializing
+// each one once per distinct format actually subscribed rather than once
+// per subscriber. It sits behind MemoryStore.Emit/StoreSummary the way hub
+// sits behind server.Server's WebSocket broadcasts.
+type Broker struct {
+	store *MemoryStore
+
+	mu   sync.Mutex
+	subs map[*Mount]struct{}
+}
+
+func newBroker(store *MemoryStore) *Broker {
+	return &Broker{store: store, subs: make(map[*Mount]struct{})}
+}
+
+// Subscribe registers a new Mount for format and returns it along with the
+// formatted replay backlog selected by window.
+func (b *Broker) Subscribe(format Format, window ReplayWindow) (*Mount, [][]byte) {
+	m := &Mount{format: format, ch: make(chan []byte, mountBufferSize)}
+
+	b.mu.Lock()
+	b.subs[m] = struct{}{}
+	b.mu.Unlock()
+
+	var replay [][]byte
+	for _, e := range b.store.entriesSince(window) {
+		replay = append(replay, FormatEntry(format, e))
+	}
+	for _, s := range b.store.summariesSince(window) {
+		replay = append(replay, FormatSummary(format, s))
+	}
+	return m, replay
+}
+
+// Unsubscribe removes m from the broker. Safe to call more than once.
+func (b *Broker) Unsubscribe(m *Mount) {
+	b.mu.Lock()
+	delete(b.subs, m)
+	b.mu.Unlock()
+}
+
+// publishEntry serializes e once per distinct format among current
+// subscribers and fans it out.
+func (b *Broker) publishEntry(e Entry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.subs) == 0 {
+		return
+	}
+	cache := make(map[Format][]byte, len(b.subs))
+	for m := range b.subs {
+		data, ok := cache[m.format]
+		if !ok {
+			data = FormatEntry(m.format, e)
+			cache[m.format] = data
+		}
+		select {
+		case m.ch <- data:
+		default:
+		}
+	}
+}
+
+// publishSummary serializes s once per distinct format among current
+// subscribers and fans it out.
+func (b *Broker) publishSummary(s Summary) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.subs) == 0 {
+		return
+	}
+	cache := make(map[Format][]byte, len(b.subs))
+	for m := range b.subs {
+		data, ok := cache[m.format]
+		if !ok {
+			data = FormatSummary(m.format, s)
+			cache[m.format] = data
+		}
+		select {
+		case m.ch <- data:
+		default:
+		}
+	}
+}
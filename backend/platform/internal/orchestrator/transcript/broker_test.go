@@ -0,0 +1,91 @@
+package transcript
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBrokerDeliversEntryInSubscribedFormat(t *testing.T) {
+	s := NewStore(30, 10)
+	mount, replay := s.Broker().Subscribe(FormatJSONL, ReplayWindow{})
+	defer s.Broker().Unsubscribe(mount)
+
+	if len(replay) != 0 {
+		t.Fatalf("expected no replay for a zero window, got %d records", len(replay))
+	}
+
+	id := s.Add("hello", "user", "You")
+	s.Emit(Event{ID: id, Text: "hello", Source: "user", Speaker: "You"})
+
+	select {
+	case data := <-mount.C():
+		if !strings.Contains(string(data), `"Text":"hello"`) {
+			t.Errorf("jsonl record missing text: %s", data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for mount delivery")
+	}
+}
+
+func TestBrokerReplaySinceID(t *testing.T) {
+	s := NewStore(30, 10)
+	id1 := s.Add("first", "user", "You")
+	s.Emit(Event{ID: id1, Text: "first", Source: "user", Speaker: "You"})
+	id2 := s.Add("second", "user", "You")
+	s.Emit(Event{ID: id2, Text: "second", Source: "user", Speaker: "You"})
+
+	mount, replay := s.Broker().Subscribe(FormatPlain, ReplayWindow{SinceID: id1})
+	defer s.Broker().Unsubscribe(mount)
+
+	if len(replay) != 1 || !strings.Contains(string(replay[0]), "second") {
+		t.Fatalf("expected replay of only the entry after id %d, got %v", id1, replay)
+	}
+}
+
+func TestBrokerSerializesOncePerDistinctFormat(t *testing.T) {
+	s := NewStore(30, 10)
+	jsonlA, _ := s.Broker().Subscribe(FormatJSONL, ReplayWindow{})
+	jsonlB, _ := s.Broker().Subscribe(FormatJSONL, ReplayWindow{})
+	vtt, _ := s.Broker().Subscribe(FormatVTT, ReplayWindow{})
+	defer s.Broker().Unsubscribe(jsonlA)
+	defer s.Broker().Unsubscribe(jsonlB)
+	defer s.Broker().Unsubscribe(vtt)
+
+	id := s.Add("hi", "user", "You")
+	s.Emit(Event{ID: id, Text: "hi", Source: "user", Speaker: "You"})
+
+	a := <-jsonlA.C()
+	b := <-jsonlB.C()
+	if string(a) != string(b) {
+		t.Errorf("subscribers sharing a format got different bytes: %q vs %q", a, b)
+	}
+	if v := <-vtt.C(); strings.HasPrefix(string(v), "{") {
+		t.Errorf("vtt subscriber got jsonl-shaped bytes: %q", v)
+	}
+}
+
+func TestBrokerSummaryRendersAsVTTNoteAndNDJSONType(t *testing.T) {
+	start := time.Now().Add(-time.Minute)
+	end := time.Now()
+	summary := Summary{StartTime: start, EndTime: end, Text: "recap"}
+
+	vtt := string(FormatSummary(FormatVTT, summary))
+	if !strings.HasPrefix(vtt, "NOTE ") {
+		t.Errorf("vtt summary should be a NOTE block, got %q", vtt)
+	}
+
+	nd := string(FormatSummary(FormatNDJSON, summary))
+	if !strings.Contains(nd, `"type":"summary"`) {
+		t.Errorf("ndjson summary missing type field: %q", nd)
+	}
+}
+
+func TestFormatEntryEachFormatIsNonEmpty(t *testing.T) {
+	e := Entry{ID: 1, Timestamp: time.Now(), Text: "hello", Source: "user", Speaker: "You"}
+	for _, f := range []Format{FormatPlain, FormatJSONL, FormatVTT, FormatSRT, FormatNDJSON} {
+		if data := FormatEntry(f, e); len(data) == 0 {
+			t.Errorf("FormatEntry(%s, ...) returned empty output", f)
+		}
+	}
+}
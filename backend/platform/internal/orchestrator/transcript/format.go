@@ -0,0 +1,69 @@
+package transcript
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// cueDuration is how long a caption cue stays on screen in the VTT/SRT
+// formats, since Entry carries only the moment transcription completed and
+// not a spoken duration.
+const cueDuration = 4 * time.Second
+
+// FormatEntry renders e for format, terminated so consecutive writes to a
+// chunked response concatenate into a valid stream.
+func FormatEntry(format Format, e Entry) []byte {
+	switch format {
+	case FormatJSONL:
+		data, _ := json.Marshal(e)
+		return append(data, '\n')
+	case FormatVTT:
+		return []byte(fmt.Sprintf("%s --> %s\n%s: %s\n\n",
+			vttTimestamp(e.Timestamp), vttTimestamp(e.Timestamp.Add(cueDuration)),
+			e.Speaker, e.Text))
+	case FormatSRT:
+		return []byte(fmt.Sprintf("%d\n%s --> %s\n%s: %s\n\n",
+			e.ID, srtTimestamp(e.Timestamp), srtTimestamp(e.Timestamp.Add(cueDuration)),
+			e.Speaker, e.Text))
+	case FormatNDJSON:
+		data, _ := json.Marshal(struct {
+			Type string `json:"type"`
+			Entry
+		}{Type: "entry", Entry: e})
+		return append(data, '\n')
+	default: // FormatPlain
+		return []byte(fmt.Sprintf("%s: %s\n", strings.ToUpper(e.Source), e.Text))
+	}
+}
+
+// FormatSummary renders s for format. VTT has a native comment cue (NOTE);
+// SRT does not, so summaries are emitted as an ordinary numberless cue.
+func FormatSummary(format Format, s Summary) []byte {
+	switch format {
+	case FormatJSONL:
+		data, _ := json.Marshal(s)
+		return append(data, '\n')
+	case FormatVTT:
+		return []byte(fmt.Sprintf("NOTE %s --> %s\n%s\n\n", vttTimestamp(s.StartTime), vttTimestamp(s.EndTime), s.Text))
+	case FormatSRT:
+		return []byte(fmt.Sprintf("0\n%s --> %s\n[Summary] %s\n\n", srtTimestamp(s.StartTime), srtTimestamp(s.EndTime), s.Text))
+	case FormatNDJSON:
+		data, _ := json.Marshal(struct {
+			Type string `json:"type"`
+			Summary
+		}{Type: "summary", Summary: s})
+		return append(data, '\n')
+	default: // FormatPlain
+		return []byte(fmt.Sprintf("[Summary] %s\n", s.Text))
+	}
+}
+
+func vttTimestamp(t time.Time) string {
+	return t.UTC().Format("15:04:05.000")
+}
+
+func srtTimestamp(t time.Time) string {
+	return strings.Replace(vttTimestamp(t), ".", ",", 1)
+}
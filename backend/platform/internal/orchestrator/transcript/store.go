@@ -7,19 +7,28 @@ import (
 	"time"
 )
 
-// Event represents a transcription event.
+// Event represents a transcription event. Interim marks a live streaming
+// hypothesis that hasn't finalized yet: a later event for the same ID
+// supersedes it, and it's never passed to Add, so it never appears in
+// Entries/GetRecent.
 type Event struct {
+	ID      int64
 	Text    string
 	Source  string
 	Speaker string
+	Interim bool
 }
 
-// Entry represents a stored transcript.
+// Entry represents a stored transcript. Interim is always false for a
+// stored Entry (Add never stores interim text); it's carried here only so
+// Emit can publish it through Broker without dropping the field.
 type Entry struct {
+	ID        int64
 	Timestamp time.Time
 	Text      string
 	Source    string
 	Speaker   string
+	Interim   bool
 }
 
 // Summary represents a compressed transcript segment.
@@ -31,7 +40,7 @@ type Summary struct {
 
 // Store interface for transcript operations.
 type Store interface {
-	Add(text, source, speaker string)
+	Add(text, source, speaker string) int64
 	GetRecent(seconds int) string
 	GetUnsummarized(olderThan time.Duration) ([]Entry, time.Time, time.Time)
 	StoreSummary(start, end time.Time, text string)
@@ -45,34 +54,49 @@ type MemoryStore struct {
 	entries    []Entry
 	summaries  []Summary
 	maxSize    int
+	nextID     int64
 	eventsCh   chan Event
 	summarized time.Time // Entries before this time have been summarized
+	wal        *wal      // non-nil when created via NewStoreWithWAL
+	broker     *Broker
 }
 
 // NewStore creates a new transcript store.
 func NewStore(maxEntries, eventBuffer int) *MemoryStore {
-	return &MemoryStore{
+	s := &MemoryStore{
 		entries:  make([]Entry, 0, maxEntries),
 		maxSize:  maxEntries,
 		eventsCh: make(chan Event, eventBuffer),
 	}
+	s.broker = newBroker(s)
+	return s
 }
 
-// Add stores a new transcript entry.
-func (s *MemoryStore) Add(text, source, speaker string) {
+// Add stores a new transcript entry and returns the ID assigned to it, so
+// callers can pass the same ID to Emit and integrators can resume a mount
+// with since=<id>.
+func (s *MemoryStore) Add(text, source, speaker string) int64 {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	s.entries = append(s.entries, Entry{
+	s.nextID++
+	entry := Entry{
+		ID:        s.nextID,
 		Timestamp: time.Now(),
 		Text:      text,
 		Source:    source,
 		Speaker:   speaker,
-	})
+	}
+	s.entries = append(s.entries, entry)
 
 	if len(s.entries) > s.maxSize {
 		s.entries = s.entries[len(s.entries)-s.maxSize:]
 	}
+	s.mu.Unlock()
+
+	if s.wal != nil {
+		s.wal.append(walRecord{Op: opAdd, Entry: &entry})
+		s.maybeCompact()
+	}
+	return entry.ID
 }
 
 // GetRecent returns transcript from last N seconds (summaries + raw recent text).
@@ -91,10 +115,18 @@ func (s *MemoryStore) GetRecent(seconds int) string {
 		}
 	}
 
-	// Add raw entries not yet summarized
+	// Add raw entries not yet summarized, labeled with the speaker a
+	// diarization pass assigned (or the source, if diarization never ran or
+	// assigned nothing), so a multi-party conversation reads back with true
+	// per-speaker attribution instead of collapsing everyone on a source
+	// into one label.
 	for _, e := range s.entries {
 		if !e.Timestamp.Before(cutoff) && e.Timestamp.After(s.summarized) {
-			parts = append(parts, strings.ToUpper(e.Source)+": "+e.Text)
+			label := e.Speaker
+			if label == "" {
+				label = e.Source
+			}
+			parts = append(parts, strings.ToUpper(label)+": "+e.Text)
 		}
 	}
 	return strings.Join(parts, "\n")
@@ -126,12 +158,12 @@ func (s *MemoryStore) GetUnsummarized(olderThan time.Duration) ([]Entry, time.Ti
 // StoreSummary stores a summary and marks entries as summarized.
 func (s *MemoryStore) StoreSummary(start, end time.Time, text string) {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	s.summaries = append(s.summaries, Summary{StartTime: start, EndTime: end, Text: text})
+	summary := Summary{StartTime: start, EndTime: end, Text: text}
+	s.summaries = append(s.summaries, summary)
 	if end.After(s.summarized) {
 		s.summarized = end
 	}
+	prunedBefore := s.summarized
 
 	// Prune summarized entries to free memory
 	kept := s.entries[:0]
@@ -146,6 +178,14 @@ func (s *MemoryStore) StoreSummary(start, end time.Time, text string) {
 	if len(s.summaries) > 5 {
 		s.summaries = s.summaries[len(s.summaries)-5:]
 	}
+	s.mu.Unlock()
+
+	if s.wal != nil {
+		s.wal.append(walRecord{Op: opSummary, Summary: &summary})
+		s.wal.append(walRecord{Op: opPrune, Before: &prunedBefore})
+		s.maybeCompact()
+	}
+	s.broker.publishSummary(summary)
 }
 
 // Events returns the channel for transcript events.
@@ -153,12 +193,22 @@ func (s *MemoryStore) Events() <-chan Event {
 	return s.eventsCh
 }
 
-// Emit sends a transcript event (non-blocking).
+// Emit sends a transcript event (non-blocking) and, through the store's
+// Broker, fans the same event out to any subscribed transcript.vtt/srt/jsonl
+// mounts, serialized into their requested Format.
 func (s *MemoryStore) Emit(event Event) {
 	select {
 	case s.eventsCh <- event:
 	default:
 	}
+	s.broker.publishEntry(Entry{
+		ID:        event.ID,
+		Timestamp: time.Now(),
+		Text:      event.Text,
+		Source:    event.Source,
+		Speaker:   event.Speaker,
+		Interim:   event.Interim,
+	})
 }
 
 // Entries returns a copy of all entries (for testing).
@@ -178,3 +228,51 @@ func (s *MemoryStore) Summaries() []Summary {
 	copy(result, s.summaries)
 	return result
 }
+
+// Broker returns the store's Broker, which server HTTP handlers Subscribe to
+// for the transcript.vtt/srt/jsonl endpoints.
+func (s *MemoryStore) Broker() *Broker { return s.broker }
+
+// entriesSince returns the entries a new Mount should replay for window. A
+// zero window requests no replay. SinceID takes priority over Since when
+// both are set.
+func (s *MemoryStore) entriesSince(window ReplayWindow) []Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if window.SinceID <= 0 && window.Since.IsZero() {
+		return nil
+	}
+
+	var result []Entry
+	for _, e := range s.entries {
+		if window.SinceID > 0 {
+			if e.ID > window.SinceID {
+				result = append(result, e)
+			}
+			continue
+		}
+		if e.Timestamp.After(window.Since) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// summariesSince returns the summaries a new Mount should replay for window.
+// Summaries have no ID of their own, so only a time-based window replays
+// them; a SinceID-only window yields none.
+func (s *MemoryStore) summariesSince(window ReplayWindow) []Summary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if window.Since.IsZero() {
+		return nil
+	}
+
+	var result []Summary
+	for _, sum := range s.summaries {
+		if sum.EndTime.After(window.Since) {
+			result = append(result, sum)
+		}
+	}
+	return result
+}
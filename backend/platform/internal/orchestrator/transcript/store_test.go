@@ -47,8 +47,8 @@ func TestGetRecent(t *testing.T) {
 	if strings.Contains(recent, "Old") {
 		t.Error("should not contain old message")
 	}
-	if !strings.Contains(recent, "USER: Recent") {
-		t.Error("should contain recent message")
+	if !strings.Contains(recent, "YOU: Recent") {
+		t.Error("should contain recent message labeled with its speaker")
 	}
 }
 
@@ -166,8 +166,8 @@ func TestGetRecentWithSummaries(t *testing.T) {
 	if !strings.Contains(recent, "Previous discussion") {
 		t.Error("should contain summary text")
 	}
-	if !strings.Contains(recent, "USER: Current message") {
-		t.Error("should contain recent raw entry")
+	if !strings.Contains(recent, "YOU: Current message") {
+		t.Error("should contain recent raw entry labeled with its speaker")
 	}
 }
 
@@ -0,0 +1,374 @@
+package transcript
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// walFlushInterval bounds how long a write can sit in the buffer before it is
+// flushed and fsynced, trading a small crash-recovery window for far fewer
+// syscalls than fsyncing every record.
+const walFlushInterval = 200 * time.Millisecond
+
+// walCompactThreshold is the on-disk log size at which the background
+// compactor rewrites the log as a single snapshot record.
+const walCompactThreshold = 4 << 20 // 4 MiB
+
+// walOp identifies the kind of record a wal line encodes.
+type walOp string
+
+const (
+	opAdd      walOp = "add"
+	opSummary  walOp = "summary"
+	opPrune    walOp = "prune"
+	opSnapshot walOp = "snapshot"
+)
+
+// walRecord is the JSON-Lines record format written to and read from the log.
+// Only the fields relevant to Op are populated.
+type walRecord struct {
+	Op walOp `json:"op"`
+
+	Entry   *Entry     `json:"entry,omitempty"`
+	Summary *Summary   `json:"summary,omitempty"`
+	Before  *time.Time `json:"before,omitempty"`
+
+	// Snapshot fields, written by compact() in place of a long op history.
+	Entries    []Entry    `json:"entries,omitempty"`
+	Summaries  []Summary  `json:"summaries,omitempty"`
+	Summarized *time.Time `json:"summarized,omitempty"`
+}
+
+// wal is an append-only, fsync-batched log of store mutations plus a
+// size-triggered compactor that collapses it back down to a snapshot.
+type wal struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	writer *bufio.Writer
+	size   int64
+
+	compacting atomic.Bool
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+}
+
+// openWAL opens (creating if needed) the log file at path and starts the
+// background flush loop.
+func openWAL(path string) (*wal, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening wal file %s: %w", path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("stat wal file %s: %w", path, err)
+	}
+
+	w := &wal{
+		path:   path,
+		file:   f,
+		writer: bufio.NewWriter(f),
+		size:   info.Size(),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+	go w.flushLoop()
+	return w, nil
+}
+
+func (w *wal) flushLoop() {
+	defer close(w.doneCh)
+	ticker := time.NewTicker(walFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.flush()
+		case <-w.stopCh:
+			w.flush()
+			return
+		}
+	}
+}
+
+func (w *wal) flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.writer.Flush(); err != nil {
+		slog.Error("wal flush failed", "path", w.path, "error", err)
+		return
+	}
+	if err := w.file.Sync(); err != nil {
+		slog.Error("wal fsync failed", "path", w.path, "error", err)
+	}
+}
+
+// append writes one record to the buffered writer. Durability is batched by
+// flushLoop, not guaranteed by the time append returns.
+func (w *wal) append(rec walRecord) {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		slog.Error("wal marshal failed", "op", rec.Op, "error", err)
+		return
+	}
+	data = append(data, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	n, err := w.writer.Write(data)
+	if err != nil {
+		slog.Error("wal append failed", "op", rec.Op, "error", err)
+		return
+	}
+	w.size += int64(n)
+}
+
+func (w *wal) needsCompaction() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.size >= walCompactThreshold
+}
+
+// compact rewrites the log as a single snapshot record via a write-to-temp,
+// fsync, then atomic rename into place. A crash before the rename leaves the
+// original log untouched; a crash after leaves the complete new one -- the
+// log is never observed half-written, the same snapshot-then-swap trick
+// embedded KV stores use to make compaction crash-safe.
+func (w *wal) compact(snapshot walRecord) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshaling wal snapshot: %w", err)
+	}
+	data = append(data, '\n')
+
+	tmpPath := w.path + ".compact"
+	tmpFile, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("creating wal compaction file: %w", err)
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("writing wal snapshot: %w", err)
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("syncing wal compaction file: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("closing wal compaction file: %w", err)
+	}
+	if err := os.Rename(tmpPath, w.path); err != nil {
+		return fmt.Errorf("renaming wal compaction file into place: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writer.Flush() // drain anything queued against the now-unlinked inode
+	w.file.Close()
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("reopening wal after compaction: %w", err)
+	}
+	w.file = f
+	w.writer = bufio.NewWriter(f)
+	w.size = int64(len(data))
+	return nil
+}
+
+func (w *wal) close() {
+	close(w.stopCh)
+	<-w.doneCh
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.file.Close()
+}
+
+// NewStoreWithWAL creates a transcript store backed by a durable log at path.
+// On open, the log is replayed to rebuild in-memory state, discarding any
+// record older than retention (pass 0 to keep everything the log has).
+// Every subsequent Add and StoreSummary call appends a record, and a
+// background compactor collapses the log to a snapshot once it grows past
+// walCompactThreshold.
+func NewStoreWithWAL(maxEntries, eventBuffer int, path string, retention time.Duration) (*MemoryStore, error) {
+	w, err := openWAL(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &MemoryStore{
+		entries:  make([]Entry, 0, maxEntries),
+		maxSize:  maxEntries,
+		eventsCh: make(chan Event, eventBuffer),
+		wal:      w,
+	}
+	s.broker = newBroker(s)
+
+	var cutoff time.Time
+	if retention > 0 {
+		cutoff = time.Now().Add(-retention)
+	}
+	if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+		w.close()
+		return nil, fmt.Errorf("seeking wal %s: %w", path, err)
+	}
+	if err := s.replayRecords(w.file, cutoff); err != nil {
+		w.close()
+		return nil, fmt.Errorf("replaying wal %s: %w", path, err)
+	}
+	if _, err := w.file.Seek(0, io.SeekEnd); err != nil {
+		w.close()
+		return nil, fmt.Errorf("seeking wal %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Replay rebuilds state from a log encoded by append/compact, with no
+// retention cutoff. It's exported for tests that want to feed a store a
+// hand-built or previously captured log without going through a file.
+func (s *MemoryStore) Replay(r io.Reader) error {
+	return s.replayRecords(r, time.Time{})
+}
+
+func (s *MemoryStore) replayRecords(r io.Reader, cutoff time.Time) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var maxID int64
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var rec walRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return fmt.Errorf("decoding wal record: %w", err)
+		}
+
+		switch rec.Op {
+		case opAdd:
+			if rec.Entry == nil || (!cutoff.IsZero() && rec.Entry.Timestamp.Before(cutoff)) {
+				continue
+			}
+			if rec.Entry.ID > maxID {
+				maxID = rec.Entry.ID
+			}
+			s.entries = append(s.entries, *rec.Entry)
+			if len(s.entries) > s.maxSize {
+				s.entries = s.entries[len(s.entries)-s.maxSize:]
+			}
+		case opSummary:
+			if rec.Summary == nil {
+				continue
+			}
+			s.summaries = append(s.summaries, *rec.Summary)
+			if rec.Summary.EndTime.After(s.summarized) {
+				s.summarized = rec.Summary.EndTime
+			}
+			if len(s.summaries) > 5 {
+				s.summaries = s.summaries[len(s.summaries)-5:]
+			}
+		case opPrune:
+			if rec.Before == nil {
+				continue
+			}
+			kept := s.entries[:0]
+			for _, e := range s.entries {
+				if e.Timestamp.After(*rec.Before) {
+					kept = append(kept, e)
+				}
+			}
+			s.entries = kept
+		case opSnapshot:
+			s.entries = append([]Entry(nil), rec.Entries...)
+			s.summaries = append([]Summary(nil), rec.Summaries...)
+			if rec.Summarized != nil {
+				s.summarized = *rec.Summarized
+			}
+			for _, e := range rec.Entries {
+				if e.ID > maxID {
+					maxID = e.ID
+				}
+			}
+		default:
+			return fmt.Errorf("unknown wal record op %q", rec.Op)
+		}
+	}
+	if maxID > s.nextID {
+		s.nextID = maxID
+	}
+	return scanner.Err()
+}
+
+// Export writes every entry and summary at or after since as JSON-Lines
+// records, for offline analysis of a running or archived transcript.
+func (s *MemoryStore) Export(w io.Writer, since time.Time) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	enc := json.NewEncoder(w)
+	for _, e := range s.entries {
+		if e.Timestamp.Before(since) {
+			continue
+		}
+		if err := enc.Encode(walRecord{Op: opAdd, Entry: &e}); err != nil {
+			return fmt.Errorf("exporting entry: %w", err)
+		}
+	}
+	for _, sum := range s.summaries {
+		if sum.EndTime.Before(since) {
+			continue
+		}
+		if err := enc.Encode(walRecord{Op: opSummary, Summary: &sum}); err != nil {
+			return fmt.Errorf("exporting summary: %w", err)
+		}
+	}
+	return nil
+}
+
+// maybeCompact kicks off an async compaction if the log has grown past
+// walCompactThreshold. A CAS on wal.compacting keeps concurrent Add/
+// StoreSummary calls from starting more than one at a time.
+func (s *MemoryStore) maybeCompact() {
+	if s.wal == nil || !s.wal.needsCompaction() {
+		return
+	}
+	if !s.wal.compacting.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer s.wal.compacting.Store(false)
+
+		s.mu.RLock()
+		snap := walRecord{
+			Op:         opSnapshot,
+			Entries:    append([]Entry(nil), s.entries...),
+			Summaries:  append([]Summary(nil), s.summaries...),
+			Summarized: &s.summarized,
+		}
+		s.mu.RUnlock()
+
+		if err := s.wal.compact(snap); err != nil {
+			slog.Error("wal compaction failed", "path", s.wal.path, "error", err)
+		}
+	}()
+}
+
+// Close flushes and closes the store's log, if it has one. Stores created
+// with NewStore have no log and Close is a no-op.
+func (s *MemoryStore) Close() {
+	if s.wal != nil {
+		s.wal.close()
+	}
+}
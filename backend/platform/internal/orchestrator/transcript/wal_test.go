@@ -0,0 +1,199 @@
+package transcript
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStoreWithWALPersistsAcrossRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transcript.wal")
+
+	s, err := NewStoreWithWAL(30, 10, path, 0)
+	if err != nil {
+		t.Fatalf("NewStoreWithWAL: %v", err)
+	}
+	s.Add("hello", "user", "You")
+	s.Add("world", "assistant", "Bot")
+	s.Close()
+
+	reopened, err := NewStoreWithWAL(30, 10, path, 0)
+	if err != nil {
+		t.Fatalf("reopen NewStoreWithWAL: %v", err)
+	}
+	defer reopened.Close()
+
+	entries := reopened.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 replayed entries, got %d", len(entries))
+	}
+	if entries[0].Text != "hello" || entries[1].Text != "world" {
+		t.Errorf("unexpected replayed entries: %+v", entries)
+	}
+}
+
+func TestStoreWithWALReplaysSummaryAndPrune(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transcript.wal")
+
+	s, err := NewStoreWithWAL(30, 10, path, 0)
+	if err != nil {
+		t.Fatalf("NewStoreWithWAL: %v", err)
+	}
+	now := time.Now()
+	s.mu.Lock()
+	s.entries = []Entry{
+		{Timestamp: now.Add(-3 * time.Minute), Text: "old", Source: "user"},
+		{Timestamp: now.Add(-30 * time.Second), Text: "recent", Source: "user"},
+	}
+	s.mu.Unlock()
+	s.StoreSummary(now.Add(-3*time.Minute), now.Add(-90*time.Second), "summary of old")
+	s.Close()
+
+	reopened, err := NewStoreWithWAL(30, 10, path, 0)
+	if err != nil {
+		t.Fatalf("reopen NewStoreWithWAL: %v", err)
+	}
+	defer reopened.Close()
+
+	summaries := reopened.Summaries()
+	if len(summaries) != 1 || summaries[0].Text != "summary of old" {
+		t.Fatalf("expected replayed summary, got %+v", summaries)
+	}
+	// The pre-seeded entries predate the constructed store's own Add calls and
+	// were never themselves logged, so only the prune's effect on the log
+	// (nothing survives it here) is what replay can observe.
+	if len(reopened.Entries()) != 0 {
+		t.Errorf("expected no entries after replaying summary+prune, got %+v", reopened.Entries())
+	}
+}
+
+func TestStoreWithWALRetentionCutoff(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transcript.wal")
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := Entry{Timestamp: time.Now().Add(-time.Hour), Text: "ancient", Source: "user"}
+	fresh := Entry{Timestamp: time.Now(), Text: "fresh", Source: "user"}
+	for _, e := range []Entry{old, fresh} {
+		line, _ := json.Marshal(walRecord{Op: opAdd, Entry: &e})
+		f.Write(append(line, '\n'))
+	}
+	f.Close()
+
+	s, err := NewStoreWithWAL(30, 10, path, time.Minute)
+	if err != nil {
+		t.Fatalf("NewStoreWithWAL: %v", err)
+	}
+	defer s.Close()
+
+	entries := s.Entries()
+	if len(entries) != 1 || entries[0].Text != "fresh" {
+		t.Fatalf("expected only entries within retention window, got %+v", entries)
+	}
+}
+
+func TestStoreReplayRejectsUnknownOp(t *testing.T) {
+	s := NewStore(30, 10)
+	r := strings.NewReader(`{"op":"bogus"}` + "\n")
+	if err := s.Replay(r); err == nil {
+		t.Error("expected error replaying unknown op")
+	}
+}
+
+func TestStoreReplayFromSnapshot(t *testing.T) {
+	s := NewStore(30, 10)
+	summarized := time.Now().Add(-time.Minute)
+	snap := walRecord{
+		Op:         opSnapshot,
+		Entries:    []Entry{{Timestamp: time.Now(), Text: "kept", Source: "user"}},
+		Summaries:  []Summary{{StartTime: summarized.Add(-time.Hour), EndTime: summarized, Text: "recap"}},
+		Summarized: &summarized,
+	}
+	line, _ := json.Marshal(snap)
+
+	if err := s.Replay(bytes.NewReader(line)); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if len(s.Entries()) != 1 || s.Entries()[0].Text != "kept" {
+		t.Errorf("expected snapshot entry, got %+v", s.Entries())
+	}
+	if len(s.Summaries()) != 1 || s.Summaries()[0].Text != "recap" {
+		t.Errorf("expected snapshot summary, got %+v", s.Summaries())
+	}
+}
+
+func TestStoreExport(t *testing.T) {
+	s := NewStore(30, 10)
+	s.Add("keep me", "user", "You")
+	cutoff := time.Now()
+	s.Add("also keep", "user", "You")
+
+	var buf bytes.Buffer
+	if err := s.Export(&buf, cutoff.Add(-time.Millisecond)); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	scanner := bufio.NewScanner(&buf)
+	var records []walRecord
+	for scanner.Scan() {
+		var rec walRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("decoding exported record: %v", err)
+		}
+		records = append(records, rec)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 exported records, got %d", len(records))
+	}
+	for _, rec := range records {
+		if rec.Op != opAdd || rec.Entry == nil {
+			t.Errorf("unexpected exported record: %+v", rec)
+		}
+	}
+}
+
+func TestWALCompactPreservesState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transcript.wal")
+
+	s, err := NewStoreWithWAL(30, 10, path, 0)
+	if err != nil {
+		t.Fatalf("NewStoreWithWAL: %v", err)
+	}
+	s.Add("one", "user", "You")
+	s.Add("two", "user", "You")
+
+	s.mu.RLock()
+	snap := walRecord{Op: opSnapshot, Entries: append([]Entry(nil), s.entries...), Summaries: append([]Summary(nil), s.summaries...), Summarized: &s.summarized}
+	s.mu.RUnlock()
+	if err := s.wal.compact(snap); err != nil {
+		t.Fatalf("compact: %v", err)
+	}
+	s.Close()
+
+	reopened, err := NewStoreWithWAL(30, 10, path, 0)
+	if err != nil {
+		t.Fatalf("reopen after compaction: %v", err)
+	}
+	defer reopened.Close()
+
+	entries := reopened.Entries()
+	if len(entries) != 2 || entries[0].Text != "one" || entries[1].Text != "two" {
+		t.Fatalf("expected entries to survive compaction, got %+v", entries)
+	}
+
+	if _, err := os.Stat(path + ".compact"); !os.IsNotExist(err) {
+		t.Error("temporary compaction file should not remain after rename")
+	}
+}
+
+func TestStoreCloseIsNoOpWithoutWAL(t *testing.T) {
+	s := NewStore(30, 10)
+	s.Close() // should not panic
+}
@@ -0,0 +1,48 @@
+//go:build linux
+
+package profiletrigger
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicksPerSecond is USER_HZ on virtually every Linux distribution;
+// there's no portable syscall for it, so we take the long-standing default.
+const clockTicksPerSecond = 100
+
+// processCPUTime reads utime+stime for the current process from
+// /proc/self/stat (fields 14 and 15, in clock ticks).
+func processCPUTime() (time.Duration, error) {
+	data, err := os.ReadFile("/proc/self/stat")
+	if err != nil {
+		return 0, fmt.Errorf("profiletrigger: read /proc/self/stat: %w", err)
+	}
+
+	// Field 2 (comm) is parenthesized and may itself contain spaces, so
+	// split on the last ")" rather than naively splitting on whitespace.
+	parenEnd := strings.LastIndexByte(string(data), ')')
+	if parenEnd < 0 {
+		return 0, fmt.Errorf("profiletrigger: malformed /proc/self/stat")
+	}
+	fields := strings.Fields(string(data[parenEnd+1:]))
+	// fields[0] is field 3 (state); utime is field 14, stime is field 15.
+	const utimeIdx, stimeIdx = 14 - 3, 15 - 3
+	if len(fields) <= stimeIdx {
+		return 0, fmt.Errorf("profiletrigger: too few fields in /proc/self/stat")
+	}
+	utime, err := strconv.ParseInt(fields[utimeIdx], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("profiletrigger: parse utime: %w", err)
+	}
+	stime, err := strconv.ParseInt(fields[stimeIdx], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("profiletrigger: parse stime: %w", err)
+	}
+
+	ticks := utime + stime
+	return time.Duration(ticks) * time.Second / clockTicksPerSecond, nil
+}
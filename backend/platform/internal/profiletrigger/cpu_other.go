@@ -0,0 +1,16 @@
+//go:build !linux
+
+package profiletrigger
+
+import (
+	"errors"
+	"time"
+)
+
+// errUnsupported is returned on platforms without a /proc/self/stat
+// equivalent wired up yet; the CPU threshold simply never fires there.
+var errUnsupported = errors.New("profiletrigger: cpu sampling not implemented on this platform")
+
+func processCPUTime() (time.Duration, error) {
+	return 0, errUnsupported
+}
@@ -0,0 +1,213 @@
+// Package profiletrigger watches process resource usage and automatically
+// captures pprof heap and CPU profiles when it crosses configured
+// thresholds, tagging each capture with the active trace ID. Inspired by
+// Dieter's profiletrigger (https://github.com/dgraph-io/profiletrigger).
+package profiletrigger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
+)
+
+// Config controls sampling thresholds and where captures are written.
+type Config struct {
+	HeapMB             float64       // capture a profile once heap usage exceeds this; 0 disables the heap trigger
+	CPUPct             float64       // capture a profile once CPU usage exceeds this (100 == one full core); 0 disables the CPU trigger
+	Dir                string        // directory profiles are written to; created if missing
+	SampleInterval     time.Duration // how often to sample; defaults to 1s
+	CPUProfileDuration time.Duration // how long each triggered CPU profile runs; defaults to 3s
+}
+
+// DefaultConfig returns conservative production defaults.
+func DefaultConfig() Config {
+	return Config{
+		HeapMB:             512,
+		CPUPct:             80,
+		Dir:                "profiles",
+		SampleInterval:     time.Second,
+		CPUProfileDuration: 3 * time.Second,
+	}
+}
+
+// Clock abstracts time.Now so tests can drive captures deterministically.
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+// ProfileRecord describes one triggered capture.
+type ProfileRecord struct {
+	Reason    string // "heap_threshold", "cpu_threshold", or "slow_span"
+	TraceID   string // empty if no trace was active
+	HeapPath  string // empty if the heap profile failed to write
+	CPUPath   string // empty if the CPU profile failed to write
+	HeapMB    float64
+	CPUPct    float64
+	Timestamp time.Time
+}
+
+// Trigger samples process resource usage on an interval and captures pprof
+// profiles when a threshold is crossed, or when registered as the trace
+// package's SlowSpanHook (see OnSlowSpan).
+type Trigger struct {
+	cfg     Config
+	clock   Clock
+	sampler ResourceSampler
+
+	cpuMu sync.Mutex // serializes runtime/pprof.StartCPUProfile, which only allows one caller at a time
+
+	mu       sync.Mutex
+	profiles []ProfileRecord
+}
+
+// New returns a Trigger sampling the real process.
+func New(cfg Config) *Trigger {
+	return NewWithDeps(cfg, realClock{}, &processSampler{})
+}
+
+// NewWithDeps returns a Trigger with injected clock and sampler, for tests.
+func NewWithDeps(cfg Config, clock Clock, sampler ResourceSampler) *Trigger {
+	return &Trigger{cfg: cfg, clock: clock, sampler: sampler}
+}
+
+// Start creates cfg.Dir if needed and begins sampling in the background
+// until ctx is canceled.
+func (t *Trigger) Start(ctx context.Context) error {
+	if err := os.MkdirAll(t.cfg.Dir, 0o755); err != nil {
+		return fmt.Errorf("profiletrigger: create dir %s: %w", t.cfg.Dir, err)
+	}
+
+	interval := t.cfg.SampleInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.checkThresholds(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *Trigger) checkThresholds(ctx context.Context) {
+	heapMB, cpuPct, err := t.sampler.Sample()
+	if err != nil {
+		slog.Debug("profiletrigger: sample failed", "error", err)
+	}
+
+	switch {
+	case t.cfg.HeapMB > 0 && heapMB > t.cfg.HeapMB:
+		t.capture(ctx, "heap_threshold", heapMB, cpuPct)
+	case t.cfg.CPUPct > 0 && cpuPct > t.cfg.CPUPct:
+		t.capture(ctx, "cpu_threshold", heapMB, cpuPct)
+	}
+}
+
+// OnSlowSpan implements trace.SlowSpanHook: it captures a profile tagged
+// with the span's trace ID. Go's CPU profiler only samples forward from
+// when it's started, so this is a best-effort snapshot of what's running
+// now, not a retroactive view of the span's own lifetime.
+func (t *Trigger) OnSlowSpan(ctx context.Context, span *trace.Span) {
+	ctx = trace.WithContext(ctx, span.Ctx)
+	heapMB, cpuPct, err := t.sampler.Sample()
+	if err != nil {
+		slog.Debug("profiletrigger: sample failed", "error", err)
+	}
+	t.capture(ctx, "slow_span", heapMB, cpuPct)
+}
+
+func (t *Trigger) capture(ctx context.Context, reason string, heapMB, cpuPct float64) ProfileRecord {
+	tc, _ := trace.FromContext(ctx)
+	ts := t.clock.Now()
+	base := fmt.Sprintf("%s-%s-%d", reason, traceIDOrNone(tc.TraceID), ts.UnixNano())
+
+	rec := ProfileRecord{Reason: reason, TraceID: tc.TraceID, HeapMB: heapMB, CPUPct: cpuPct, Timestamp: ts}
+
+	heapPath := filepath.Join(t.cfg.Dir, base+"-heap.pprof")
+	if err := writeHeapProfile(heapPath); err != nil {
+		slog.Warn("profiletrigger: heap profile failed", "reason", reason, "error", err)
+	} else {
+		rec.HeapPath = heapPath
+	}
+
+	cpuPath := filepath.Join(t.cfg.Dir, base+"-cpu.pprof")
+	if err := t.captureCPUProfile(cpuPath); err != nil {
+		slog.Warn("profiletrigger: cpu profile failed", "reason", reason, "error", err)
+	} else {
+		rec.CPUPath = cpuPath
+	}
+
+	t.mu.Lock()
+	t.profiles = append(t.profiles, rec)
+	t.mu.Unlock()
+
+	return rec
+}
+
+// Index returns every profile captured so far, oldest first.
+func (t *Trigger) Index() []ProfileRecord {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	out := make([]ProfileRecord, len(t.profiles))
+	copy(out, t.profiles)
+	return out
+}
+
+func traceIDOrNone(id string) string {
+	if id == "" {
+		return "none"
+	}
+	return id
+}
+
+func writeHeapProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	runtime.GC()
+	return pprof.WriteHeapProfile(f)
+}
+
+func (t *Trigger) captureCPUProfile(path string) error {
+	t.cpuMu.Lock()
+	defer t.cpuMu.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := pprof.StartCPUProfile(f); err != nil {
+		return err
+	}
+	duration := t.cfg.CPUProfileDuration
+	if duration <= 0 {
+		duration = 3 * time.Second
+	}
+	time.Sleep(duration)
+	pprof.StopCPUProfile()
+	return nil
+}
@@ -0,0 +1,142 @@
+package profiletrigger
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
+)
+
+// fakeClock lets tests control the timestamps captures are tagged with.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+// fakeSampler returns a fixed, settable reading on every call.
+type fakeSampler struct {
+	heapMB, cpuPct float64
+}
+
+func (s *fakeSampler) Sample() (float64, float64, error) {
+	return s.heapMB, s.cpuPct, nil
+}
+
+func newTestTrigger(t *testing.T, cfg Config, sampler *fakeSampler, clock *fakeClock) *Trigger {
+	t.Helper()
+	cfg.Dir = t.TempDir()
+	if cfg.CPUProfileDuration <= 0 {
+		cfg.CPUProfileDuration = time.Millisecond
+	}
+	return NewWithDeps(cfg, clock, sampler)
+}
+
+func TestCheckThresholdsCapturesOnHeapExceeded(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	sampler := &fakeSampler{heapMB: 600}
+	trig := newTestTrigger(t, Config{HeapMB: 512, CPUPct: 0}, sampler, clock)
+
+	trig.checkThresholds(context.Background())
+
+	profiles := trig.Index()
+	if len(profiles) != 1 {
+		t.Fatalf("Index() len = %d, want 1", len(profiles))
+	}
+	if profiles[0].Reason != "heap_threshold" {
+		t.Errorf("Reason = %q, want heap_threshold", profiles[0].Reason)
+	}
+	if _, err := os.Stat(profiles[0].HeapPath); err != nil {
+		t.Errorf("heap profile not written: %v", err)
+	}
+	if _, err := os.Stat(profiles[0].CPUPath); err != nil {
+		t.Errorf("cpu profile not written: %v", err)
+	}
+}
+
+func TestCheckThresholdsSkipsWhenBelowThreshold(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	sampler := &fakeSampler{heapMB: 100, cpuPct: 5}
+	trig := newTestTrigger(t, Config{HeapMB: 512, CPUPct: 80}, sampler, clock)
+
+	trig.checkThresholds(context.Background())
+
+	if len(trig.Index()) != 0 {
+		t.Errorf("Index() len = %d, want 0", len(trig.Index()))
+	}
+}
+
+func TestCheckThresholdsCapturesOnCPUExceeded(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	sampler := &fakeSampler{heapMB: 100, cpuPct: 95}
+	trig := newTestTrigger(t, Config{HeapMB: 512, CPUPct: 80}, sampler, clock)
+
+	trig.checkThresholds(context.Background())
+
+	profiles := trig.Index()
+	if len(profiles) != 1 || profiles[0].Reason != "cpu_threshold" {
+		t.Fatalf("Index() = %+v, want one cpu_threshold record", profiles)
+	}
+}
+
+func TestCaptureFilenameIncludesTraceID(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	sampler := &fakeSampler{heapMB: 600}
+	trig := newTestTrigger(t, Config{HeapMB: 512}, sampler, clock)
+
+	ctx := trace.WithContext(context.Background(), trace.Context{TraceID: "abc123"})
+	trig.checkThresholds(ctx)
+
+	profiles := trig.Index()
+	if len(profiles) != 1 {
+		t.Fatalf("Index() len = %d, want 1", len(profiles))
+	}
+	if profiles[0].TraceID != "abc123" {
+		t.Errorf("TraceID = %q, want abc123", profiles[0].TraceID)
+	}
+	got := filepath.Base(profiles[0].HeapPath)
+	if !strings.Contains(got, "heap_threshold") || !strings.Contains(got, "abc123") {
+		t.Errorf("heap profile filename %q does not include reason and trace id", got)
+	}
+}
+
+func TestOnSlowSpanCapturesProfile(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	sampler := &fakeSampler{heapMB: 10, cpuPct: 10}
+	trig := newTestTrigger(t, Config{HeapMB: 0, CPUPct: 0}, sampler, clock)
+
+	_, span := trace.StartSpan(context.Background(), "slow_op", trace.WithSlowThreshold(time.Nanosecond))
+	time.Sleep(time.Millisecond)
+	trig.OnSlowSpan(context.Background(), span)
+
+	profiles := trig.Index()
+	if len(profiles) != 1 || profiles[0].Reason != "slow_span" {
+		t.Fatalf("Index() = %+v, want one slow_span record", profiles)
+	}
+	if profiles[0].TraceID != span.Ctx.TraceID {
+		t.Errorf("TraceID = %q, want %q", profiles[0].TraceID, span.Ctx.TraceID)
+	}
+}
+
+func TestStartRespectsContextCancellation(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	sampler := &fakeSampler{heapMB: 600}
+	trig := newTestTrigger(t, Config{HeapMB: 512, SampleInterval: time.Millisecond}, sampler, clock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := trig.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	countAfterCancel := len(trig.Index())
+	time.Sleep(20 * time.Millisecond)
+	if len(trig.Index()) != countAfterCancel {
+		t.Error("sampling continued after context cancellation")
+	}
+}
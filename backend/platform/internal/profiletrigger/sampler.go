@@ -0,0 +1,45 @@
+package profiletrigger
+
+import (
+	"runtime"
+	"time"
+)
+
+// ResourceSampler reports current resource usage. Real usage goes through
+// processSampler; tests substitute a fake to drive thresholds deterministically.
+type ResourceSampler interface {
+	// Sample returns current heap usage in MB and CPU usage as a percentage
+	// (100 == one full core) averaged since the previous call.
+	Sample() (heapMB, cpuPct float64, err error)
+}
+
+// processSampler reports the running process's own heap and CPU usage. CPU
+// percentage is derived from the delta in cumulative process CPU time
+// between calls, so the first Sample always reports 0% CPU.
+type processSampler struct {
+	lastWall time.Time
+	lastCPU  time.Duration
+}
+
+func (p *processSampler) Sample() (heapMB, cpuPct float64, err error) {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	heapMB = float64(m.HeapAlloc) / (1024 * 1024)
+
+	cpu, err := processCPUTime()
+	if err != nil {
+		return heapMB, 0, err
+	}
+
+	now := time.Now()
+	if !p.lastWall.IsZero() {
+		wallDelta := now.Sub(p.lastWall)
+		cpuDelta := cpu - p.lastCPU
+		if wallDelta > 0 {
+			cpuPct = float64(cpuDelta) / float64(wallDelta) * 100
+		}
+	}
+	p.lastWall, p.lastCPU = now, cpu
+
+	return heapMB, cpuPct, nil
+}
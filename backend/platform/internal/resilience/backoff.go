@@ -0,0 +1,96 @@
+// Package resilience provides fault tolerance patterns
+package resilience
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// Backoff configuration constants, modeled on gRPC's connection-backoff spec
+// (https://github.com/grpc/grpc/blob/master/doc/connection-backoff.md):
+// next = min(MaxDelay, BaseDelay * Factor^retries), then jittered by
+// +/-Jitter.
+const (
+	DefaultBackoffBaseDelay = 1 * time.Second
+	DefaultBackoffFactor    = 1.6
+	DefaultBackoffJitter    = 0.2
+	DefaultBackoffMaxDelay  = 120 * time.Second
+)
+
+// BackoffConfig parameterizes a Backoff's growth curve.
+type BackoffConfig struct {
+	BaseDelay time.Duration
+	Factor    float64
+	Jitter    float64
+	MaxDelay  time.Duration
+}
+
+// DefaultBackoffConfig returns gRPC's standard connection-backoff parameters.
+func DefaultBackoffConfig() BackoffConfig {
+	return BackoffConfig{
+		BaseDelay: DefaultBackoffBaseDelay,
+		Factor:    DefaultBackoffFactor,
+		Jitter:    DefaultBackoffJitter,
+		MaxDelay:  DefaultBackoffMaxDelay,
+	}
+}
+
+func (c BackoffConfig) withDefaults() BackoffConfig {
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = DefaultBackoffBaseDelay
+	}
+	if c.Factor <= 0 {
+		c.Factor = DefaultBackoffFactor
+	}
+	if c.Jitter < 0 {
+		c.Jitter = DefaultBackoffJitter
+	}
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = DefaultBackoffMaxDelay
+	}
+	return c
+}
+
+// Backoff computes gRPC-style connection-backoff delays. It is stateful: each
+// call to Next grows from the retry count accumulated by prior calls, so a
+// caller that polls in a loop naturally gets an increasing delay until Reset
+// is called (typically on success), a thundering herd of retries past an
+// outage.
+type Backoff struct {
+	cfg     BackoffConfig
+	mu      sync.Mutex
+	retries int
+}
+
+// NewBackoff creates a Backoff with cfg, defaulting any unset fields.
+func NewBackoff(cfg BackoffConfig) *Backoff {
+	return &Backoff{cfg: cfg.withDefaults()}
+}
+
+// Next returns the delay for the current retry count, jittered, and
+// advances the retry count for the next call.
+func (b *Backoff) Next() time.Duration {
+	b.mu.Lock()
+	retries := b.retries
+	b.retries++
+	b.mu.Unlock()
+
+	delay := float64(b.cfg.BaseDelay) * math.Pow(b.cfg.Factor, float64(retries))
+	if max := float64(b.cfg.MaxDelay); delay > max {
+		delay = max
+	}
+	jittered := delay * (1 + b.cfg.Jitter*(2*rand.Float64()-1))
+	if jittered < 0 {
+		jittered = 0
+	}
+	return time.Duration(jittered)
+}
+
+// Reset clears the accumulated retry count, e.g. after a successful probe.
+func (b *Backoff) Reset() {
+	b.mu.Lock()
+	b.retries = 0
+	b.mu.Unlock()
+}
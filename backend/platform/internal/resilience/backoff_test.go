@@ -0,0 +1,70 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffGrowsWithRetries(t *testing.T) {
+	b := NewBackoff(BackoffConfig{BaseDelay: time.Second, Factor: 2, Jitter: 0, MaxDelay: time.Hour})
+
+	first := b.Next()
+	second := b.Next()
+	third := b.Next()
+
+	if first != time.Second {
+		t.Errorf("first = %v, want 1s", first)
+	}
+	if second != 2*time.Second {
+		t.Errorf("second = %v, want 2s", second)
+	}
+	if third != 4*time.Second {
+		t.Errorf("third = %v, want 4s", third)
+	}
+}
+
+func TestBackoffCapsAtMaxDelay(t *testing.T) {
+	b := NewBackoff(BackoffConfig{BaseDelay: time.Second, Factor: 10, Jitter: 0, MaxDelay: 5 * time.Second})
+
+	for i := 0; i < 5; i++ {
+		if d := b.Next(); d > 5*time.Second {
+			t.Errorf("Next() = %v, want <= 5s", d)
+		}
+	}
+}
+
+func TestBackoffJitterStaysInBounds(t *testing.T) {
+	b := NewBackoff(BackoffConfig{BaseDelay: time.Second, Factor: 1, Jitter: 0.2, MaxDelay: time.Hour})
+
+	for i := 0; i < 20; i++ {
+		d := b.Next()
+		if d < 800*time.Millisecond || d > 1200*time.Millisecond {
+			t.Errorf("Next() = %v, want within [0.8s, 1.2s]", d)
+		}
+	}
+}
+
+func TestBackoffResetRestartsGrowth(t *testing.T) {
+	b := NewBackoff(BackoffConfig{BaseDelay: time.Second, Factor: 2, Jitter: 0, MaxDelay: time.Hour})
+
+	b.Next()
+	b.Next()
+	b.Reset()
+
+	if d := b.Next(); d != time.Second {
+		t.Errorf("Next() after Reset = %v, want 1s", d)
+	}
+}
+
+func TestBackoffDefaults(t *testing.T) {
+	b := NewBackoff(BackoffConfig{})
+	if b.cfg.BaseDelay != DefaultBackoffBaseDelay {
+		t.Errorf("BaseDelay = %v, want %v", b.cfg.BaseDelay, DefaultBackoffBaseDelay)
+	}
+	if b.cfg.Factor != DefaultBackoffFactor {
+		t.Errorf("Factor = %v, want %v", b.cfg.Factor, DefaultBackoffFactor)
+	}
+	if b.cfg.MaxDelay != DefaultBackoffMaxDelay {
+		t.Errorf("MaxDelay = %v, want %v", b.cfg.MaxDelay, DefaultBackoffMaxDelay)
+	}
+}
@@ -31,46 +31,83 @@ var (
 
 // Breaker implements the circuit breaker pattern with sliding window and exponential backoff.
 type Breaker struct {
-	cfg           Config
-	state         atomic.Uint32
-	successes     atomic.Int32
-	consecutiveOK atomic.Int32
-	openedAt      atomic.Int64 // unix nano when opened
-	openCount     atomic.Int32 // times opened (for backoff)
-	lastLogAt     atomic.Int64 // rate limit logging
-	onStateChange func(from, to State)
-
-	// Sliding window for failure tracking
+	cfg              Config
+	state            atomic.Uint32
+	successes        atomic.Int32
+	consecutiveOK    atomic.Int32
+	halfOpenInFlight atomic.Int32 // probes issued but not yet resolved via Success/Failure
+	openedAt         atomic.Int64 // unix nano when opened
+	openCount        atomic.Int32 // times opened (for backoff)
+	lastLogAt        atomic.Int64 // rate limit logging
+	onStateChange    func(from, to State, class ErrorClass)
+
+	permanentFailures atomic.Int64 // lifetime count of ClassPermanent failures
+
+	// Sliding window for failure tracking (CountBased mode)
 	mu       sync.Mutex
 	failures []int64 // timestamps of recent failures
+
+	window *rateWindow // bucketed success/failure counts (RateBased mode only)
+}
+
+// Stats holds point-in-time breaker counters for observability.
+type Stats struct {
+	State             State
+	OpenCount         int32
+	PermanentFailures int64
+}
+
+// Stats returns a snapshot of breaker counters.
+func (b *Breaker) Stats() Stats {
+	return Stats{
+		State:             b.State(),
+		OpenCount:         b.openCount.Load(),
+		PermanentFailures: b.permanentFailures.Load(),
+	}
 }
 
 // New creates a breaker with config.
 func New(cfg Config) *Breaker {
 	c := cfg.withDefaults()
-	return &Breaker{
+	b := &Breaker{
 		cfg:      c,
 		failures: make([]int64, 0, c.Threshold),
 	}
+	if c.Mode == RateBased {
+		b.window = newRateWindow(c.FailureWindow, time.Now())
+	}
+	return b
 }
 
-// WithHook sets state change callback (for metrics/logging).
-func (b *Breaker) WithHook(fn func(from, to State)) *Breaker {
+// WithHook sets state change callback (for metrics/logging). The class argument
+// identifies the ErrorClass that triggered the transition (ClassTransient for
+// threshold- and recovery-driven changes, ClassPermanent for FailurePermanent trips).
+func (b *Breaker) WithHook(fn func(from, to State, class ErrorClass)) *Breaker {
 	b.onStateChange = fn
 	return b
 }
 
+// WithClassifier overrides the error classifier used by Execute/ExecuteWithResult.
+func (b *Breaker) WithClassifier(fn func(error) ErrorClass) *Breaker {
+	b.cfg.Classifier = fn
+	return b
+}
+
 // Allow checks if request should proceed; returns nil if allowed.
 func (b *Breaker) Allow() error {
 	switch State(b.state.Load()) {
 	case Open:
-		if b.shouldAttemptReset() {
-			b.transition(HalfOpen)
-			return nil
+		if !b.shouldAttemptReset() {
+			b.logOpenThrottled()
+			return ErrOpen
 		}
-		b.logOpenThrottled()
-		return ErrOpen
+		b.transition(HalfOpen, ClassTransient)
+		fallthrough
 	case HalfOpen:
+		if b.halfOpenInFlight.Add(1) > int32(b.cfg.HalfOpenProbes) {
+			b.halfOpenInFlight.Add(-1)
+			return ErrHalfOpen
+		}
 		return nil
 	default:
 		return nil
@@ -119,10 +156,14 @@ func (b *Breaker) Success() {
 	state := State(b.state.Load())
 	switch state {
 	case HalfOpen:
+		b.halfOpenInFlight.Add(-1)
 		if b.successes.Add(1) >= int32(b.cfg.HalfOpenSuccesses) {
-			b.transition(Closed)
+			b.transition(Closed, ClassTransient)
 		}
 	case Closed:
+		if b.cfg.Mode == RateBased {
+			b.window.recordSuccess(time.Now())
+		}
 		// Track consecutive successes to decay open count
 		if b.consecutiveOK.Add(1) >= int32(b.cfg.Threshold*2) {
 			b.openCount.Store(0) // reset backoff after sustained success
@@ -134,27 +175,52 @@ func (b *Breaker) Success() {
 
 // Failure records failed call using sliding window.
 func (b *Breaker) Failure() {
-	now := time.Now().UnixNano()
+	now := time.Now()
 	b.consecutiveOK.Store(0)
 
 	state := State(b.state.Load())
 	switch state {
 	case HalfOpen:
-		b.transition(Open)
+		b.halfOpenInFlight.Add(-1)
+		b.transition(Open, ClassTransient)
 		return
 	case Closed:
+		if b.cfg.Mode == RateBased {
+			b.window.recordFailure(now)
+			successes, failures := b.window.totals(now)
+			total := successes + failures
+			if total >= int64(b.cfg.MinimumRequests) && float64(failures)/float64(total) >= b.cfg.FailureRateThreshold {
+				b.transition(Open, ClassTransient)
+			}
+			return
+		}
+
+		nowNano := now.UnixNano()
 		b.mu.Lock()
-		b.failures = append(b.failures, now)
-		b.pruneFailures(now)
+		b.failures = append(b.failures, nowNano)
+		b.pruneFailures(nowNano)
 		count := len(b.failures)
 		b.mu.Unlock()
 
 		if count >= b.cfg.Threshold {
-			b.transition(Open)
+			b.transition(Open, ClassTransient)
 		}
 	}
 }
 
+// FailurePermanent immediately trips the breaker to Open for a non-recoverable
+// error (corrupted data, revoked auth), bypassing the sliding-window threshold,
+// and escalates the backoff as if this were a repeat trip so recovery attempts
+// don't hammer a service that just told us it will never succeed.
+func (b *Breaker) FailurePermanent() {
+	b.consecutiveOK.Store(0)
+	b.permanentFailures.Add(1)
+	if b.openCount.Load() == 0 {
+		b.openCount.Store(1) // ensure backoff escalates past the base level below
+	}
+	b.transition(Open, ClassPermanent)
+}
+
 // pruneFailures removes failures outside the sliding window. Must hold mu.
 func (b *Breaker) pruneFailures(now int64) {
 	cutoff := now - int64(b.cfg.FailureWindow)
@@ -181,12 +247,13 @@ func (b *Breaker) State() State {
 
 // Reset forces breaker to closed state.
 func (b *Breaker) Reset() {
-	b.transition(Closed)
+	b.transition(Closed, ClassTransient)
 	b.openCount.Store(0)
 }
 
-// transition changes state with side effects.
-func (b *Breaker) transition(to State) {
+// transition changes state with side effects. class identifies the error class
+// that triggered the change, reported to onStateChange for metrics.
+func (b *Breaker) transition(to State, class ErrorClass) {
 	from := State(b.state.Swap(uint32(to)))
 	if from == to {
 		return
@@ -197,21 +264,27 @@ func (b *Breaker) transition(to State) {
 		b.mu.Lock()
 		b.failures = b.failures[:0]
 		b.mu.Unlock()
+		if b.window != nil {
+			b.window.reset(time.Now())
+		}
 		b.successes.Store(0)
 		b.consecutiveOK.Store(0)
+		b.halfOpenInFlight.Store(0)
 		slog.Info("circuit breaker closed")
 	case Open:
 		b.successes.Store(0)
+		b.halfOpenInFlight.Store(0)
 		b.openedAt.Store(time.Now().UnixNano())
 		count := b.openCount.Add(1)
-		slog.Warn("circuit breaker opened", "failures", b.cfg.Threshold, "backoff", b.currentBackoff(), "open_count", count)
+		slog.Warn("circuit breaker opened", "failures", b.cfg.Threshold, "backoff", b.currentBackoff(), "open_count", count, "class", class)
 	case HalfOpen:
 		b.successes.Store(0)
-		slog.Info("circuit breaker half-open", "required_successes", b.cfg.HalfOpenSuccesses)
+		b.halfOpenInFlight.Store(0)
+		slog.Info("circuit breaker half-open", "required_successes", b.cfg.HalfOpenSuccesses, "max_probes", b.cfg.HalfOpenProbes)
 	}
 
 	if b.onStateChange != nil {
-		b.onStateChange(from, to)
+		b.onStateChange(from, to, class)
 	}
 }
 
@@ -223,20 +296,40 @@ func (b *Breaker) shouldAttemptReset() bool {
 	return time.Since(time.Unix(0, opened)) > b.currentBackoff()
 }
 
-// Execute runs fn with circuit breaker protection.
-func (b *Breaker) Execute(fn func() error) error {
-	if err := b.Allow(); err != nil {
-		return err
+// record applies cfg.Classifier to err and updates breaker state accordingly:
+// nil succeeds, ClassIgnore skips accounting entirely, ClassPermanent trips
+// the breaker immediately, and anything else follows the normal
+// sliding-window Failure() path. Shared by Execute, ExecuteWithResult, and
+// Retry's RetryConfig.Breaker integration.
+func (b *Breaker) record(err error) {
+	if err == nil {
+		b.Success()
+		return
 	}
-	if err := fn(); err != nil {
+	switch b.cfg.Classifier(err) {
+	case ClassIgnore:
+	case ClassPermanent:
+		b.FailurePermanent()
+	default:
 		b.Failure()
+	}
+}
+
+// Execute runs fn with circuit breaker protection, classifying any returned
+// error via cfg.Classifier: ClassIgnore skips accounting entirely, ClassPermanent
+// trips the breaker immediately, and ClassTransient follows the normal
+// sliding-window Failure() path.
+func (b *Breaker) Execute(fn func() error) error {
+	if err := b.Allow(); err != nil {
 		return err
 	}
-	b.Success()
-	return nil
+	err := fn()
+	b.record(err)
+	return err
 }
 
-// ExecuteWithResult runs fn returning value and error with circuit protection.
+// ExecuteWithResult runs fn returning value and error with circuit protection,
+// classifying errors the same way as Execute.
 func ExecuteWithResult[T any](b *Breaker, fn func() (T, error)) (T, error) {
 	var zero T
 	if err := b.Allow(); err != nil {
@@ -244,9 +337,9 @@ func ExecuteWithResult[T any](b *Breaker, fn func() (T, error)) (T, error) {
 	}
 	result, err := fn()
 	if err != nil {
-		b.Failure()
+		b.record(err)
 		return zero, err
 	}
-	b.Success()
+	b.record(nil)
 	return result, nil
 }
@@ -1,10 +1,15 @@
 package resilience
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // testConfig returns a fast config for testing (short windows).
@@ -143,7 +148,7 @@ func TestBreakerExecuteWithResult(t *testing.T) {
 func TestBreakerHook(t *testing.T) {
 	var transitions []struct{ from, to State }
 	b := New(testConfig(1))
-	b.WithHook(func(from, to State) {
+	b.WithHook(func(from, to State, class ErrorClass) {
 		transitions = append(transitions, struct{ from, to State }{from, to})
 	})
 
@@ -216,6 +221,73 @@ func TestConfigDefaults(t *testing.T) {
 	}
 }
 
+func TestHalfOpenProbesLimitsConcurrentRequests(t *testing.T) {
+	cfg := testConfig(1)
+	cfg.HalfOpenSuccesses = 1
+	cfg.HalfOpenProbes = 2
+	b := New(cfg)
+	b.Failure()
+	time.Sleep(5 * time.Millisecond)
+
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe 1: Allow() = %v, want nil", err)
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe 2: Allow() = %v, want nil", err)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrHalfOpen) {
+		t.Errorf("probe 3: Allow() = %v, want ErrHalfOpen", err)
+	}
+}
+
+func TestHalfOpenProbesFreedOnResolve(t *testing.T) {
+	cfg := testConfig(1)
+	cfg.HalfOpenSuccesses = 5 // stay half-open across multiple probes
+	cfg.HalfOpenProbes = 1
+	b := New(cfg)
+	b.Failure()
+	time.Sleep(5 * time.Millisecond)
+
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe 1: Allow() = %v, want nil", err)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrHalfOpen) {
+		t.Fatalf("probe 2 before resolve: Allow() = %v, want ErrHalfOpen", err)
+	}
+
+	b.Success() // resolves probe 1, freeing a slot
+
+	if err := b.Allow(); err != nil {
+		t.Errorf("probe after resolve: Allow() = %v, want nil", err)
+	}
+}
+
+func TestGRPCClassifier(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want ErrorClass
+	}{
+		{codes.Unavailable, ClassTransient},
+		{codes.DeadlineExceeded, ClassTransient},
+		{codes.ResourceExhausted, ClassTransient},
+		{codes.Aborted, ClassTransient},
+		{codes.Internal, ClassTransient},
+		{codes.InvalidArgument, ClassIgnore},
+		{codes.NotFound, ClassIgnore},
+	}
+
+	for _, tt := range tests {
+		err := status.Error(tt.code, "test")
+		if got := GRPCClassifier(err); got != tt.want {
+			t.Errorf("GRPCClassifier(%v) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+
+	if got := GRPCClassifier(context.Canceled); got != ClassIgnore {
+		t.Errorf("GRPCClassifier(context.Canceled) = %v, want ClassIgnore", got)
+	}
+}
+
 func TestSlidingWindowExpiry(t *testing.T) {
 	cfg := Config{
 		Threshold:         3,
@@ -236,6 +308,211 @@ func TestSlidingWindowExpiry(t *testing.T) {
 	}
 }
 
+func TestBreakerDefaultClassifier(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorClass
+	}{
+		{"nil-like context canceled", context.Canceled, ClassIgnore},
+		{"deadline exceeded", context.DeadlineExceeded, ClassIgnore},
+		{"permanent sentinel", fmt.Errorf("auth revoked: %w", ErrPermanent), ClassPermanent},
+		{"plain error", errors.New("connection reset"), ClassTransient},
+	}
+	for _, tt := range tests {
+		if got := DefaultClassifier(tt.err); got != tt.want {
+			t.Errorf("%s: DefaultClassifier() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBreakerExecuteIgnoresCanceled(t *testing.T) {
+	b := New(testConfig(1))
+
+	err := b.Execute(func() error { return context.Canceled })
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Execute() = %v, want context.Canceled", err)
+	}
+	if b.State() != Closed {
+		t.Errorf("state = %v, want Closed (ignored error shouldn't trip breaker)", b.State())
+	}
+	if b.Stats().PermanentFailures != 0 {
+		t.Errorf("PermanentFailures = %d, want 0", b.Stats().PermanentFailures)
+	}
+}
+
+func TestBreakerExecutePermanentTripsImmediately(t *testing.T) {
+	b := New(testConfig(10)) // threshold high enough that a transient failure wouldn't trip it
+
+	err := b.Execute(func() error { return ErrPermanent })
+	if !errors.Is(err, ErrPermanent) {
+		t.Fatalf("Execute() = %v, want ErrPermanent", err)
+	}
+	if b.State() != Open {
+		t.Errorf("state = %v, want Open (permanent failure should trip immediately)", b.State())
+	}
+	stats := b.Stats()
+	if stats.PermanentFailures != 1 {
+		t.Errorf("PermanentFailures = %d, want 1", stats.PermanentFailures)
+	}
+	if stats.OpenCount < 2 {
+		t.Errorf("OpenCount = %d, want >= 2 (backoff should start escalated)", stats.OpenCount)
+	}
+}
+
+func TestBreakerWithClassifier(t *testing.T) {
+	sentinel := errors.New("custom permanent")
+	b := New(testConfig(10)).WithClassifier(func(err error) ErrorClass {
+		if errors.Is(err, sentinel) {
+			return ClassPermanent
+		}
+		return ClassTransient
+	})
+
+	_ = b.Execute(func() error { return sentinel })
+	if b.State() != Open {
+		t.Errorf("state = %v, want Open", b.State())
+	}
+}
+
+func TestBreakerHookReceivesClass(t *testing.T) {
+	var gotClass ErrorClass
+	b := New(testConfig(10))
+	b.WithHook(func(from, to State, class ErrorClass) {
+		if to == Open {
+			gotClass = class
+		}
+	})
+
+	_ = b.Execute(func() error { return ErrPermanent })
+
+	if gotClass != ClassPermanent {
+		t.Errorf("hook class = %v, want ClassPermanent", gotClass)
+	}
+}
+
+func rateConfig(threshold float64, minRequests int, window time.Duration) Config {
+	return Config{
+		Mode:                 RateBased,
+		FailureRateThreshold: threshold,
+		MinimumRequests:      minRequests,
+		ResetTimeout:         time.Hour,
+		MaxBackoff:           time.Hour,
+		FailureWindow:        window,
+		HalfOpenSuccesses:    1,
+	}
+}
+
+func TestRateBasedBreakerStaysClosedBelowMinimumRequests(t *testing.T) {
+	b := New(rateConfig(0.5, 10, time.Hour))
+
+	// 3 failures, 0 successes: a 100% failure rate, but below MinimumRequests.
+	b.Failure()
+	b.Failure()
+	b.Failure()
+
+	if b.State() != Closed {
+		t.Errorf("state = %v, want Closed (sample size below MinimumRequests)", b.State())
+	}
+}
+
+func TestRateBasedBreakerTripsOnFailureRatio(t *testing.T) {
+	b := New(rateConfig(0.5, 10, time.Hour))
+
+	for i := 0; i < 5; i++ {
+		b.Success()
+	}
+	for i := 0; i < 5; i++ {
+		b.Failure()
+	}
+
+	if b.State() != Open {
+		t.Errorf("state = %v, want Open (50%% failure rate at the threshold)", b.State())
+	}
+}
+
+func TestRateBasedBreakerStaysClosedBelowThreshold(t *testing.T) {
+	b := New(rateConfig(0.5, 10, time.Hour))
+
+	for i := 0; i < 8; i++ {
+		b.Success()
+	}
+	for i := 0; i < 2; i++ {
+		b.Failure()
+	}
+
+	if b.State() != Closed {
+		t.Errorf("state = %v, want Closed (20%% failure rate is below the 50%% threshold)", b.State())
+	}
+}
+
+func TestRateBasedBreakerSameRawCountDifferentVolume(t *testing.T) {
+	// 5 failures out of 10 requests (low volume) trips...
+	low := New(rateConfig(0.5, 10, time.Hour))
+	for i := 0; i < 5; i++ {
+		low.Success()
+	}
+	for i := 0; i < 5; i++ {
+		low.Failure()
+	}
+	if low.State() != Open {
+		t.Errorf("low-volume state = %v, want Open", low.State())
+	}
+
+	// ...but the same 5 failures out of 1000 requests (high volume) does not,
+	// unlike a CountBased breaker which would trip on the raw count alone.
+	high := New(rateConfig(0.5, 10, time.Hour))
+	for i := 0; i < 995; i++ {
+		high.Success()
+	}
+	for i := 0; i < 5; i++ {
+		high.Failure()
+	}
+	if high.State() != Closed {
+		t.Errorf("high-volume state = %v, want Closed (0.5%% failure rate is well below threshold)", high.State())
+	}
+}
+
+func TestRateBasedBreakerBucketExpiry(t *testing.T) {
+	b := New(rateConfig(0.5, 3, 50*time.Millisecond))
+
+	// 2 failures out of 2 requests is a 100% rate, but below MinimumRequests
+	// so it doesn't trip yet.
+	b.Failure()
+	b.Failure()
+	time.Sleep(60 * time.Millisecond) // let those buckets roll out of the window
+
+	// If the old failures were still counted, this would push the rate to
+	// 3/5 = 60% and trip; since they've rolled out, it's 1/3 = 33%.
+	b.Success()
+	b.Success()
+	b.Failure()
+
+	if b.State() != Closed {
+		t.Errorf("state = %v, want Closed (old failures should have rolled out of the window)", b.State())
+	}
+}
+
+func TestRateBasedBreakerResetsOnClose(t *testing.T) {
+	b := New(rateConfig(0.5, 2, time.Hour))
+	b.Failure()
+	b.Failure()
+	if b.State() != Open {
+		t.Fatal("expected Open")
+	}
+
+	b.Reset()
+	if b.State() != Closed {
+		t.Fatal("expected Closed after Reset")
+	}
+
+	// After Reset, old failures shouldn't count toward a new trip.
+	b.Success()
+	if b.State() != Closed {
+		t.Errorf("state = %v, want Closed (stale failures should not carry over after Reset)", b.State())
+	}
+}
+
 func TestExponentialBackoff(t *testing.T) {
 	cfg := Config{
 		Threshold:         1,
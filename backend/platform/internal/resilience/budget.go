@@ -0,0 +1,107 @@
+// Package resilience provides fault tolerance patterns
+package resilience
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Budget caps the rate of retry attempts relative to the rate of original
+// requests, so a flood of callers hitting a sick downstream can't multiply
+// load by MaxRetries on top of it (the classic retry-amplification failure
+// mode; see the Google SRE book's retry budget pattern). It is a token
+// bucket: each original (non-retry) request credits ratio tokens, and a
+// continuous floor of minPerSec tokens/sec accrues regardless of traffic so
+// a cold-started or low-volume client still gets some retry budget. Each
+// retry attempt spends one token; once the bucket is empty, Retry returns
+// the original error immediately instead of queuing more load behind a
+// failing dependency.
+//
+// A single Budget is meant to be shared (via RetryConfig.Budget) across all
+// calls hitting the same downstream, so the budget reflects their combined
+// traffic rather than each caller getting its own.
+type Budget struct {
+	ratio     float64
+	minPerSec float64
+	capacity  float64
+
+	mu       sync.Mutex
+	tokens   float64
+	lastFill time.Time
+
+	exhausted atomic.Int64
+}
+
+// NewRetryBudget creates a Budget crediting ratio tokens per original
+// request plus a continuous minPerSec tokens/sec floor, capped at 10x
+// minPerSec (or 10, whichever is larger) so banked credit from a past burst
+// can't fund an unbounded number of future retries.
+func NewRetryBudget(ratio float64, minPerSec int) *Budget {
+	if ratio < 0 {
+		ratio = 0
+	}
+	if minPerSec < 0 {
+		minPerSec = 0
+	}
+	capacity := float64(minPerSec) * 10
+	if capacity < 10 {
+		capacity = 10
+	}
+	return &Budget{
+		ratio:     ratio,
+		minPerSec: float64(minPerSec),
+		capacity:  capacity,
+		tokens:    capacity,
+		lastFill:  time.Now(),
+	}
+}
+
+// recordRequest credits the budget for one original request.
+func (b *Budget) recordRequest() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refillLocked()
+	b.tokens = min(b.tokens+b.ratio, b.capacity)
+}
+
+// allowRetry reports whether a token is available for a retry attempt,
+// consuming one if so. A denied retry counts toward BudgetStats.Exhausted.
+func (b *Budget) allowRetry() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refillLocked()
+	if b.tokens < 1 {
+		b.exhausted.Add(1)
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// refillLocked adds the elapsed-time floor credit. Caller must hold b.mu.
+func (b *Budget) refillLocked() {
+	now := time.Now()
+	elapsed := now.Sub(b.lastFill).Seconds()
+	b.lastFill = now
+	if elapsed <= 0 || b.minPerSec <= 0 {
+		return
+	}
+	b.tokens = min(b.tokens+elapsed*b.minPerSec, b.capacity)
+}
+
+// BudgetStats is a snapshot of a Budget's current state, suitable for
+// exporting as metrics (tokens_available, budget_exhausted_total).
+type BudgetStats struct {
+	TokensAvailable float64
+	Exhausted       int64
+}
+
+// Stats returns a snapshot of the budget's current token count and
+// lifetime exhaustion count.
+func (b *Budget) Stats() BudgetStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refillLocked()
+	return BudgetStats{TokensAvailable: b.tokens, Exhausted: b.exhausted.Load()}
+}
@@ -0,0 +1,114 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewRetryBudgetStartsFull(t *testing.T) {
+	b := NewRetryBudget(1, 5)
+	if got := b.Stats().TokensAvailable; got != 50 {
+		t.Errorf("TokensAvailable = %v, want 50 (capacity)", got)
+	}
+}
+
+func TestBudgetAllowRetryConsumesToken(t *testing.T) {
+	b := NewRetryBudget(0, 0) // capacity floors at 10, no refill
+	for i := 0; i < 10; i++ {
+		if !b.allowRetry() {
+			t.Fatalf("allowRetry() false on attempt %d, want true", i)
+		}
+	}
+	if b.allowRetry() {
+		t.Error("allowRetry() true after exhausting all tokens, want false")
+	}
+	if got := b.Stats().Exhausted; got != 1 {
+		t.Errorf("Exhausted = %d, want 1", got)
+	}
+}
+
+func TestBudgetRecordRequestCredits(t *testing.T) {
+	b := NewRetryBudget(2, 0)
+	for b.allowRetry() {
+		// drain the initial capacity
+	}
+	b.recordRequest()
+	if got := b.Stats().TokensAvailable; got != 2 {
+		t.Errorf("TokensAvailable after recordRequest = %v, want 2", got)
+	}
+}
+
+func TestBudgetRecordRequestCappedAtCapacity(t *testing.T) {
+	b := NewRetryBudget(100, 0) // capacity floors at 10
+	b.recordRequest()
+	if got := b.Stats().TokensAvailable; got != 10 {
+		t.Errorf("TokensAvailable = %v, want capped at 10", got)
+	}
+}
+
+func TestBudgetFloorRateAccrues(t *testing.T) {
+	b := NewRetryBudget(0, 1000) // capacity 10000, 1000 tokens/sec floor
+	for b.allowRetry() {
+		// drain the initial capacity
+	}
+	time.Sleep(20 * time.Millisecond)
+	if got := b.Stats().TokensAvailable; got <= 0 {
+		t.Errorf("TokensAvailable = %v, want floor rate to have accrued some tokens", got)
+	}
+}
+
+func TestRetryReturnsEarlyWhenBudgetExhausted(t *testing.T) {
+	b := NewRetryBudget(0, 0) // capacity 10, no refill between retries
+	cfg := RetryConfig{MaxRetries: 20, BaseDelay: time.Microsecond, MaxDelay: time.Microsecond, Budget: b}
+	retryErr := status.Error(codes.Unavailable, "down")
+
+	calls := 0
+	err := Retry(context.Background(), cfg, func() error {
+		calls++
+		return retryErr
+	})
+
+	if err != retryErr {
+		t.Errorf("Retry() = %v, want %v", err, retryErr)
+	}
+	// 1 initial attempt + at most 10 budgeted retries, well short of 21 unthrottled attempts.
+	if calls > 11 {
+		t.Errorf("calls = %d, want at most 11 once the budget is exhausted", calls)
+	}
+}
+
+func TestRetryBudgetCapsRetryStormFromFailingCallers(t *testing.T) {
+	budget := NewRetryBudget(1, 0) // 1 retry token credited per original request
+	cfg := RetryConfig{MaxRetries: 5, BaseDelay: time.Microsecond, MaxDelay: time.Microsecond, Budget: budget}
+	retryErr := status.Error(codes.Unavailable, "down")
+
+	const callers = 20
+	var totalAttempts atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = Retry(context.Background(), cfg, func() error {
+				totalAttempts.Add(1)
+				return retryErr
+			})
+		}()
+	}
+	wg.Wait()
+
+	unthrottled := int64(callers * (1 + cfg.MaxRetries))
+	got := totalAttempts.Load()
+	if got >= unthrottled {
+		t.Errorf("attempts = %d, want far fewer than the unthrottled %d (MaxRetries x callers)", got, unthrottled)
+	}
+	if got < callers {
+		t.Errorf("attempts = %d, want at least %d (every caller's first attempt)", got, callers)
+	}
+}
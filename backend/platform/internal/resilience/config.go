@@ -1,6 +1,10 @@
 package resilience
 
-import "time"
+import (
+	"context"
+	"errors"
+	"time"
+)
 
 // Circuit breaker configuration constants
 const (
@@ -10,6 +14,7 @@ const (
 	DefaultMaxBackoff        = 60 * time.Second // max exponential backoff
 	DefaultFailureWindow     = 30 * time.Second // sliding window for counting failures
 	DefaultHalfOpenSuccesses = 2                // successes needed to close
+	DefaultHalfOpenProbes    = 1                // concurrent probes permitted while half-open
 
 	// Fast configuration (aggressive, for critical paths)
 	FastThreshold         = 5
@@ -17,6 +22,7 @@ const (
 	FastMaxBackoff        = 30 * time.Second
 	FastFailureWindow     = 10 * time.Second
 	FastHalfOpenSuccesses = 1
+	FastHalfOpenProbes    = 1
 
 	// Slow configuration (lenient, for less critical paths)
 	SlowThreshold         = 20
@@ -24,15 +30,98 @@ const (
 	SlowMaxBackoff        = 120 * time.Second
 	SlowFailureWindow     = 60 * time.Second
 	SlowHalfOpenSuccesses = 3
+	SlowHalfOpenProbes    = 2
+
+	// RateBased mode defaults
+	DefaultFailureRateThreshold = 0.5 // trip once half of sampled requests fail
+	DefaultMinimumRequests      = 10  // samples required before the rate is evaluated
+	rateWindowBuckets           = 10  // buckets FailureWindow is divided into for rate tracking
 )
 
+// Mode selects how a Breaker decides to trip. CountBased (the default) trips
+// on an absolute failure count within FailureWindow, which misbehaves across
+// wildly different traffic volumes: a service at 10rps tripping at 5
+// failures behaves very differently from one at 10000rps. RateBased instead
+// trips on a failure ratio once a minimum sample size is met, so the same
+// config behaves consistently regardless of request volume.
+type Mode int
+
+const (
+	CountBased Mode = iota
+	RateBased
+)
+
+func (m Mode) String() string {
+	return [...]string{"count_based", "rate_based"}[m]
+}
+
+// ErrorClass categorizes an error for breaker accounting purposes.
+type ErrorClass int
+
+const (
+	// ClassTransient is a retryable, expected-to-recover failure (network blip, EOF).
+	// It runs the normal sliding-window Failure() path.
+	ClassTransient ErrorClass = iota
+	// ClassPermanent is a non-recoverable failure (corrupted data, auth revoked).
+	// It trips the breaker immediately and escalates the backoff.
+	ClassPermanent
+	// ClassIgnore is not a real failure (context cancellation, caller-initiated stop)
+	// and affects neither success nor failure accounting.
+	ClassIgnore
+)
+
+func (c ErrorClass) String() string {
+	return [...]string{"transient", "permanent", "ignore"}[c]
+}
+
+// ErrPermanent is a sentinel wrappable via fmt.Errorf("%w: ...", ErrPermanent) or
+// errors.Join to mark an error as permanent under the DefaultClassifier.
+var ErrPermanent = errors.New("resilience: permanent failure")
+
+// DefaultClassifier classifies context cancellation as ignorable, errors wrapping
+// ErrPermanent as permanent, and everything else as transient.
+func DefaultClassifier(err error) ErrorClass {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return ClassIgnore
+	case errors.Is(err, ErrPermanent):
+		return ClassPermanent
+	default:
+		return ClassTransient
+	}
+}
+
+// GRPCClassifier classifies errors for a breaker guarding gRPC calls, using
+// the same retryable-code set as IsRetryableGRPC: Unavailable,
+// DeadlineExceeded, ResourceExhausted, Aborted, and Internal count as
+// transient failures against the breaker's sliding window. Context
+// cancellation is ignored, and any other code (InvalidArgument, NotFound,
+// PermissionDenied, ...) is ignored too, since those reflect a bad call
+// rather than a struggling backend and shouldn't trip the breaker.
+func GRPCClassifier(err error) ErrorClass {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return ClassIgnore
+	case IsRetryableGRPC(err):
+		return ClassTransient
+	default:
+		return ClassIgnore
+	}
+}
+
 // Config holds circuit breaker settings.
 type Config struct {
-	Threshold         int           // failures within window before opening
-	ResetTimeout      time.Duration // initial wait before half-open attempt
-	MaxBackoff        time.Duration // max backoff after repeated opens
-	FailureWindow     time.Duration // sliding window for failure counting
-	HalfOpenSuccesses int           // successes needed to close
+	Threshold         int                    // failures within window before opening (CountBased mode)
+	ResetTimeout      time.Duration          // initial wait before half-open attempt
+	MaxBackoff        time.Duration          // max backoff after repeated opens
+	FailureWindow     time.Duration          // sliding window for failure counting/rate tracking
+	HalfOpenSuccesses int                    // successes needed to close
+	HalfOpenProbes    int                    // concurrent probe requests permitted while half-open
+	Classifier        func(error) ErrorClass // classifies errors for Execute/ExecuteWithResult; defaults to DefaultClassifier
+
+	Mode                 Mode    // CountBased (default) or RateBased
+	FailureRateThreshold float64 // 0.0-1.0 failure ratio to trip at; only used in RateBased mode
+	MinimumRequests      int     // samples required before the rate is evaluated; only used in RateBased mode
 }
 
 // DefaultConfig returns startup-tolerant production defaults.
@@ -43,6 +132,7 @@ func DefaultConfig() Config {
 		MaxBackoff:        DefaultMaxBackoff,
 		FailureWindow:     DefaultFailureWindow,
 		HalfOpenSuccesses: DefaultHalfOpenSuccesses,
+		HalfOpenProbes:    DefaultHalfOpenProbes,
 	}
 }
 
@@ -54,6 +144,7 @@ func FastConfig() Config {
 		MaxBackoff:        FastMaxBackoff,
 		FailureWindow:     FastFailureWindow,
 		HalfOpenSuccesses: FastHalfOpenSuccesses,
+		HalfOpenProbes:    FastHalfOpenProbes,
 	}
 }
 
@@ -65,6 +156,7 @@ func SlowConfig() Config {
 		MaxBackoff:        SlowMaxBackoff,
 		FailureWindow:     SlowFailureWindow,
 		HalfOpenSuccesses: SlowHalfOpenSuccesses,
+		HalfOpenProbes:    SlowHalfOpenProbes,
 	}
 }
 
@@ -84,5 +176,19 @@ func (c Config) withDefaults() Config {
 	if c.HalfOpenSuccesses <= 0 {
 		c.HalfOpenSuccesses = DefaultHalfOpenSuccesses
 	}
+	if c.HalfOpenProbes <= 0 {
+		c.HalfOpenProbes = DefaultHalfOpenProbes
+	}
+	if c.Classifier == nil {
+		c.Classifier = DefaultClassifier
+	}
+	if c.Mode == RateBased {
+		if c.FailureRateThreshold <= 0 {
+			c.FailureRateThreshold = DefaultFailureRateThreshold
+		}
+		if c.MinimumRequests <= 0 {
+			c.MinimumRequests = DefaultMinimumRequests
+		}
+	}
 	return c
 }
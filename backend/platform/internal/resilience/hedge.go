@@ -0,0 +1,180 @@
+// Package resilience provides fault tolerance patterns
+package resilience
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Hedge configuration constants
+const (
+	DefaultHedgeMaxAttempts = 2
+	DefaultHedgeDelay       = 100 * time.Millisecond
+)
+
+// HedgeConfig holds hedged-request settings.
+type HedgeConfig struct {
+	MaxAttempts int // total in-flight copies including the first; default 2
+
+	// HedgeDelay is the static wait before launching the next attempt. Ignored
+	// once Latency has recorded at least one sample, in favor of its live P95.
+	HedgeDelay time.Duration
+	Latency    *LatencyTracker // optional; live P95 overrides HedgeDelay once warmed up
+
+	PerAttemptTimeout time.Duration // optional per-attempt timeout; 0 disables
+
+	// Budget, if set, is consulted before launching every attempt past the
+	// first, rate-limiting extra copies the same way Retry rate-limits
+	// retries. Share the same *Budget between Retry and Hedge calls against
+	// a downstream to cap their combined extra load.
+	Budget *Budget
+
+	// IsHedgeable reports whether a failed attempt's error still permits
+	// racing further copies: false fails Hedge fast with that error instead
+	// of waiting on the rest, since hedging a non-idempotent-unsafe failure
+	// (e.g. a write that may have already landed) risks duplicating its
+	// side effects. Defaults to IsRetryableGRPC's safe/idempotent code set.
+	IsHedgeable func(error) bool
+}
+
+func (c HedgeConfig) withDefaults() HedgeConfig {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = DefaultHedgeMaxAttempts
+	}
+	if c.HedgeDelay <= 0 {
+		c.HedgeDelay = DefaultHedgeDelay
+	}
+	if c.IsHedgeable == nil {
+		c.IsHedgeable = IsRetryableGRPC
+	}
+	return c
+}
+
+// hedgeDelay returns the live P95 from Latency once it has samples,
+// otherwise the configured static HedgeDelay.
+func (c HedgeConfig) hedgeDelay() time.Duration {
+	if c.Latency != nil {
+		if p95 := c.Latency.P95(); p95 > 0 {
+			return p95
+		}
+	}
+	return c.HedgeDelay
+}
+
+type hedgeResult[T any] struct {
+	val T
+	err error
+}
+
+// Hedge runs fn, and if it hasn't returned within the hedge delay, launches
+// additional copies of it in parallel (up to cfg.MaxAttempts total). The
+// first attempt to return a nil error wins; every other attempt is canceled
+// via ctx. fn must be idempotent, since more than one copy may run
+// concurrently against the real dependency.
+func Hedge[T any](ctx context.Context, cfg HedgeConfig, fn func(context.Context) (T, error)) (T, error) {
+	cfg = cfg.withDefaults()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgeResult[T], cfg.MaxAttempts)
+	launch := func() {
+		go func() {
+			attemptCtx := ctx
+			if cfg.PerAttemptTimeout > 0 {
+				var attemptCancel context.CancelFunc
+				attemptCtx, attemptCancel = context.WithTimeout(ctx, cfg.PerAttemptTimeout)
+				defer attemptCancel()
+			}
+			start := time.Now()
+			val, err := fn(attemptCtx)
+			if cfg.Latency != nil {
+				cfg.Latency.Record(time.Since(start))
+			}
+			select {
+			case results <- hedgeResult[T]{val, err}:
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	launch()
+	launched := 1
+
+	var timerC <-chan time.Time
+	if cfg.MaxAttempts > 1 {
+		timer := time.NewTimer(cfg.hedgeDelay())
+		defer timer.Stop()
+		timerC = timer.C
+	}
+
+	var zero T
+	var lastErr error
+	pending := 1
+	for pending > 0 {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				return res.val, nil
+			}
+			lastErr = res.err
+			if !cfg.IsHedgeable(res.err) {
+				return zero, res.err
+			}
+		case <-timerC:
+			timerC = nil
+			if launched < cfg.MaxAttempts && (cfg.Budget == nil || cfg.Budget.allowRetry()) {
+				launch()
+				launched++
+				pending++
+			}
+		case <-ctx.Done():
+			if lastErr != nil {
+				return zero, lastErr
+			}
+			return zero, ctx.Err()
+		}
+	}
+	return zero, lastErr
+}
+
+// HedgeUnaryClientInterceptor returns a grpc.UnaryClientInterceptor that
+// hedges unary calls per cfg (see Hedge), racing up to cfg.MaxAttempts
+// concurrent invocations and returning the first to succeed. Chain it via
+// grpc.WithChainUnaryInterceptor alongside retry- or trace-oriented
+// interceptors without rewriting call sites; only idempotent RPCs should be
+// dialed through it, since more than one copy may reach the server.
+func HedgeUnaryClientInterceptor(cfg HedgeConfig) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		_, err := Hedge(ctx, cfg, func(attemptCtx context.Context) (struct{}, error) {
+			return struct{}{}, invoker(attemptCtx, method, req, reply, cc, opts...)
+		})
+		return err
+	}
+}
+
+// ExecuteWithResultHedged runs fn with circuit breaker protection, hedging
+// a slow first attempt per cfg (see Hedge). Only the winning attempt's
+// outcome is recorded against the breaker, so accounting stays the same
+// shape as a plain ExecuteWithResult call.
+func ExecuteWithResultHedged[T any](ctx context.Context, b *Breaker, cfg HedgeConfig, fn func(context.Context) (T, error)) (T, error) {
+	var zero T
+	if err := b.Allow(); err != nil {
+		return zero, err
+	}
+	result, err := Hedge(ctx, cfg, fn)
+	if err == nil {
+		b.Success()
+		return result, nil
+	}
+	switch b.cfg.Classifier(err) {
+	case ClassIgnore:
+	case ClassPermanent:
+		b.FailurePermanent()
+	default:
+		b.Failure()
+	}
+	return zero, err
+}
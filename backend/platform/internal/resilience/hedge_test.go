@@ -0,0 +1,215 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHedgeReturnsFastAttemptWithoutHedging(t *testing.T) {
+	var calls atomic.Int32
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: 50 * time.Millisecond}
+
+	val, err := Hedge(context.Background(), cfg, func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		return 42, nil
+	})
+
+	if err != nil {
+		t.Fatalf("Hedge() error = %v", err)
+	}
+	if val != 42 {
+		t.Errorf("val = %d, want 42", val)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1 (should not hedge a fast success)", calls.Load())
+	}
+}
+
+func TestHedgeLaunchesSecondAttemptAfterDelay(t *testing.T) {
+	var calls atomic.Int32
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: 10 * time.Millisecond}
+
+	val, err := Hedge(context.Background(), cfg, func(ctx context.Context) (int, error) {
+		n := calls.Add(1)
+		if n == 1 {
+			// first attempt never returns before the test's own deadline
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+		return 7, nil
+	})
+
+	if err != nil {
+		t.Fatalf("Hedge() error = %v", err)
+	}
+	if val != 7 {
+		t.Errorf("val = %d, want 7 (the hedged attempt's result)", val)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("calls = %d, want 2", calls.Load())
+	}
+}
+
+func TestHedgeReturnsErrorWhenAllAttemptsFail(t *testing.T) {
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: time.Millisecond}
+	wantErr := errors.New("downstream down")
+
+	_, err := Hedge(context.Background(), cfg, func(ctx context.Context) (int, error) {
+		return 0, wantErr
+	})
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Hedge() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestHedgeRespectsMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	cfg := HedgeConfig{MaxAttempts: 1, HedgeDelay: time.Millisecond}
+
+	_, err := Hedge(context.Background(), cfg, func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		return 0, errors.New("fail")
+	})
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1 (MaxAttempts=1 disables hedging)", calls.Load())
+	}
+}
+
+func TestHedgeUsesLiveLatencyP95(t *testing.T) {
+	tracker := NewLatencyTracker(8)
+	for i := 0; i < 8; i++ {
+		tracker.Record(5 * time.Millisecond)
+	}
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: time.Hour, Latency: tracker}
+
+	var calls atomic.Int32
+	val, err := Hedge(context.Background(), cfg, func(ctx context.Context) (int, error) {
+		n := calls.Add(1)
+		if n == 1 {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+		return 1, nil
+	})
+
+	if err != nil {
+		t.Fatalf("Hedge() error = %v", err)
+	}
+	if val != 1 || calls.Load() != 2 {
+		t.Errorf("val = %d, calls = %d, want 1, 2 (should hedge using the tracker's P95, not the 1h static delay)", val, calls.Load())
+	}
+}
+
+func TestHedgeBudgetBlocksExtraAttempts(t *testing.T) {
+	budget := NewRetryBudget(0, 0)
+	for budget.allowRetry() {
+		// drain the initial capacity so the hedge budget starts empty
+	}
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: time.Millisecond, Budget: budget}
+
+	var calls atomic.Int32
+	_, err := Hedge(context.Background(), cfg, func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		return 0, errors.New("fail")
+	})
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1 (exhausted budget should block the hedge)", calls.Load())
+	}
+}
+
+func TestHedgeFailsFastOnNonHedgeableError(t *testing.T) {
+	var calls atomic.Int32
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: 50 * time.Millisecond}
+	wantErr := status.Error(codes.InvalidArgument, "bad request")
+
+	_, err := Hedge(context.Background(), cfg, func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		return 0, wantErr
+	})
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Hedge() error = %v, want %v", err, wantErr)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1 (non-hedgeable error should fail fast)", calls.Load())
+	}
+}
+
+func TestHedgeUnaryClientInterceptorRacesInvoker(t *testing.T) {
+	var calls atomic.Int32
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: 5 * time.Millisecond}
+	interceptor := HedgeUnaryClientInterceptor(cfg)
+
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		n := calls.Add(1)
+		if n == 1 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	err := interceptor(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+
+	if err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("calls = %d, want 2 (slow first invocation should have been hedged)", calls.Load())
+	}
+}
+
+func TestExecuteWithResultHedgedRecordsBreakerOutcome(t *testing.T) {
+	b := New(FastConfig())
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: time.Millisecond}
+
+	for i := 0; i < FastThreshold; i++ {
+		_, err := ExecuteWithResultHedged(context.Background(), b, cfg, func(ctx context.Context) (int, error) {
+			return 0, errors.New("down")
+		})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+
+	if b.State() != Open {
+		t.Errorf("State() = %v, want Open after repeated hedge failures", b.State())
+	}
+}
+
+func TestExecuteWithResultHedgedReturnsSuccess(t *testing.T) {
+	b := New(FastConfig())
+	cfg := HedgeConfig{MaxAttempts: 2, HedgeDelay: time.Millisecond}
+
+	val, err := ExecuteWithResultHedged(context.Background(), b, cfg, func(ctx context.Context) (string, error) {
+		return "ok", nil
+	})
+
+	if err != nil {
+		t.Fatalf("ExecuteWithResultHedged() error = %v", err)
+	}
+	if val != "ok" {
+		t.Errorf("val = %q, want %q", val, "ok")
+	}
+	if b.State() != Closed {
+		t.Errorf("State() = %v, want Closed", b.State())
+	}
+}
@@ -0,0 +1,72 @@
+// Package resilience provides fault tolerance patterns
+package resilience
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyWindow is the sample count a LatencyTracker keeps by default.
+const DefaultLatencyWindow = 256
+
+// LatencyTracker keeps a rolling window of recent call latencies and reports
+// a live P95, so Hedge can size its hedge delay to actual observed latency
+// instead of a single static duration.
+type LatencyTracker struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+	filled  bool
+}
+
+// NewLatencyTracker creates a tracker holding the last window latency
+// samples. window <= 0 falls back to DefaultLatencyWindow.
+func NewLatencyTracker(window int) *LatencyTracker {
+	if window <= 0 {
+		window = DefaultLatencyWindow
+	}
+	return &LatencyTracker{samples: make([]time.Duration, window)}
+}
+
+// Record adds a latency observation, overwriting the oldest sample once the
+// window is full.
+func (t *LatencyTracker) Record(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.samples[t.next] = d
+	t.next = (t.next + 1) % len(t.samples)
+	if t.next == 0 {
+		t.filled = true
+	}
+}
+
+// P95 returns the 95th percentile of recorded latencies, or 0 if nothing has
+// been recorded yet.
+func (t *LatencyTracker) P95() time.Duration {
+	return t.Percentile(0.95)
+}
+
+// Percentile returns the pth percentile (0 <= p <= 1) of recorded
+// latencies, or 0 if nothing has been recorded yet.
+func (t *LatencyTracker) Percentile(p float64) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := t.next
+	if t.filled {
+		n = len(t.samples)
+	}
+	if n == 0 {
+		return 0
+	}
+
+	sorted := make([]time.Duration, n)
+	copy(sorted, t.samples[:n])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(float64(n) * p)
+	if idx >= n {
+		idx = n - 1
+	}
+	return sorted[idx]
+}
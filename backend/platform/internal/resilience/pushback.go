@@ -0,0 +1,73 @@
+// Package resilience provides fault tolerance patterns
+package resilience
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterError is implemented by errors that carry an HTTP Retry-After
+// value (RFC 9110 §10.2.3), either as delay-seconds or an HTTP-date. Wrap an
+// HTTP client error with a type implementing this so Retry can honor the
+// server's requested backoff the same way it does gRPC's RetryInfo detail.
+type RetryAfterError interface {
+	error
+	RetryAfter() string
+}
+
+// extractPushback inspects err for a server-provided backoff hint: a
+// google.rpc.RetryInfo detail on a gRPC status, or a RetryAfterError's
+// Retry-After value. present reports whether a hint was found at all;
+// stopRetry reports a negative RetryInfo delay, which servers use to signal
+// "do not retry this request". When present is false, delay and stopRetry
+// are meaningless and the caller should fall back to computed backoff.
+func extractPushback(err error) (delay time.Duration, present bool, stopRetry bool) {
+	if st, ok := status.FromError(err); ok {
+		for _, detail := range st.Details() {
+			ri, ok := detail.(*errdetails.RetryInfo)
+			if !ok || ri.GetRetryDelay() == nil {
+				continue
+			}
+			d := ri.GetRetryDelay().AsDuration()
+			if d < 0 {
+				return 0, true, true
+			}
+			return d, true, false
+		}
+	}
+
+	var rae RetryAfterError
+	if errors.As(err, &rae) {
+		if d, ok := parseRetryAfter(rae.RetryAfter()); ok {
+			return d, true, false
+		}
+	}
+
+	return 0, false, false
+}
+
+// parseRetryAfter parses an HTTP Retry-After header value, either delta-seconds
+// or an HTTP-date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
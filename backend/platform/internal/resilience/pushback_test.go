@@ -0,0 +1,182 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func statusWithRetryInfo(t *testing.T, code codes.Code, delay time.Duration) error {
+	t.Helper()
+	st := status.New(code, "pushback")
+	st, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		t.Fatalf("WithDetails() error = %v", err)
+	}
+	return st.Err()
+}
+
+type retryAfterError struct {
+	header string
+}
+
+func (e *retryAfterError) Error() string      { return "rate limited" }
+func (e *retryAfterError) RetryAfter() string { return e.header }
+
+func TestExtractPushbackFromGRPCRetryInfo(t *testing.T) {
+	err := statusWithRetryInfo(t, codes.ResourceExhausted, 2*time.Second)
+
+	delay, present, stop := extractPushback(err)
+	if !present {
+		t.Fatal("expected pushback to be present")
+	}
+	if stop {
+		t.Error("expected stop = false for a positive delay")
+	}
+	if delay != 2*time.Second {
+		t.Errorf("delay = %v, want 2s", delay)
+	}
+}
+
+func TestExtractPushbackNegativeMeansStop(t *testing.T) {
+	err := statusWithRetryInfo(t, codes.ResourceExhausted, -time.Second)
+
+	_, present, stop := extractPushback(err)
+	if !present {
+		t.Fatal("expected pushback to be present")
+	}
+	if !stop {
+		t.Error("expected stop = true for a negative delay")
+	}
+}
+
+func TestExtractPushbackAbsentWhenNoDetail(t *testing.T) {
+	err := status.Error(codes.Unavailable, "plain status")
+
+	_, present, _ := extractPushback(err)
+	if present {
+		t.Error("expected no pushback for a status without RetryInfo")
+	}
+}
+
+func TestExtractPushbackFromRetryAfterSeconds(t *testing.T) {
+	err := &retryAfterError{header: "5"}
+
+	delay, present, stop := extractPushback(err)
+	if !present || stop {
+		t.Fatalf("present = %v, stop = %v, want true, false", present, stop)
+	}
+	if delay != 5*time.Second {
+		t.Errorf("delay = %v, want 5s", delay)
+	}
+}
+
+func TestExtractPushbackFromRetryAfterHTTPDate(t *testing.T) {
+	future := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	err := &retryAfterError{header: future}
+
+	delay, present, stop := extractPushback(err)
+	if !present || stop {
+		t.Fatalf("present = %v, stop = %v, want true, false", present, stop)
+	}
+	if delay <= 0 || delay > 10*time.Second {
+		t.Errorf("delay = %v, want roughly 10s", delay)
+	}
+}
+
+func TestExtractPushbackIgnoresUnparseableRetryAfter(t *testing.T) {
+	err := &retryAfterError{header: "not-a-valid-value"}
+
+	_, present, _ := extractPushback(err)
+	if present {
+		t.Error("expected no pushback for an unparseable Retry-After value")
+	}
+}
+
+func TestExtractPushbackPlainErrorAbsent(t *testing.T) {
+	_, present, _ := extractPushback(errors.New("boom"))
+	if present {
+		t.Error("expected no pushback for a plain error")
+	}
+}
+
+func TestRetryHonorsServerPushbackDelay(t *testing.T) {
+	cfg := RetryConfig{
+		MaxRetries:         3,
+		BaseDelay:          time.Millisecond,
+		MaxDelay:           time.Second,
+		RespectServerHints: true,
+	}
+	pushbackErr := statusWithRetryInfo(t, codes.Unavailable, 50*time.Millisecond)
+
+	calls := 0
+	start := time.Now()
+	err := Retry(context.Background(), cfg, func() error {
+		calls++
+		if calls < 2 {
+			return pushbackErr
+		}
+		return nil
+	})
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Errorf("Retry() = %v, want nil", err)
+	}
+	if elapsed < 50*time.Millisecond {
+		t.Errorf("elapsed = %v, want at least the 50ms pushback delay", elapsed)
+	}
+}
+
+func TestRetryFailsFastOnNegativePushback(t *testing.T) {
+	cfg := RetryConfig{
+		MaxRetries:         5,
+		BaseDelay:          time.Millisecond,
+		MaxDelay:           time.Second,
+		RespectServerHints: true,
+	}
+	pushbackErr := statusWithRetryInfo(t, codes.Unavailable, -time.Second)
+
+	calls := 0
+	err := Retry(context.Background(), cfg, func() error {
+		calls++
+		return pushbackErr
+	})
+
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Retry() = %v, want the pushback error", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 (no retries after a stop-retry pushback)", calls)
+	}
+}
+
+func TestRetryIgnoresPushbackWhenDisabled(t *testing.T) {
+	cfg := RetryConfig{
+		MaxRetries:         1,
+		BaseDelay:          time.Millisecond,
+		MaxDelay:           time.Second,
+		RespectServerHints: false,
+	}
+	pushbackErr := statusWithRetryInfo(t, codes.Unavailable, -time.Second)
+
+	calls := 0
+	err := Retry(context.Background(), cfg, func() error {
+		calls++
+		return pushbackErr
+	})
+
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Retry() = %v, want the pushback error", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2 (stop-retry hint ignored, normal retry runs)", calls)
+	}
+}
@@ -0,0 +1,99 @@
+// Package resilience provides fault tolerance patterns
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+// rateBucket holds the success/failure tally for one slice of a rateWindow.
+type rateBucket struct {
+	successes int64
+	failures  int64
+}
+
+// rateWindow is a bucketed sliding window of successes/failures spanning a
+// fixed wall-clock duration, used by a RateBased Breaker to compute a live
+// failure ratio. It divides that duration into rateWindowBuckets equal
+// buckets and rotates the oldest one out as time passes, so the reported
+// ratio always reflects only the trailing window rather than growing
+// unbounded or requiring explicit pruning.
+type rateWindow struct {
+	mu         sync.Mutex
+	buckets    []rateBucket
+	bucketSize time.Duration
+	current    int
+	boundary   time.Time // start time of the bucket at `current`
+}
+
+// newRateWindow creates a rateWindow spanning window, anchored at now.
+func newRateWindow(window time.Duration, now time.Time) *rateWindow {
+	return &rateWindow{
+		buckets:    make([]rateBucket, rateWindowBuckets),
+		bucketSize: window / rateWindowBuckets,
+		boundary:   now,
+	}
+}
+
+// advance rotates the window forward to now, zeroing any buckets that have
+// aged out of the window. Callers must hold mu.
+func (w *rateWindow) advance(now time.Time) {
+	if w.bucketSize <= 0 {
+		return
+	}
+	steps := int(now.Sub(w.boundary) / w.bucketSize)
+	if steps <= 0 {
+		return
+	}
+	if steps >= len(w.buckets) {
+		for i := range w.buckets {
+			w.buckets[i] = rateBucket{}
+		}
+		w.current = 0
+		w.boundary = now
+		return
+	}
+	for i := 0; i < steps; i++ {
+		w.current = (w.current + 1) % len(w.buckets)
+		w.buckets[w.current] = rateBucket{}
+	}
+	w.boundary = w.boundary.Add(time.Duration(steps) * w.bucketSize)
+}
+
+func (w *rateWindow) recordSuccess(now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.advance(now)
+	w.buckets[w.current].successes++
+}
+
+func (w *rateWindow) recordFailure(now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.advance(now)
+	w.buckets[w.current].failures++
+}
+
+// totals sums successes/failures across all live buckets, rotating to now
+// first so an idle window reports zero rather than stale counts.
+func (w *rateWindow) totals(now time.Time) (successes, failures int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.advance(now)
+	for _, b := range w.buckets {
+		successes += b.successes
+		failures += b.failures
+	}
+	return
+}
+
+// reset clears all buckets and re-anchors the window at now.
+func (w *rateWindow) reset(now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i := range w.buckets {
+		w.buckets[i] = rateBucket{}
+	}
+	w.current = 0
+	w.boundary = now
+}
@@ -0,0 +1,95 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateWindowTotalsAccumulate(t *testing.T) {
+	now := time.Now()
+	w := newRateWindow(time.Second, now)
+
+	w.recordSuccess(now)
+	w.recordSuccess(now)
+	w.recordFailure(now)
+
+	successes, failures := w.totals(now)
+	if successes != 2 || failures != 1 {
+		t.Errorf("totals = (%d, %d), want (2, 1)", successes, failures)
+	}
+}
+
+func TestRateWindowBucketRotation(t *testing.T) {
+	now := time.Now()
+	w := newRateWindow(100*time.Millisecond, now) // 10ms buckets
+
+	w.recordFailure(now)
+	successes, failures := w.totals(now)
+	if successes != 0 || failures != 1 {
+		t.Fatalf("totals before rotation = (%d, %d), want (0, 1)", successes, failures)
+	}
+
+	// Advance by one bucket: the failure should still be live (within window).
+	later := now.Add(10 * time.Millisecond)
+	_, failures = w.totals(later)
+	if failures != 1 {
+		t.Errorf("totals after 1 bucket = %d failures, want 1 (still within window)", failures)
+	}
+
+	// Advance past the whole window: the old failure must have rotated out.
+	muchLater := now.Add(200 * time.Millisecond)
+	successes, failures = w.totals(muchLater)
+	if successes != 0 || failures != 0 {
+		t.Errorf("totals after full window elapsed = (%d, %d), want (0, 0)", successes, failures)
+	}
+}
+
+func TestRateWindowPartialWindowEvaluation(t *testing.T) {
+	now := time.Now()
+	w := newRateWindow(100*time.Millisecond, now) // 10ms buckets
+
+	w.recordFailure(now)
+	w.recordFailure(now.Add(5 * time.Millisecond))
+
+	// Halfway through the window, only buckets touched so far should count;
+	// untouched later buckets contribute nothing, they're not "empty failures".
+	halfway := now.Add(50 * time.Millisecond)
+	successes, failures := w.totals(halfway)
+	if successes != 0 || failures != 2 {
+		t.Errorf("partial-window totals = (%d, %d), want (0, 2)", successes, failures)
+	}
+}
+
+func TestRateWindowReset(t *testing.T) {
+	now := time.Now()
+	w := newRateWindow(time.Second, now)
+	w.recordFailure(now)
+	w.recordSuccess(now)
+
+	w.reset(now)
+
+	successes, failures := w.totals(now)
+	if successes != 0 || failures != 0 {
+		t.Errorf("totals after reset = (%d, %d), want (0, 0)", successes, failures)
+	}
+}
+
+func TestRateWindowGradualRotationPreservesRecentBuckets(t *testing.T) {
+	now := time.Now()
+	w := newRateWindow(100*time.Millisecond, now) // 10ms buckets
+
+	w.recordFailure(now)
+	// Step forward one bucket at a time, adding a success each step.
+	for i := 1; i <= 5; i++ {
+		t := now.Add(time.Duration(i*10) * time.Millisecond)
+		w.recordSuccess(t)
+	}
+
+	successes, failures := w.totals(now.Add(50 * time.Millisecond))
+	if successes != 5 {
+		t.Errorf("successes = %d, want 5", successes)
+	}
+	if failures != 1 {
+		t.Errorf("failures = %d, want 1 (original failure still within the 10-bucket window)", failures)
+	}
+}
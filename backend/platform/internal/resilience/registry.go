@@ -0,0 +1,33 @@
+package resilience
+
+import "sync"
+
+// Registry lazily creates and caches one Breaker per key, typically a
+// target address, so independent backends - different LLM/inference hosts
+// behind the same gRPC client, for instance - fail independently instead of
+// sharing one breaker's trip state.
+type Registry struct {
+	cfg Config
+
+	mu       sync.Mutex
+	breakers map[string]*Breaker
+}
+
+// NewRegistry returns a Registry that builds breakers from cfg the first
+// time each key is requested.
+func NewRegistry(cfg Config) *Registry {
+	return &Registry{cfg: cfg, breakers: make(map[string]*Breaker)}
+}
+
+// Get returns the breaker for key, creating one with the registry's Config
+// on first use.
+func (r *Registry) Get(key string) *Breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.breakers[key]
+	if !ok {
+		b = New(r.cfg)
+		r.breakers[key] = b
+	}
+	return b
+}
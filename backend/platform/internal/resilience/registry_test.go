@@ -0,0 +1,31 @@
+package resilience
+
+import "testing"
+
+func TestRegistryReturnsSameBreakerForSameKey(t *testing.T) {
+	r := NewRegistry(testConfig(1))
+
+	a := r.Get("host-a:50051")
+	again := r.Get("host-a:50051")
+	if a != again {
+		t.Errorf("Get() returned different breakers for the same key")
+	}
+}
+
+func TestRegistryIsolatesKeys(t *testing.T) {
+	r := NewRegistry(testConfig(1))
+
+	a := r.Get("host-a:50051")
+	b := r.Get("host-b:50051")
+	if a == b {
+		t.Fatalf("Get() returned the same breaker for different keys")
+	}
+
+	a.Failure()
+	if a.State() != Open {
+		t.Errorf("host-a state = %v, want Open", a.State())
+	}
+	if b.State() != Closed {
+		t.Errorf("host-b state = %v, want Closed (should be unaffected by host-a's failure)", b.State())
+	}
+}
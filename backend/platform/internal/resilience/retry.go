@@ -31,27 +31,57 @@ type RetryConfig struct {
 	MaxDelay     time.Duration
 	JitterFactor float64
 	IsRetryable  func(error) bool
+
+	// Budget, if set, caps the rate of retry attempts (not calls to Retry
+	// itself) so many callers hitting the same failing downstream don't
+	// amplify load by MaxRetries on top of it. Share one Budget across all
+	// Retry calls targeting the same downstream. Nil disables the cap.
+	Budget *Budget
+
+	// RespectServerHints makes Retry honor a server-provided backoff signal
+	// on the error (a gRPC RetryInfo detail or an HTTP Retry-After), using
+	// max(hint, computedBackoff) capped by MaxDelay, or failing fast if the
+	// hint says not to retry at all. Defaults to true in DefaultRetryConfig
+	// and LLMRetryConfig; a zero-value RetryConfig literal has it disabled
+	// until set explicitly.
+	RespectServerHints bool
+
+	// Strategy, if set, supplies per-attempt delays via Strategy.Next()
+	// instead of the built-in BaseDelay/JitterFactor power-of-two schedule.
+	// Useful for sharing one gRPC-style connection-backoff curve across a
+	// client's retry paths. Nil uses the built-in schedule.
+	Strategy *Backoff
+
+	// Breaker, if set, gates each attempt through Allow() before calling fn
+	// and records the outcome via Success()/Failure() afterward, so Retry
+	// stops short-circuiting with ErrOpen instead of burning attempts against
+	// a downstream that's already tripped. Share one Breaker across Retry
+	// calls targeting the same downstream, just like Budget. Nil disables
+	// breaker integration.
+	Breaker *Breaker
 }
 
 // DefaultRetryConfig returns standard retry settings.
 func DefaultRetryConfig() RetryConfig {
 	return RetryConfig{
-		MaxRetries:   DefaultMaxRetries,
-		BaseDelay:    DefaultBaseDelay,
-		MaxDelay:     DefaultMaxDelay,
-		JitterFactor: DefaultJitterFactor,
-		IsRetryable:  IsRetryableGRPC,
+		MaxRetries:         DefaultMaxRetries,
+		BaseDelay:          DefaultBaseDelay,
+		MaxDelay:           DefaultMaxDelay,
+		JitterFactor:       DefaultJitterFactor,
+		IsRetryable:        IsRetryableGRPC,
+		RespectServerHints: true,
 	}
 }
 
 // LLMRetryConfig returns settings optimized for flaky LLM APIs.
 func LLMRetryConfig() RetryConfig {
 	return RetryConfig{
-		MaxRetries:   LLMMaxRetries,
-		BaseDelay:    LLMBaseDelay,
-		MaxDelay:     LLMMaxDelay,
-		JitterFactor: DefaultJitterFactor,
-		IsRetryable:  IsRetryableGRPC,
+		MaxRetries:         LLMMaxRetries,
+		BaseDelay:          LLMBaseDelay,
+		MaxDelay:           LLMMaxDelay,
+		JitterFactor:       DefaultJitterFactor,
+		IsRetryable:        IsRetryableGRPC,
+		RespectServerHints: true,
 	}
 }
 
@@ -73,8 +103,13 @@ func IsRetryableGRPC(err error) bool {
 }
 
 // Retry executes fn with exponential backoff. Returns last error if all retries fail.
+// If cfg.Budget is set, it is credited once per call and may cut retries
+// short once exhausted, returning the last error immediately.
 func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 	cfg = cfg.withDefaults()
+	if cfg.Budget != nil {
+		cfg.Budget.recordRequest()
+	}
 	var lastErr error
 
 	for attempt := 0; attempt <= cfg.MaxRetries; attempt++ {
@@ -82,7 +117,17 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 			return err
 		}
 
-		if lastErr = fn(); lastErr == nil {
+		if cfg.Breaker != nil {
+			if err := cfg.Breaker.Allow(); err != nil {
+				return err
+			}
+		}
+
+		lastErr = fn()
+		if cfg.Breaker != nil {
+			cfg.Breaker.record(lastErr)
+		}
+		if lastErr == nil {
 			return nil
 		}
 
@@ -90,7 +135,29 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 			return lastErr
 		}
 
-		delay := backoffDelay(cfg, attempt)
+		if cfg.Budget != nil && !cfg.Budget.allowRetry() {
+			slog.Debug("retry budget exhausted, giving up early", "attempt", attempt+1, "error", lastErr)
+			return lastErr
+		}
+
+		var delay time.Duration
+		if cfg.Strategy != nil {
+			delay = cfg.Strategy.Next()
+		} else {
+			delay = backoffDelay(cfg, attempt)
+		}
+		if cfg.RespectServerHints {
+			if hint, present, stop := extractPushback(lastErr); present {
+				if stop {
+					slog.Debug("server pushback forbids retry", "attempt", attempt+1, "error", lastErr)
+					return lastErr
+				}
+				delay = max(hint, delay)
+				if delay > cfg.MaxDelay {
+					delay = cfg.MaxDelay
+				}
+			}
+		}
 		slog.Debug("retrying after error", "attempt", attempt+1, "max", cfg.MaxRetries, "delay", delay, "error", lastErr)
 
 		select {
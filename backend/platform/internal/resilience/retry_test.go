@@ -137,6 +137,73 @@ func TestLLMRetryConfig(t *testing.T) {
 	}
 }
 
+func TestRetryUsesStrategyWhenSet(t *testing.T) {
+	strategy := NewBackoff(BackoffConfig{BaseDelay: time.Millisecond, Factor: 1, Jitter: 0, MaxDelay: time.Second})
+	cfg := RetryConfig{MaxRetries: 3, BaseDelay: time.Hour, MaxDelay: time.Hour, Strategy: strategy}
+
+	calls := 0
+	start := time.Now()
+	err := Retry(context.Background(), cfg, func() error {
+		calls++
+		if calls < 3 {
+			return status.Error(codes.Unavailable, "transient")
+		}
+		return nil
+	})
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Errorf("Retry() = %v, want nil", err)
+	}
+	// If the built-in hour-long BaseDelay schedule had been used instead of
+	// Strategy, this would never return within the test timeout.
+	if elapsed > time.Second {
+		t.Errorf("elapsed = %v, want well under 1s (Strategy should override BaseDelay)", elapsed)
+	}
+}
+
+func TestRetryShortCircuitsOnOpenBreaker(t *testing.T) {
+	cfg := testConfig(1)
+	cfg.ResetTimeout = time.Hour // prevent auto-recovery
+	b := New(cfg)
+	b.Failure() // trip it
+
+	calls := 0
+	retryCfg := RetryConfig{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond, Breaker: b}
+	err := Retry(context.Background(), retryCfg, func() error {
+		calls++
+		return nil
+	})
+
+	if !errors.Is(err, ErrOpen) {
+		t.Errorf("Retry() = %v, want ErrOpen", err)
+	}
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0 (breaker should have short-circuited before fn ran)", calls)
+	}
+}
+
+func TestRetryRecordsBreakerOutcome(t *testing.T) {
+	b := New(testConfig(5))
+	calls := 0
+	retryCfg := RetryConfig{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond, Breaker: b}
+
+	err := Retry(context.Background(), retryCfg, func() error {
+		calls++
+		if calls < 2 {
+			return status.Error(codes.Unavailable, "transient")
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Retry() = %v, want nil", err)
+	}
+	if b.State() != Closed {
+		t.Errorf("breaker state = %v, want Closed", b.State())
+	}
+}
+
 func TestBackoffDelay(t *testing.T) {
 	cfg := RetryConfig{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, JitterFactor: 0}
 
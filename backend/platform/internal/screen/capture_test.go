@@ -3,124 +3,113 @@ package screen
 import (
 	"crypto/md5"
 	"os"
-	"path/filepath"
 	"testing"
 )
 
-func TestNewCapturer(t *testing.T) {
-	c := NewCapturer()
-	if c == nil {
-		t.Fatal("NewCapturer returned nil")
-	}
-	if c.tempDir == "" {
-		t.Error("tempDir should be set")
-	}
-	defer c.Close()
+// fakeBackend is a test double implementing backend (and optionally multiBackend).
+type fakeBackend struct {
+	frames  [][]byte
+	idx     int
+	cleaned bool
+}
 
-	// Check temp dir exists
-	if _, err := os.Stat(c.tempDir); os.IsNotExist(err) {
-		t.Error("temp directory should exist")
+func (f *fakeBackend) captureRaw() []byte {
+	if f.idx >= len(f.frames) {
+		return f.frames[len(f.frames)-1]
 	}
+	data := f.frames[f.idx]
+	f.idx++
+	return data
 }
 
-func TestCapturerClose(t *testing.T) {
-	c := NewCapturer()
-	tempDir := c.tempDir
+func (f *fakeBackend) cleanup() { f.cleaned = true }
 
-	c.Close()
-
-	// Temp dir should be removed
-	if _, err := os.Stat(tempDir); !os.IsNotExist(err) {
-		t.Error("temp directory should be removed after Close")
-	}
+type fakeMultiBackend struct {
+	fakeBackend
+	displays [][]byte
 }
 
-func TestCapturerChangeDetection(t *testing.T) {
-	c := NewCapturer()
-	defer c.Close()
-
-	// Set a known hash
-	testData := []byte("test image data")
-	c.lastHash = md5.Sum(testData[:min(len(testData), 4096)])
+func (f *fakeMultiBackend) captureAllRaw() [][]byte { return f.displays }
 
-	// Same hash should indicate no change
-	sameHash := md5.Sum(testData[:min(len(testData), 4096)])
-	if sameHash != c.lastHash {
-		t.Error("same data should produce same hash")
-	}
+func TestBaseCapturerChangeDetection(t *testing.T) {
+	b := &fakeBackend{frames: [][]byte{[]byte("frame-one"), []byte("frame-one"), []byte("frame-two")}}
+	c := newBase(b, "")
 
-	// Different data should produce different hash
-	differentData := []byte("different image data")
-	differentHash := md5.Sum(differentData[:min(len(differentData), 4096)])
-	if differentHash == c.lastHash {
-		t.Error("different data should produce different hash")
+	data, changed := c.Capture()
+	if !changed || string(data) != "frame-one" {
+		t.Fatalf("first capture = (%q, %v), want (frame-one, true)", data, changed)
 	}
-}
 
-func TestMin(t *testing.T) {
-	tests := []struct {
-		a, b     int
-		expected int
-	}{
-		{1, 2, 1},
-		{2, 1, 1},
-		{5, 5, 5},
-		{0, 100, 0},
-		{-1, 1, -1},
+	data, changed = c.Capture()
+	if changed || data != nil {
+		t.Fatalf("repeat capture = (%q, %v), want (nil, false)", data, changed)
 	}
 
-	for _, tt := range tests {
-		result := min(tt.a, tt.b)
-		if result != tt.expected {
-			t.Errorf("min(%d, %d) = %d, want %d", tt.a, tt.b, result, tt.expected)
-		}
+	data, changed = c.Capture()
+	if !changed || string(data) != "frame-two" {
+		t.Fatalf("changed capture = (%q, %v), want (frame-two, true)", data, changed)
 	}
 }
 
-func TestCaptureScreenTempFile(t *testing.T) {
-	c := NewCapturer()
-	defer c.Close()
+func TestBaseCapturerCaptureAlways(t *testing.T) {
+	b := &fakeBackend{frames: [][]byte{[]byte("same"), []byte("same")}}
+	c := newBase(b, "")
 
-	// Verify temp file path construction
-	expectedPath := filepath.Join(c.tempDir, "screenshot.jpg")
-	if !filepath.IsAbs(expectedPath) {
-		t.Error("screenshot path should be absolute")
+	if got := c.CaptureAlways(); string(got) != "same" {
+		t.Errorf("CaptureAlways() = %q, want same", got)
+	}
+	// Even with no change, CaptureAlways should return data unconditionally.
+	if got := c.CaptureAlways(); string(got) != "same" {
+		t.Errorf("second CaptureAlways() = %q, want same", got)
 	}
 }
 
-// Integration test - only runs if screencapture is available
-func TestCaptureIntegration(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping integration test in short mode")
+func TestBaseCapturerClose(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "goodlistener-screen-test-*")
+	if err != nil {
+		t.Fatal(err)
 	}
+	b := &fakeBackend{frames: [][]byte{[]byte("x")}}
+	c := newBase(b, tmpDir)
 
-	// Check if screencapture command exists (macOS only)
-	if _, err := os.Stat("/usr/sbin/screencapture"); os.IsNotExist(err) {
-		t.Skip("screencapture not available (not macOS)")
+	c.Close()
+
+	if !b.cleaned {
+		t.Error("Close() should call backend.cleanup()")
+	}
+	if _, err := os.Stat(tmpDir); !os.IsNotExist(err) {
+		t.Error("temp directory should be removed after Close")
 	}
+}
 
-	c := NewCapturer()
-	defer c.Close()
+func TestBaseCapturerMultiDisplayFallback(t *testing.T) {
+	b := &fakeBackend{frames: [][]byte{[]byte("only-display")}}
+	c := newBase(b, "")
 
-	// First capture
-	data1, changed1 := c.Capture()
-	if data1 == nil {
-		t.Log("First capture returned nil (may be permission issue)")
-		return
-	}
-	if !changed1 {
-		t.Error("first capture should indicate change")
+	frames := c.MultiDisplay()
+	if len(frames) != 1 || string(frames[0]) != "only-display" {
+		t.Errorf("MultiDisplay() = %v, want single-element fallback", frames)
 	}
+}
+
+func TestBaseCapturerMultiDisplayDelegates(t *testing.T) {
+	mb := &fakeMultiBackend{displays: [][]byte{[]byte("display-0"), []byte("display-1")}}
+	c := newBase(mb, "")
 
-	// Second immediate capture should show no change (screen likely same)
-	data2, changed2 := c.Capture()
-	if data2 != nil && changed2 {
-		t.Log("Screen changed between captures (possible but unexpected)")
+	frames := c.MultiDisplay()
+	if len(frames) != 2 {
+		t.Fatalf("MultiDisplay() returned %d frames, want 2", len(frames))
+	}
+	if string(frames[0]) != "display-0" || string(frames[1]) != "display-1" {
+		t.Errorf("MultiDisplay() = %v, want per-display frames", frames)
 	}
+}
 
-	// CaptureAlways should always return data
-	data3 := c.CaptureAlways()
-	if data3 == nil {
-		t.Log("CaptureAlways returned nil (may be permission issue)")
+func TestHashStability(t *testing.T) {
+	data := []byte("test image data")
+	h1 := md5.Sum(data[:min(len(data), 4096)])
+	h2 := md5.Sum(data[:min(len(data), 4096)])
+	if h1 != h2 {
+		t.Error("same data should produce same hash")
 	}
 }
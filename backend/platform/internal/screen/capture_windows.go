@@ -3,26 +3,158 @@
 package screen
 
 import (
+	"errors"
+	"fmt"
+	"image"
 	"log/slog"
 	"os"
+	"sync"
+
+	"github.com/kbinani/screenshot"
+	"github.com/kirides/screencapture/d3d"
 )
 
-type windowsBackend struct{ tempDir string }
+// ForceGDIEnvVar forces windowsBackend to skip DXGI entirely and always use
+// the GDI BitBlt path, even on a machine where desktop duplication would
+// otherwise work. Set it to exercise the GDI fallback outside of an RDP
+// session, e.g. in CI.
+const ForceGDIEnvVar = "GOODLISTENER_SCREEN_FORCE_GDI"
+
+// dxgiFrameTimeoutMs bounds how long AcquireNextFrame waits for a new frame
+// before captureFrameLocked falls back to GDI for that capture.
+const dxgiFrameTimeoutMs = 500
+
+// windowsBackend captures via the DXGI Desktop Duplication API, which is
+// fast and GPU-resident, falling back to a GDI BitBlt grab (via
+// kbinani/screenshot) when DXGI is unavailable — most commonly an RDP
+// session, where no WDDM adapter is exposed to the remote desktop.
+type windowsBackend struct {
+	mu sync.Mutex
+
+	device    *d3d.ID3D11Device
+	deviceCtx *d3d.ID3D11DeviceContext
+	dups      map[int]*d3d.OutputDuplicator // per-display, created lazily
+}
+
+func newWindowsBackend() *windowsBackend {
+	w := &windowsBackend{dups: make(map[int]*d3d.OutputDuplicator)}
+	if os.Getenv(ForceGDIEnvVar) != "" {
+		slog.Info("GOODLISTENER_SCREEN_FORCE_GDI set, skipping DXGI desktop duplication")
+		return w
+	}
+	device, deviceCtx, err := d3d.NewD3D11Device()
+	if err != nil {
+		slog.Warn("DXGI desktop duplication unavailable, falling back to GDI BitBlt", "error", err)
+		return w
+	}
+	w.device, w.deviceCtx = device, deviceCtx
+	return w
+}
 
 func (w *windowsBackend) captureRaw() []byte {
-	// TODO: Implement using Windows GDI or DXGI
-	slog.Warn("Windows screen capture not yet implemented")
-	return nil
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	img, err := w.captureFrameLocked(0)
+	if err != nil {
+		slog.Error("screen capture failed", "error", err)
+		return nil
+	}
+	return encodeJPEG(img)
 }
 
-func (w *windowsBackend) cleanup() {}
+func (w *windowsBackend) captureAllRaw() [][]byte {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	n := screenshot.NumActiveDisplays()
+	frames := make([][]byte, 0, n)
+	for i := 0; i < n; i++ {
+		img, err := w.captureFrameLocked(i)
+		if err != nil {
+			slog.Error("per-display capture failed", "display", i, "error", err)
+			continue
+		}
+		if data := encodeJPEG(img); data != nil {
+			frames = append(frames, data)
+		}
+	}
+	return frames
+}
+
+// captureFrameLocked grabs one frame for the given display index, preferring
+// DXGI and transparently falling back to GDI if duplication was never
+// available or starts failing mid-session (e.g. the user starts an RDP
+// session on a machine that was capturing locally). Caller must hold w.mu.
+func (w *windowsBackend) captureFrameLocked(display int) (image.Image, error) {
+	if w.device != nil {
+		if img, err := w.dxgiFrame(display); err == nil {
+			return img, nil
+		} else {
+			if errors.Is(err, d3d.DXGI_ERROR_ACCESS_LOST) {
+				// The desktop surface was lost, e.g. a mode change, a UAC
+				// prompt on the secure desktop, or the session switching to
+				// RDP mid-capture. The duplicator is now unusable; drop it so
+				// the next call recreates it from scratch.
+				slog.Warn("DXGI access lost, recreating output duplication", "display", display, "error", err)
+			} else {
+				slog.Warn("DXGI frame acquisition failed, switching to GDI for this frame", "display", display, "error", err)
+			}
+			w.dropDuplicator(display)
+		}
+	}
+	return captureGDI(display)
+}
+
+func (w *windowsBackend) dxgiFrame(display int) (image.Image, error) {
+	dup, ok := w.dups[display]
+	if !ok {
+		var err error
+		dup, err = d3d.NewIDXGIOutputDuplication(w.device, w.deviceCtx, uint(display))
+		if err != nil {
+			return nil, fmt.Errorf("creating output duplication for display %d: %w", display, err)
+		}
+		w.dups[display] = dup
+	}
+	bounds := screenshot.GetDisplayBounds(display)
+	img := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	if err := dup.GetImage(img, dxgiFrameTimeoutMs); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+func (w *windowsBackend) dropDuplicator(display int) {
+	if dup, ok := w.dups[display]; ok {
+		dup.Release()
+		delete(w.dups, display)
+	}
+}
+
+func (w *windowsBackend) cleanup() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for display := range w.dups {
+		w.dropDuplicator(display)
+	}
+	if w.deviceCtx != nil {
+		w.deviceCtx.Release()
+	}
+	if w.device != nil {
+		w.device.Release()
+	}
+}
+
+// captureGDI grabs a frame with the classic BitBlt-into-a-compatible-DC path,
+// which works over RDP where DXGI's WDDM-backed duplication API does not.
+func captureGDI(display int) (image.Image, error) {
+	return screenshot.CaptureDisplay(display)
+}
 
-// New creates a platform-specific screen capturer
+// New creates a platform-specific screen capturer.
 func New() Capturer {
 	tmpDir, err := os.MkdirTemp("", "goodlistener-screen-*")
 	if err != nil {
 		slog.Error("failed to create temp dir", "error", err)
 		tmpDir = os.TempDir()
 	}
-	return newBase(&windowsBackend{tempDir: tmpDir}, tmpDir)
+	return newBase(newWindowsBackend(), tmpDir)
 }
@@ -2,10 +2,28 @@
 package screen
 
 import (
+	"bytes"
 	"crypto/md5"
+	"image"
+	"image/jpeg"
+	"log/slog"
 	"os"
 )
 
+// jpegQuality is used when backends encode captured frames in-process.
+const jpegQuality = 85
+
+// encodeJPEG is a shared helper for backends that decode frames via the
+// standard image package before handing bytes off to Capture's hash check.
+func encodeJPEG(img image.Image) []byte {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		slog.Error("JPEG encode failed", "error", err)
+		return nil
+	}
+	return buf.Bytes()
+}
+
 // Capturer captures screenshots with change detection
 type Capturer interface {
 	Capture() ([]byte, bool)
@@ -13,12 +31,26 @@ type Capturer interface {
 	Close()
 }
 
+// MultiDisplayCapturer is implemented by backends that can enumerate and
+// capture each connected display independently. X11, DXGI, and macOS
+// screencapture all expose per-output handles natively; callers that don't
+// need it can type-assert Capturer to this interface.
+type MultiDisplayCapturer interface {
+	// MultiDisplay returns one JPEG-encoded frame per connected display.
+	MultiDisplay() [][]byte
+}
+
 // backend implements platform-specific raw capture
 type backend interface {
 	captureRaw() []byte
 	cleanup()
 }
 
+// multiBackend is implemented by backends that can capture per-display.
+type multiBackend interface {
+	captureAllRaw() [][]byte
+}
+
 // baseCapturer provides shared hash-based change detection
 type baseCapturer struct {
 	backend
@@ -51,6 +83,19 @@ func (c *baseCapturer) CaptureAlways() []byte {
 	return data
 }
 
+// MultiDisplay captures one frame per display if the underlying backend
+// supports it, otherwise falls back to a single-element slice from the
+// primary-display capture.
+func (c *baseCapturer) MultiDisplay() [][]byte {
+	if mb, ok := c.backend.(multiBackend); ok {
+		return mb.captureAllRaw()
+	}
+	if data := c.captureRaw(); data != nil {
+		return [][]byte{data}
+	}
+	return nil
+}
+
 func (c *baseCapturer) Close() {
 	c.cleanup()
 	if c.tempDir != "" {
@@ -13,4 +13,9 @@ const (
 	IPRateLimitWindow          = time.Second      // Sliding window duration
 	IPRateLimitCleanupInterval = 5 * time.Minute  // How often to purge stale IP entries
 	IPRateLimitEntryTTL        = 10 * time.Minute // TTL for inactive IP entries
+
+	// SSE/WebSocket pub/sub hub
+	SSESubscriberBufferSize = 64               // Events a slow subscriber may queue before being dropped
+	SSERingBufferSize       = 256              // Events retained per topic for Last-Event-ID replay
+	SSEHeartbeatInterval    = 15 * time.Second // Keep-alive comment interval for idle SSE connections
 )
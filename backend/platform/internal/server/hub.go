@@ -0,0 +1,147 @@
+package server
+
+import "sync"
+
+// topic names one of the event streams the hub fans out. The string value
+// doubles as the SSE `event:` field name.
+type topic string
+
+const (
+	topicTranscript topic = "transcript"
+	topicVAD        topic = "vad"
+	topicAutoAnswer topic = "auto_answer"
+)
+
+// hubEvent is one published message, tagged with the topic it belongs to
+// and a per-topic sequence ID so SSE clients can resume after a reconnect.
+type hubEvent struct {
+	Topic topic
+	ID    uint64
+	Data  any
+}
+
+// subscriber receives events for a fixed set of topics. Its channel is
+// bounded so a slow consumer can't block the publisher; see hub.publish.
+type subscriber struct {
+	topics map[topic]struct{}
+	ch     chan hubEvent
+	closed bool // guarded by hub.mu; set once ch has been closed
+}
+
+// hub is a small in-process pub/sub broker that both the WebSocket and SSE
+// handlers subscribe to, replacing the broadcast goroutines' direct
+// per-connection writes. Each topic keeps a bounded replay ring so a
+// reconnecting SSE client can catch up on what it missed.
+type hub struct {
+	mu     sync.Mutex
+	subs   map[*subscriber]struct{}
+	ring   map[topic][]hubEvent
+	nextID map[topic]uint64
+}
+
+func newHub() *hub {
+	return &hub{
+		subs:   make(map[*subscriber]struct{}),
+		ring:   make(map[topic][]hubEvent),
+		nextID: make(map[topic]uint64),
+	}
+}
+
+// subscribe registers a new subscriber for topics with no replay backlog.
+func (h *hub) subscribe(topics ...topic) *subscriber {
+	sub, _ := h.subscribeWithReplay(nil, topics...)
+	return sub
+}
+
+// subscribeWithReplay registers a new subscriber for topics and, under the
+// same lock, returns every retained event per topic with ID greater than
+// afterID[topic] (0 if absent). Doing both under one lock guarantees the
+// replay plus the subscriber's channel cover every event exactly once, with
+// no gap or duplicate around the registration instant.
+func (h *hub) subscribeWithReplay(afterID map[topic]uint64, topics ...topic) (*subscriber, []hubEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &subscriber{
+		topics: make(map[topic]struct{}, len(topics)),
+		ch:     make(chan hubEvent, SSESubscriberBufferSize),
+	}
+	for _, t := range topics {
+		sub.topics[t] = struct{}{}
+	}
+	h.subs[sub] = struct{}{}
+
+	var replay []hubEvent
+	for _, t := range topics {
+		after := uint64(0)
+		if afterID != nil {
+			after = afterID[t]
+		}
+		for _, evt := range h.ring[t] {
+			if evt.ID > after {
+				replay = append(replay, evt)
+			}
+		}
+	}
+	return sub, replay
+}
+
+// unsubscribe removes sub from the hub. Safe to call more than once.
+func (h *hub) unsubscribe(sub *subscriber) {
+	h.mu.Lock()
+	delete(h.subs, sub)
+	h.mu.Unlock()
+}
+
+// closeStaleSubscriber removes sub from the hub and closes its channel,
+// guarding the close with sub.closed under h.mu so a subscriber registered
+// for more than one topic - which every /ws connection and the
+// multiplexed /events SSE handler are - can't be closed twice when two
+// publish calls on different topics mark it stale concurrently.
+func (h *hub) closeStaleSubscriber(sub *subscriber) {
+	h.mu.Lock()
+	delete(h.subs, sub)
+	alreadyClosed := sub.closed
+	sub.closed = true
+	h.mu.Unlock()
+
+	if !alreadyClosed {
+		close(sub.ch)
+	}
+}
+
+// publish assigns data the next sequence ID for topic, appends it to that
+// topic's replay ring, and fans it out to every subscriber of topic. A
+// subscriber whose buffer is full is dropped (its channel closed) rather
+// than allowed to block publish — backpressure falls on the slow client,
+// never on the hub.
+func (h *hub) publish(t topic, data any) {
+	h.mu.Lock()
+
+	id := h.nextID[t] + 1
+	h.nextID[t] = id
+	evt := hubEvent{Topic: t, ID: id, Data: data}
+
+	ring := append(h.ring[t], evt)
+	if len(ring) > SSERingBufferSize {
+		ring = ring[len(ring)-SSERingBufferSize:]
+	}
+	h.ring[t] = ring
+
+	var stale []*subscriber
+	for sub := range h.subs {
+		if _, ok := sub.topics[t]; !ok {
+			continue
+		}
+		select {
+		case sub.ch <- evt:
+		default:
+			stale = append(stale, sub)
+		}
+	}
+	h.mu.Unlock()
+
+	for _, sub := range stale {
+		h.closeStaleSubscriber(sub)
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestHub_PublishDeliversToSubscribedTopicsOnly(t *testing.T) {
+	h := newHub()
+	sub := h.subscribe(topicTranscript)
+	defer h.unsubscribe(sub)
+
+	h.publish(topicVAD, "ignored")
+	h.publish(topicTranscript, "hello")
+
+	select {
+	case evt := <-sub.ch:
+		if evt.Data != "hello" || evt.Topic != topicTranscript {
+			t.Fatalf("got %+v, want topic=%s data=hello", evt, topicTranscript)
+		}
+	default:
+		t.Fatal("expected a buffered event for topicTranscript")
+	}
+
+	select {
+	case evt := <-sub.ch:
+		t.Fatalf("unexpected second event %+v; topicVAD publish should not reach this subscriber", evt)
+	default:
+	}
+}
+
+func TestHub_SubscribeWithReplayReturnsRetainedEvents(t *testing.T) {
+	h := newHub()
+	h.publish(topicTranscript, "one")
+	h.publish(topicTranscript, "two")
+	h.publish(topicTranscript, "three")
+
+	sub, replay := h.subscribeWithReplay(map[topic]uint64{topicTranscript: 1}, topicTranscript)
+	defer h.unsubscribe(sub)
+
+	if len(replay) != 2 {
+		t.Fatalf("replay = %v, want 2 events after ID 1", replay)
+	}
+	if replay[0].Data != "two" || replay[1].Data != "three" {
+		t.Errorf("replay = %+v, want [two three]", replay)
+	}
+}
+
+func TestHub_DropsSlowSubscriberInsteadOfBlocking(t *testing.T) {
+	h := newHub()
+	sub := h.subscribe(topicTranscript)
+
+	for i := 0; i < SSESubscriberBufferSize+1; i++ {
+		h.publish(topicTranscript, i)
+	}
+
+	h.mu.Lock()
+	_, stillSubscribed := h.subs[sub]
+	h.mu.Unlock()
+	if stillSubscribed {
+		t.Fatal("expected the overflowed subscriber to have been dropped")
+	}
+
+	drained := 0
+	for range sub.ch {
+		drained++
+	}
+	if drained != SSESubscriberBufferSize {
+		t.Errorf("drained %d buffered events, want %d", drained, SSESubscriberBufferSize)
+	}
+}
+
+// TestHub_MultiTopicSubscriberOverflowDoesNotDoubleClose reproduces a
+// subscriber registered for more than one topic (every /ws connection and
+// the multiplexed /events SSE handler) overflowing on two topics at once,
+// as would happen with two independent publish goroutines racing. Closing
+// the same channel twice panics, so this must not panic.
+func TestHub_MultiTopicSubscriberOverflowDoesNotDoubleClose(t *testing.T) {
+	h := newHub()
+	sub := h.subscribe(topicTranscript, topicVAD)
+
+	var wg sync.WaitGroup
+	for _, tpc := range []topic{topicTranscript, topicVAD} {
+		wg.Add(1)
+		go func(tpc topic) {
+			defer wg.Done()
+			for i := 0; i < SSESubscriberBufferSize+1; i++ {
+				h.publish(tpc, i)
+			}
+		}(tpc)
+	}
+	wg.Wait()
+
+	h.mu.Lock()
+	_, stillSubscribed := h.subs[sub]
+	h.mu.Unlock()
+	if stillSubscribed {
+		t.Fatal("expected the overflowed subscriber to have been dropped")
+	}
+}
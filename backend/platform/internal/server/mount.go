@@ -0,0 +1,94 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/transcript"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
+)
+
+// mountContentType maps a transcript.Format to the Content-Type its HTTP
+// endpoint serves.
+var mountContentType = map[transcript.Format]string{
+	transcript.FormatVTT:   "text/vtt",
+	transcript.FormatSRT:   "application/x-subrip",
+	transcript.FormatJSONL: "application/x-ndjson",
+}
+
+// handleTranscriptMount returns a handler streaming the transcript broker's
+// output in format with chunked transfer encoding. A client resumes after a
+// disconnect with ?since=<id>, which maps to a transcript.ReplayWindow keyed
+// by entry ID -- the coherent resume unit for an unbounded live text stream,
+// where a byte Range (which this handler does not attempt to honor) has no
+// stable meaning. Omitting since starts a live-only tail with no backlog.
+func (s *Server) handleTranscriptMount(format transcript.Format) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientIP := extractIP(r)
+		if !s.ipRateLimit.allow(clientIP) {
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		var window transcript.ReplayWindow
+		if since := r.URL.Query().Get("since"); since != "" {
+			id, err := strconv.ParseInt(since, 10, 64)
+			if err != nil {
+				http.Error(w, "invalid since", http.StatusBadRequest)
+				return
+			}
+			window.SinceID = id
+		}
+
+		broker := s.orch.TranscriptBroker()
+		mount, replay := broker.Subscribe(format, window)
+		defer broker.Unsubscribe(mount)
+
+		log := trace.Logger(r.Context())
+		log.Info("transcript mount connected", "remote", r.RemoteAddr, "client_ip", clientIP, "format", format)
+
+		w.Header().Set("Content-Type", mountContentType[format])
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+
+		if format == transcript.FormatVTT {
+			fmt.Fprint(w, "WEBVTT\n\n")
+		}
+
+		for _, data := range replay {
+			if _, err := w.Write(data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+
+		heartbeat := time.NewTicker(SSEHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case data, ok := <-mount.C():
+				if !ok {
+					return
+				}
+				if _, err := w.Write(data); err != nil {
+					return
+				}
+				flusher.Flush()
+			case <-heartbeat.C:
+				flusher.Flush()
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
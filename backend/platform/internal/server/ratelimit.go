@@ -0,0 +1,90 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ipEntry tracks one client IP's sliding-window request count.
+type ipEntry struct {
+	count     int
+	windowEnd time.Time
+	lastSeen  time.Time
+}
+
+// ipRateLimiter applies a simple fixed-window request cap per client IP,
+// shared across every connection from that IP (WebSocket and SSE alike) so
+// a client can't bypass the limit by opening more connections.
+type ipRateLimiter struct {
+	mu     sync.Mutex
+	window time.Duration
+	limit  int
+	ips    map[string]*ipEntry
+}
+
+// newIPRateLimiter returns a limiter allowing at most limit calls to allow
+// per IP per window. A background goroutine purges IPs that have gone
+// quiet for IPRateLimitEntryTTL, checked every IPRateLimitCleanupInterval.
+func newIPRateLimiter(window time.Duration, limit int) *ipRateLimiter {
+	l := &ipRateLimiter{
+		window: window,
+		limit:  limit,
+		ips:    make(map[string]*ipEntry),
+	}
+	go l.cleanupLoop()
+	return l
+}
+
+// allow reports whether ip may make another request in the current window,
+// incrementing its count as a side effect.
+func (l *ipRateLimiter) allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	entry, ok := l.ips[ip]
+	if !ok || now.After(entry.windowEnd) {
+		entry = &ipEntry{windowEnd: now.Add(l.window)}
+		l.ips[ip] = entry
+	}
+	entry.lastSeen = now
+
+	if entry.count >= l.limit {
+		return false
+	}
+	entry.count++
+	return true
+}
+
+func (l *ipRateLimiter) cleanupLoop() {
+	ticker := time.NewTicker(IPRateLimitCleanupInterval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		l.mu.Lock()
+		for ip, entry := range l.ips {
+			if now.Sub(entry.lastSeen) > IPRateLimitEntryTTL {
+				delete(l.ips, ip)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+// extractIP returns the client IP for r, preferring the first hop in
+// X-Forwarded-For (set by a reverse proxy) and falling back to RemoteAddr.
+func extractIP(r *http.Request) string {
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		if i := strings.IndexByte(fwd, ','); i >= 0 {
+			fwd = fwd[:i]
+		}
+		return strings.TrimSpace(fwd)
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIPRateLimiter_AllowsUpToLimitPerWindow(t *testing.T) {
+	l := newIPRateLimiter(time.Minute, 3)
+
+	for i := 0; i < 3; i++ {
+		if !l.allow("1.2.3.4") {
+			t.Fatalf("request %d should be allowed", i)
+		}
+	}
+	if l.allow("1.2.3.4") {
+		t.Fatal("4th request in the same window should be rejected")
+	}
+	if !l.allow("5.6.7.8") {
+		t.Fatal("a different IP should have its own budget")
+	}
+}
+
+func TestIPRateLimiter_ResetsAfterWindowElapses(t *testing.T) {
+	l := newIPRateLimiter(10*time.Millisecond, 1)
+
+	if !l.allow("1.2.3.4") {
+		t.Fatal("first request should be allowed")
+	}
+	if l.allow("1.2.3.4") {
+		t.Fatal("second request within the window should be rejected")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if !l.allow("1.2.3.4") {
+		t.Fatal("request after the window elapsed should be allowed")
+	}
+}
+
+func TestExtractIP(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{"remote addr only", "203.0.113.5:12345", "", "203.0.113.5"},
+		{"forwarded single", "203.0.113.5:12345", "198.51.100.9", "198.51.100.9"},
+		{"forwarded chain takes first hop", "203.0.113.5:12345", "198.51.100.9, 203.0.113.5", "198.51.100.9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &http.Request{RemoteAddr: tt.remoteAddr, Header: http.Header{}}
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			if got := extractIP(r); got != tt.want {
+				t.Errorf("extractIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
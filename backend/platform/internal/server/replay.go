@@ -0,0 +1,214 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator"
+	replaymanager "github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/replay"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/transcript"
+)
+
+// TranscriptRecordEnvVar names the environment variable that, when set to
+// "1", enables transcript recording for the life of the process (see
+// NewRecordingOrchestrator). TranscriptRecordPathEnvVar optionally overrides
+// the default output path.
+const (
+	TranscriptRecordEnvVar      = "TRANSCRIPT_RECORD"
+	TranscriptRecordPathEnvVar  = "TRANSCRIPT_RECORD_PATH"
+	DefaultTranscriptRecordPath = "transcript_record.jsonl"
+)
+
+// transcriptRecord is one recorded TranscriptEvent, timestamped relative to
+// the previous record so a replay reproduces the original pacing regardless
+// of when it runs.
+type transcriptRecord struct {
+	Event    orchestrator.TranscriptEvent `json:"event"`
+	OffsetMs int64                        `json:"offset_ms"`
+}
+
+// RecordingOrchestrator wraps a sessionOrchestrator, tee-ing every
+// TranscriptEvent it emits to a JSONL file while forwarding events downstream
+// unchanged and undelayed. It's used to capture real sessions for later
+// deterministic replay in tests (see ReplayOrchestrator).
+type RecordingOrchestrator struct {
+	sessionOrchestrator
+	events chan orchestrator.TranscriptEvent
+}
+
+// NewRecordingOrchestrator opens path (creating it and any parent directory
+// if needed) and returns a RecordingOrchestrator that appends every
+// TranscriptEvent orch emits to it as it's forwarded.
+func NewRecordingOrchestrator(orch sessionOrchestrator, path string) (*RecordingOrchestrator, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening transcript record file %s: %w", path, err)
+	}
+
+	r := &RecordingOrchestrator{
+		sessionOrchestrator: orch,
+		events:              make(chan orchestrator.TranscriptEvent, 16),
+	}
+
+	go r.record(f)
+	return r, nil
+}
+
+func (r *RecordingOrchestrator) record(f *os.File) {
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	last := time.Now()
+	for evt := range r.events {
+		now := time.Now()
+		rec := transcriptRecord{Event: evt, OffsetMs: now.Sub(last).Milliseconds()}
+		last = now
+		if err := enc.Encode(rec); err != nil {
+			slog.Error("failed to write transcript record", "error", err)
+		}
+	}
+}
+
+// TranscriptEvents forwards the wrapped orchestrator's events downstream
+// un-delayed while also tee-ing each one to the record file.
+func (r *RecordingOrchestrator) TranscriptEvents() <-chan orchestrator.TranscriptEvent {
+	src := r.sessionOrchestrator.TranscriptEvents()
+	out := make(chan orchestrator.TranscriptEvent)
+	go func() {
+		defer close(out)
+		defer close(r.events)
+		for evt := range src {
+			r.events <- evt
+			out <- evt
+		}
+	}()
+	return out
+}
+
+// ReplayOrchestrator re-emits a previously recorded sequence of
+// TranscriptEvents, reproducing their original inter-event timing (scaled by
+// speed; speed > 1 replays faster). The other sessionOrchestrator methods
+// are stubbed out as no-ops since only the transcript path is recorded.
+type ReplayOrchestrator struct {
+	records []transcriptRecord
+	speed   float64
+	store   *transcript.MemoryStore // seeded from records so TranscriptBroker has real backing data
+}
+
+// LoadReplayOrchestrator reads a JSONL file written by
+// RecordingOrchestrator and returns a ReplayOrchestrator that will re-emit
+// its events on TranscriptEvents(). speed scales the original timing; pass 1
+// to replay at the original pace.
+func LoadReplayOrchestrator(path string, speed float64) (*ReplayOrchestrator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening transcript replay file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var records []transcriptRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var rec transcriptRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return nil, fmt.Errorf("decoding transcript replay record: %w", err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading transcript replay file %s: %w", path, err)
+	}
+
+	if speed <= 0 {
+		speed = 1
+	}
+
+	store := transcript.NewStore(len(records), 1)
+	for _, rec := range records {
+		store.Add(rec.Event.Text, rec.Event.Source, rec.Event.Speaker)
+	}
+	return &ReplayOrchestrator{records: records, speed: speed, store: store}, nil
+}
+
+// TranscriptEvents re-emits the recorded events in order, sleeping between
+// each by its recorded offset (scaled by speed) before sending.
+func (r *ReplayOrchestrator) TranscriptEvents() <-chan orchestrator.TranscriptEvent {
+	out := make(chan orchestrator.TranscriptEvent)
+	go func() {
+		defer close(out)
+		for _, rec := range r.records {
+			if rec.OffsetMs > 0 {
+				time.Sleep(time.Duration(float64(rec.OffsetMs)/r.speed) * time.Millisecond)
+			}
+			out <- rec.Event
+		}
+	}()
+	return out
+}
+
+// AutoAnswerEvents returns a closed channel; replayed sessions only cover
+// the transcript path.
+func (r *ReplayOrchestrator) AutoAnswerEvents() <-chan orchestrator.AutoAnswerEvent {
+	ch := make(chan orchestrator.AutoAnswerEvent)
+	close(ch)
+	return ch
+}
+
+// VADEvents returns a closed channel; replayed sessions only cover the
+// transcript path.
+func (r *ReplayOrchestrator) VADEvents() <-chan orchestrator.VADEvent {
+	ch := make(chan orchestrator.VADEvent)
+	close(ch)
+	return ch
+}
+
+// Analyze is a no-op; replayed sessions don't drive the chat/analysis path.
+func (r *ReplayOrchestrator) Analyze(_ context.Context, _ string, _ func(string)) error {
+	return nil
+}
+
+// GetLatestScreenText returns "" for a replayed session.
+func (r *ReplayOrchestrator) GetLatestScreenText() string { return "" }
+
+// SetRecording is a no-op for a replayed session.
+func (r *ReplayOrchestrator) SetRecording(bool) {}
+
+// StartBroadcast is a no-op for a replayed session.
+func (r *ReplayOrchestrator) StartBroadcast(string) error { return nil }
+
+// StopBroadcast is a no-op for a replayed session.
+func (r *ReplayOrchestrator) StopBroadcast() {}
+
+// WHIPHandler returns nil; a replayed session has no live WHIP endpoint.
+func (r *ReplayOrchestrator) WHIPHandler() http.Handler { return nil }
+
+// AddStreamSource is a no-op for a replayed session.
+func (r *ReplayOrchestrator) AddStreamSource(string) error { return nil }
+
+// RemoveStreamSource is a no-op for a replayed session.
+func (r *ReplayOrchestrator) RemoveStreamSource(string) error { return nil }
+
+// TranscriptBroker returns a broker backed by the recorded entries, so the
+// transcript.vtt/srt/jsonl endpoints work against a replayed session too.
+func (r *ReplayOrchestrator) TranscriptBroker() *transcript.Broker { return r.store.Broker() }
+
+// GetReplayManifest returns a zero Manifest; a replayed session has no
+// audio/screen segments of its own to list.
+func (r *ReplayOrchestrator) GetReplayManifest() replaymanager.Manifest {
+	return replaymanager.Manifest{}
+}
+
+// GetReplaySegment returns ""; a replayed session has no segments to serve.
+func (r *ReplayOrchestrator) GetReplaySegment(string, uint64) string { return "" }
+
+// RenameSpeaker is a no-op for a replayed session.
+func (r *ReplayOrchestrator) RenameSpeaker(string, string) error { return nil }
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator"
+)
+
+func TestRecordingOrchestrator_RoundTripsThroughReplay(t *testing.T) {
+	src := make(chan orchestrator.TranscriptEvent, 3)
+	src <- orchestrator.TranscriptEvent{Text: "hello", Source: "user"}
+	src <- orchestrator.TranscriptEvent{Text: "hi there", Source: "system"}
+	src <- orchestrator.TranscriptEvent{Text: "how can I help", Source: "system", Speaker: "assistant"}
+	close(src)
+
+	recorded := &mockOrchestrator{transcriptsCh: src}
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+
+	rec, err := NewRecordingOrchestrator(recorded, path)
+	if err != nil {
+		t.Fatalf("NewRecordingOrchestrator: %v", err)
+	}
+
+	var got []orchestrator.TranscriptEvent
+	for evt := range rec.TranscriptEvents() {
+		got = append(got, evt)
+	}
+	if len(got) != 3 {
+		t.Fatalf("forwarded %d events, want 3", len(got))
+	}
+
+	// Give the recorder goroutine time to flush the file after the channel closes.
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if replay, err := LoadReplayOrchestrator(path, 1000); err == nil && len(replay.records) == 3 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	replay, err := LoadReplayOrchestrator(path, 1000)
+	if err != nil {
+		t.Fatalf("LoadReplayOrchestrator: %v", err)
+	}
+
+	var replayed []orchestrator.TranscriptEvent
+	for evt := range replay.TranscriptEvents() {
+		replayed = append(replayed, evt)
+	}
+
+	if len(replayed) != len(got) {
+		t.Fatalf("replayed %d events, want %d", len(replayed), len(got))
+	}
+	for i := range got {
+		if replayed[i] != got[i] {
+			t.Errorf("event %d = %+v, want %+v", i, replayed[i], got[i])
+		}
+	}
+}
+
+func TestReplayOrchestrator_DrivesWebSocketHandler(t *testing.T) {
+	src := make(chan orchestrator.TranscriptEvent, 2)
+	src <- orchestrator.TranscriptEvent{Text: "first", Source: "user"}
+	src <- orchestrator.TranscriptEvent{Text: "second", Source: "system", Speaker: "assistant"}
+	close(src)
+
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	rec, err := NewRecordingOrchestrator(&mockOrchestrator{transcriptsCh: src}, path)
+	if err != nil {
+		t.Fatalf("NewRecordingOrchestrator: %v", err)
+	}
+	for range rec.TranscriptEvents() {
+	}
+
+	var replay *ReplayOrchestrator
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if r, err := LoadReplayOrchestrator(path, 1000); err == nil && len(r.records) == 2 {
+			replay = r
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if replay == nil {
+		t.Fatal("timed out waiting for recorded session to be readable")
+	}
+
+	s := &Server{
+		orch:        replay,
+		hub:         newHub(),
+		ipRateLimit: newIPRateLimiter(IPRateLimitWindow, IPRateLimitMessages),
+	}
+	go s.broadcastTranscripts()
+
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	wsURL := "ws" + srv.URL[len("http"):] + "/ws"
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, _, err := websocket.Dial(ctx, wsURL, nil)
+	if err != nil {
+		t.Fatalf("websocket.Dial: %v", err)
+	}
+	defer func() { _ = conn.Close(websocket.StatusNormalClosure, "") }()
+
+	want := []TranscriptMessage{
+		{Type: "transcript", Text: "first", Source: "user"},
+		{Type: "transcript", Text: "second", Source: "system", Speaker: "assistant"},
+	}
+
+	for i, w := range want {
+		var got TranscriptMessage
+		if err := wsjson.Read(ctx, conn, &got); err != nil {
+			t.Fatalf("frame %d: wsjson.Read: %v", i, err)
+		}
+		if got != w {
+			t.Errorf("frame %d = %+v, want %+v", i, got, w)
+		}
+	}
+}
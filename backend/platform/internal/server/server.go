@@ -6,13 +6,18 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
-	"sync"
+	"os"
+	"strconv"
 
 	"github.com/coder/websocket"
 	"github.com/coder/websocket/wsjson"
 
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/config"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/health"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/replay"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/transcript"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/profiletrigger"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
 )
 
@@ -29,9 +34,11 @@ type ChatMessage struct {
 
 type TranscriptMessage struct {
 	Type    string `json:"type"`
+	ID      int64  `json:"id"`
 	Text    string `json:"text"`
 	Source  string `json:"source"`
 	Speaker string `json:"speaker"`
+	Interim bool   `json:"interim,omitempty"`
 }
 
 type ChunkMessage struct {
@@ -74,21 +81,84 @@ type VADMessage struct {
 	Source      string  `json:"source"`
 }
 
-// Server handles HTTP and WebSocket connections.
+type RenameSpeakerMessage struct {
+	Type     string `json:"type"`
+	OldLabel string `json:"old_label"`
+	NewLabel string `json:"new_label"`
+}
+
+type RenameSpeakerAckMessage struct {
+	Type  string `json:"type"`
+	Error string `json:"error,omitempty"`
+}
+
+// profileIndexer is implemented by *profiletrigger.Trigger; kept as an
+// interface so the server package doesn't need a live trigger to build its
+// handler in tests.
+type profileIndexer interface {
+	Index() []profiletrigger.ProfileRecord
+}
+
+// sessionOrchestrator is implemented by *orchestrator.Orchestrator; kept as
+// an interface (mirroring profileIndexer) so the server package can drive
+// its WebSocket handlers against a recorded or replayed session — see
+// RecordingOrchestrator and ReplayOrchestrator — without a live inference
+// stack.
+type sessionOrchestrator interface {
+	TranscriptEvents() <-chan orchestrator.TranscriptEvent
+	AutoAnswerEvents() <-chan orchestrator.AutoAnswerEvent
+	VADEvents() <-chan orchestrator.VADEvent
+	Analyze(ctx context.Context, query string, onChunk func(string)) error
+	GetLatestScreenText() string
+	SetRecording(enabled bool)
+	StartBroadcast(url string) error
+	StopBroadcast()
+	WHIPHandler() http.Handler
+	AddStreamSource(url string) error
+	RemoveStreamSource(url string) error
+	TranscriptBroker() *transcript.Broker
+	GetReplayManifest() replay.Manifest
+	GetReplaySegment(kind string, seq uint64) string
+	RenameSpeaker(oldLabel, newLabel string) error
+}
+
+// Server handles HTTP, WebSocket, and SSE connections.
 type Server struct {
-	orch        *orchestrator.Orchestrator
-	mu          sync.RWMutex
-	conns       map[*websocket.Conn]struct{}
+	orch        sessionOrchestrator
+	hub         *hub           // fans transcript/VAD/auto-answer events out to WebSocket and SSE subscribers
 	ipRateLimit *ipRateLimiter // Global IP-based rate limiting
+	profiles    profileIndexer // nil if profiling is disabled
 }
 
-// New creates a new server.
-func New(orch *orchestrator.Orchestrator, _ *config.Config) *Server {
+// New creates a new server. profiles may be nil if continuous profiling is
+// disabled. If TranscriptRecordEnvVar is set to "1", every TranscriptEvent
+// orch emits is also persisted as JSONL (to TranscriptRecordPathEnvVar, or
+// DefaultTranscriptRecordPath) for later replay (see RecordingOrchestrator);
+// a failure to open that file is logged and recording is skipped rather
+// than failing startup.
+func New(orch *orchestrator.Orchestrator, _ *config.Config, profiles *profiletrigger.Trigger) *Server {
+	var wrapped sessionOrchestrator = orch
+	if os.Getenv(TranscriptRecordEnvVar) == "1" {
+		path := os.Getenv(TranscriptRecordPathEnvVar)
+		if path == "" {
+			path = DefaultTranscriptRecordPath
+		}
+		rec, err := NewRecordingOrchestrator(orch, path)
+		if err != nil {
+			slog.Error("failed to start transcript recording, continuing without it", "path", path, "error", err)
+		} else {
+			wrapped = rec
+		}
+	}
+
 	s := &Server{
-		orch:        orch,
-		conns:       make(map[*websocket.Conn]struct{}),
+		orch:        wrapped,
+		hub:         newHub(),
 		ipRateLimit: newIPRateLimiter(IPRateLimitWindow, IPRateLimitMessages),
 	}
+	if profiles != nil {
+		s.profiles = profiles
+	}
 
 	// Start broadcasters
 	go s.broadcastTranscripts()
@@ -105,10 +175,48 @@ func (s *Server) Handler() http.Handler {
 	// WebSocket endpoint
 	mux.HandleFunc("/ws", s.handleWebSocket)
 
+	// SSE endpoints: /events multiplexes every topic, the per-topic
+	// variants let a client subscribe to just one without the WebSocket
+	// upgrade (e.g. behind a proxy that blocks it, or plain curl/EventSource).
+	mux.HandleFunc("GET /events", s.handleSSE(topicTranscript, topicVAD, topicAutoAnswer))
+	mux.HandleFunc("GET /events/transcripts", s.handleSSE(topicTranscript))
+	mux.HandleFunc("GET /events/vad", s.handleSSE(topicVAD))
+	mux.HandleFunc("GET /events/auto_answers", s.handleSSE(topicAutoAnswer))
+
+	// WHIP ingest: nil when WHIP is disabled, in which case POST /whip 404s
+	// (no route registered) rather than every server needing a check.
+	if h := s.orch.WHIPHandler(); h != nil {
+		mux.Handle("POST /whip", h)
+		mux.Handle("DELETE /whip/{id}", h)
+	}
+
 	// REST API
 	mux.HandleFunc("GET /api/capture", s.handleCapture)
 	mux.HandleFunc("POST /api/recording/start", s.handleRecordingStart)
 	mux.HandleFunc("POST /api/recording/stop", s.handleRecordingStop)
+	mux.HandleFunc("POST /broadcast/start", s.handleBroadcastStart)
+	mux.HandleFunc("POST /broadcast/stop", s.handleBroadcastStop)
+	mux.HandleFunc("POST /api/sources/stream", s.handleStreamSourceAdd)
+	mux.HandleFunc("DELETE /api/sources/stream", s.handleStreamSourceRemove)
+
+	// Transcript mounts: format-specific, resumable tails for integrators
+	// (captioning overlays, log pipelines, summarizers) that don't want the
+	// WebSocket protocol.
+	mux.HandleFunc("GET /transcript.vtt", s.handleTranscriptMount(transcript.FormatVTT))
+	mux.HandleFunc("GET /transcript.srt", s.handleTranscriptMount(transcript.FormatSRT))
+	mux.HandleFunc("GET /transcript.jsonl", s.handleTranscriptMount(transcript.FormatJSONL))
+
+	// Replay: rolling HLS-style manifest of recently captured audio/screen,
+	// so a client can scrub back through a session without its own recorder.
+	mux.HandleFunc("GET /replay/manifest", s.handleReplayManifest)
+	mux.HandleFunc("GET /replay/segments/{kind}/{seq}", s.handleReplaySegment)
+
+	// Admin/debug
+	mux.HandleFunc("GET /debug/profiles", s.handleDebugProfiles)
+
+	// Liveness/readiness, for orchestrators and load balancers
+	mux.HandleFunc("GET /healthz", health.LivezHandler)
+	mux.HandleFunc("GET /readyz", health.ReadyzHandler(health.Default()))
 
 	// Apply middleware: trace -> CORS
 	return corsMiddleware(trace.Middleware(mux))
@@ -141,21 +249,18 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 	}
 	defer func() { _ = conn.Close(websocket.StatusNormalClosure, "") }()
 
-	s.mu.Lock()
-	s.conns[conn] = struct{}{}
-	s.mu.Unlock()
-
-	defer func() {
-		s.mu.Lock()
-		delete(s.conns, conn)
-		s.mu.Unlock()
-	}()
-
 	// Get trace context from HTTP upgrade request
 	baseCtx := r.Context()
 	log := trace.Logger(baseCtx)
 	log.Info("websocket connected", "remote", r.RemoteAddr, "client_ip", clientIP)
 
+	sub := s.hub.subscribe(topicTranscript, topicVAD, topicAutoAnswer)
+	defer s.hub.unsubscribe(sub)
+
+	done := make(chan struct{})
+	defer close(done)
+	go pumpWebSocket(conn, sub, done)
+
 	for {
 		var msg json.RawMessage
 		if err := wsjson.Read(baseCtx, conn, &msg); err != nil {
@@ -197,10 +302,27 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 				ctx, _ = trace.EnsureContext(ctx)
 			}
 			s.handleChat(ctx, conn, chat.Message)
+		case "rename_speaker":
+			var rename RenameSpeakerMessage
+			if err := json.Unmarshal(msg, &rename); err != nil {
+				continue
+			}
+			s.handleRenameSpeaker(baseCtx, conn, rename.OldLabel, rename.NewLabel)
 		}
 	}
 }
 
+// handleRenameSpeaker relabels a diarized speaker and acknowledges the
+// result back to the requesting connection; it doesn't broadcast to other
+// subscribers, since the rename only affects labels assigned from here on.
+func (s *Server) handleRenameSpeaker(ctx context.Context, conn *websocket.Conn, oldLabel, newLabel string) {
+	ack := RenameSpeakerAckMessage{Type: "rename_speaker_ack"}
+	if err := s.orch.RenameSpeaker(oldLabel, newLabel); err != nil {
+		ack.Error = err.Error()
+	}
+	_ = wsjson.Write(ctx, conn, ack)
+}
+
 func (s *Server) handleChat(ctx context.Context, conn *websocket.Conn, query string) {
 	ctx, span := trace.StartSpan(ctx, "handle_chat")
 	defer span.End()
@@ -226,29 +348,40 @@ func (s *Server) handleChat(ctx context.Context, conn *websocket.Conn, query str
 	_ = wsjson.Write(ctx, conn, DoneMessage{Type: "done"})
 }
 
+// pumpWebSocket forwards hub events delivered to sub to conn until done is
+// closed or sub's channel is closed (the subscriber was dropped for being
+// too slow). Writes run on their own goroutine so a broadcast never waits
+// on the connection's read loop.
+func pumpWebSocket(conn *websocket.Conn, sub *subscriber, done <-chan struct{}) {
+	for {
+		select {
+		case evt, ok := <-sub.ch:
+			if !ok {
+				return
+			}
+			_ = wsjson.Write(context.Background(), conn, evt.Data)
+		case <-done:
+			return
+		}
+	}
+}
+
 func (s *Server) broadcastTranscripts() {
 	for evt := range s.orch.TranscriptEvents() {
-		msg := TranscriptMessage{
+		s.hub.publish(topicTranscript, TranscriptMessage{
 			Type:    "transcript",
+			ID:      evt.ID,
 			Text:    evt.Text,
 			Source:  evt.Source,
 			Speaker: evt.Speaker,
-		}
-
-		s.mu.RLock()
-		for conn := range s.conns {
-			go func(c *websocket.Conn) {
-				ctx := context.Background()
-				_ = wsjson.Write(ctx, c, msg)
-			}(conn)
-		}
-		s.mu.RUnlock()
+			Interim: evt.Interim,
+		})
 	}
 }
 
 func (s *Server) broadcastAutoAnswers() {
 	for evt := range s.orch.AutoAnswerEvents() {
-		var msg interface{}
+		var msg any
 		switch evt.Type {
 		case "start":
 			msg = AutoStartMessage{Type: "auto_start", Question: evt.Question}
@@ -259,33 +392,18 @@ func (s *Server) broadcastAutoAnswers() {
 		default:
 			continue
 		}
-
-		s.mu.RLock()
-		for conn := range s.conns {
-			go func(c *websocket.Conn, m interface{}) {
-				_ = wsjson.Write(context.Background(), c, m)
-			}(conn, msg)
-		}
-		s.mu.RUnlock()
+		s.hub.publish(topicAutoAnswer, msg)
 	}
 }
 
 func (s *Server) broadcastVAD() {
 	for evt := range s.orch.VADEvents() {
-		msg := VADMessage{
+		s.hub.publish(topicVAD, VADMessage{
 			Type:        "vad",
 			Probability: evt.Probability,
 			IsSpeech:    evt.IsSpeech,
 			Source:      evt.Source,
-		}
-
-		s.mu.RLock()
-		for conn := range s.conns {
-			go func(c *websocket.Conn, m VADMessage) {
-				_ = wsjson.Write(context.Background(), c, m)
-			}(conn, msg)
-		}
-		s.mu.RUnlock()
+		})
 	}
 }
 
@@ -310,3 +428,104 @@ func (s *Server) handleRecordingStop(w http.ResponseWriter, r *http.Request) {
 	s.orch.SetRecording(false)
 	json.NewEncoder(w).Encode(map[string]string{"status": "recording_stopped"})
 }
+
+type broadcastStartRequest struct {
+	URL string `json:"url"`
+}
+
+func (s *Server) handleBroadcastStart(w http.ResponseWriter, r *http.Request) {
+	var req broadcastStartRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.URL == "" {
+		http.Error(w, "missing url", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.orch.StartBroadcast(req.URL); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"status": "broadcast_started"})
+}
+
+func (s *Server) handleBroadcastStop(w http.ResponseWriter, r *http.Request) {
+	s.orch.StopBroadcast()
+	json.NewEncoder(w).Encode(map[string]string{"status": "broadcast_stopped"})
+}
+
+type streamSourceRequest struct {
+	URL string `json:"url"`
+}
+
+// handleStreamSourceAdd connects an ICY/Shoutcast stream (e.g. an internet
+// radio station) as an additional audio source to transcribe.
+func (s *Server) handleStreamSourceAdd(w http.ResponseWriter, r *http.Request) {
+	var req streamSourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.URL == "" {
+		http.Error(w, "missing url", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.orch.AddStreamSource(req.URL); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"status": "stream_source_added"})
+}
+
+// handleStreamSourceRemove disconnects a stream source added earlier via
+// handleStreamSourceAdd.
+func (s *Server) handleStreamSourceRemove(w http.ResponseWriter, r *http.Request) {
+	var req streamSourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.URL == "" {
+		http.Error(w, "missing url", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.orch.RemoveStreamSource(req.URL); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"status": "stream_source_removed"})
+}
+
+// handleReplayManifest returns the current rolling manifest of captured
+// audio/screen segments and captions as JSON, or an all-empty manifest if
+// replay is disabled (cfg.Replay.Enabled is false).
+func (s *Server) handleReplayManifest(w http.ResponseWriter, r *http.Request) {
+	manifest := s.orch.GetReplayManifest()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"audio_playlist":  manifest.AudioPlaylist,
+		"screen_playlist": manifest.ScreenPlaylist,
+		"captions_vtt":    manifest.Captions,
+	})
+}
+
+// handleReplaySegment serves one segment file (an Opus audio segment or a
+// JPEG screen frame) named in a playlist returned by handleReplayManifest.
+func (s *Server) handleReplaySegment(w http.ResponseWriter, r *http.Request) {
+	kind := r.PathValue("kind")
+	seq, err := strconv.ParseUint(r.PathValue("seq"), 10, 64)
+	if err != nil || (kind != "audio" && kind != "screen") {
+		http.Error(w, "invalid segment", http.StatusBadRequest)
+		return
+	}
+
+	path := s.orch.GetReplaySegment(kind, seq)
+	if path == "" {
+		http.Error(w, "segment not found", http.StatusNotFound)
+		return
+	}
+	http.ServeFile(w, r, path)
+}
+
+// handleDebugProfiles lists every pprof profile profiletrigger has captured
+// so far. Returns an empty list if profiling is disabled.
+func (s *Server) handleDebugProfiles(w http.ResponseWriter, r *http.Request) {
+	var profiles []profiletrigger.ProfileRecord
+	if s.profiles != nil {
+		profiles = s.profiles.Index()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{"profiles": profiles})
+}
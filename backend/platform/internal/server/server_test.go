@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/config"
 	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/replay"
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/orchestrator/transcript"
 )
 
 // mockOrchestrator for testing.
@@ -36,6 +39,28 @@ func (m *mockOrchestrator) SetAutoAnswer(enabled bool)     { m.autoAnswerOn = en
 func (m *mockOrchestrator) TranscriptEvents() <-chan orchestrator.TranscriptEvent {
 	return m.transcriptsCh
 }
+func (m *mockOrchestrator) AutoAnswerEvents() <-chan orchestrator.AutoAnswerEvent {
+	ch := make(chan orchestrator.AutoAnswerEvent)
+	close(ch)
+	return ch
+}
+func (m *mockOrchestrator) VADEvents() <-chan orchestrator.VADEvent {
+	ch := make(chan orchestrator.VADEvent)
+	close(ch)
+	return ch
+}
+func (m *mockOrchestrator) Analyze(context.Context, string, func(string)) error { return nil }
+func (m *mockOrchestrator) StartBroadcast(string) error                         { return nil }
+func (m *mockOrchestrator) StopBroadcast()                                      {}
+func (m *mockOrchestrator) WHIPHandler() http.Handler                           { return nil }
+func (m *mockOrchestrator) AddStreamSource(string) error                        { return nil }
+func (m *mockOrchestrator) RemoveStreamSource(string) error                     { return nil }
+func (m *mockOrchestrator) TranscriptBroker() *transcript.Broker {
+	return transcript.NewStore(1, 1).Broker()
+}
+func (m *mockOrchestrator) GetReplayManifest() replay.Manifest     { return replay.Manifest{} }
+func (m *mockOrchestrator) GetReplaySegment(string, uint64) string { return "" }
+func (m *mockOrchestrator) RenameSpeaker(string, string) error     { return nil }
 
 func TestCORSMiddleware(t *testing.T) {
 	handler := corsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
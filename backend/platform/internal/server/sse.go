@@ -0,0 +1,120 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/trace"
+)
+
+// handleSSE returns a handler streaming topics as text/event-stream. A
+// reconnecting client's Last-Event-ID (a comma-separated "topic:seq" vector
+// covering every subscribed topic, produced by encodeLastEventID) is
+// replayed from the hub's ring buffer before switching to live events.
+func (s *Server) handleSSE(topics ...topic) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientIP := extractIP(r)
+		if !s.ipRateLimit.allow(clientIP) {
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		lastIDs := decodeLastEventID(r.Header.Get("Last-Event-ID"))
+		sub, replay := s.hub.subscribeWithReplay(lastIDs, topics...)
+		defer s.hub.unsubscribe(sub)
+
+		log := trace.Logger(r.Context())
+		log.Info("sse connected", "remote", r.RemoteAddr, "client_ip", clientIP, "topics", topics)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+
+		for _, evt := range replay {
+			if writeSSEEvent(w, evt, lastIDs, topics) != nil {
+				return
+			}
+		}
+		flusher.Flush()
+
+		heartbeat := time.NewTicker(SSEHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case evt, ok := <-sub.ch:
+				if !ok {
+					return
+				}
+				if writeSSEEvent(w, evt, lastIDs, topics) != nil {
+					return
+				}
+				flusher.Flush()
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+					return
+				}
+				flusher.Flush()
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
+
+// writeSSEEvent writes evt as one SSE frame, updating lastIDs[evt.Topic] and
+// encoding the full lastIDs vector (across topics) as the frame's id so a
+// later reconnect can resume every subscribed topic, not just evt's.
+func writeSSEEvent(w http.ResponseWriter, evt hubEvent, lastIDs map[topic]uint64, topics []topic) error {
+	payload, err := json.Marshal(evt.Data)
+	if err != nil {
+		return err
+	}
+	lastIDs[evt.Topic] = evt.ID
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", encodeLastEventID(lastIDs, topics), evt.Topic, payload)
+	return err
+}
+
+// encodeLastEventID serializes lastIDs for topics as "topic:seq,topic:seq",
+// in topics order, so the resulting string round-trips through
+// decodeLastEventID regardless of which topic's event it was sent with.
+func encodeLastEventID(lastIDs map[topic]uint64, topics []topic) string {
+	parts := make([]string, len(topics))
+	for i, t := range topics {
+		parts[i] = fmt.Sprintf("%s:%d", t, lastIDs[t])
+	}
+	return strings.Join(parts, ",")
+}
+
+// decodeLastEventID parses a string produced by encodeLastEventID back into
+// a per-topic sequence map. Unparseable or unknown entries are skipped; an
+// empty or malformed header simply yields no replay backlog.
+func decodeLastEventID(raw string) map[topic]uint64 {
+	ids := make(map[topic]uint64)
+	if raw == "" {
+		return ids
+	}
+	for _, part := range strings.Split(raw, ",") {
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		seq, err := strconv.ParseUint(kv[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		ids[topic(kv[0])] = seq
+	}
+	return ids
+}
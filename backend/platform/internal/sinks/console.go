@@ -0,0 +1,29 @@
+package sinks
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+// ConsoleSink base64-encodes every artifact and writes it to an io.Writer
+// (stderr by default), for local debugging without standing up a
+// filesystem sink.
+type ConsoleSink struct {
+	w io.Writer
+}
+
+// NewConsoleSink returns a ConsoleSink writing to w.
+func NewConsoleSink(w io.Writer) *ConsoleSink {
+	return &ConsoleSink{w: w}
+}
+
+func (s *ConsoleSink) Write(_ context.Context, a Artifact) error {
+	_, err := fmt.Fprintf(s.w, "[sink] %s kind=%s bytes=%d data=%s\n",
+		a.Timestamp.UTC().Format("2006-01-02T15:04:05.000Z"), a.Kind, len(a.Data),
+		base64.StdEncoding.EncodeToString(a.Data))
+	return err
+}
+
+func (s *ConsoleSink) Close() error { return nil }
@@ -0,0 +1,44 @@
+package sinks
+
+import (
+	"log/slog"
+	"os"
+	"time"
+)
+
+// Config selects and parameterizes a Sink, sourced from config/env by
+// whatever caller builds it (see config.SinkConfig).
+type Config struct {
+	Type       string // "null" (default), "filesystem", or "console"
+	Dir        string // FilesystemSink root directory
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAge     time.Duration
+}
+
+// New builds the configured Sink. An unknown Type falls back to NullSink
+// with a warning rather than failing startup, matching how optional
+// observability features elsewhere in this service degrade gracefully.
+func New(cfg Config) Sink {
+	switch cfg.Type {
+	case "", "null":
+		return NewNullSink()
+	case "console":
+		return NewConsoleSink(os.Stderr)
+	case "filesystem":
+		sink, err := NewFilesystemSink(FilesystemSinkConfig{
+			Dir:        cfg.Dir,
+			MaxSizeMB:  cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAge,
+		})
+		if err != nil {
+			slog.Warn("sinks: filesystem sink init failed, falling back to null sink", "error", err)
+			return NewNullSink()
+		}
+		return sink
+	default:
+		slog.Warn("sinks: unknown sink type, falling back to null sink", "type", cfg.Type)
+		return NewNullSink()
+	}
+}
@@ -0,0 +1,136 @@
+package sinks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// FilesystemSinkConfig controls where FilesystemSink writes and how
+// aggressively it prunes old artifacts, modeled on lumberjack's log
+// rotation knobs.
+type FilesystemSinkConfig struct {
+	Dir        string        // root directory; one subdirectory per Artifact.Kind
+	MaxSizeMB  int           // total size per Kind subdirectory before oldest files are pruned; 0 means 100MB
+	MaxBackups int           // max number of retained files per Kind; 0 means unlimited
+	MaxAge     time.Duration // files older than this are pruned; 0 means unlimited
+}
+
+func (c FilesystemSinkConfig) withDefaults() FilesystemSinkConfig {
+	if c.MaxSizeMB <= 0 {
+		c.MaxSizeMB = 100
+	}
+	return c
+}
+
+// FilesystemSink writes each artifact to its own timestamped file under
+// Dir/<kind>/, then prunes that subdirectory against MaxSizeMB, MaxBackups,
+// and MaxAge. Unlike lumberjack it never appends to a single growing file,
+// since artifacts (JPEG frames, transcript text) are each a complete,
+// independent unit rather than lines in one log.
+type FilesystemSink struct {
+	cfg FilesystemSinkConfig
+	mu  sync.Mutex
+	seq atomic.Uint64
+}
+
+// NewFilesystemSink creates Dir if needed and returns a sink writing into it.
+func NewFilesystemSink(cfg FilesystemSinkConfig) (*FilesystemSink, error) {
+	cfg = cfg.withDefaults()
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("sinks: filesystem sink requires a Dir")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("sinks: create dir %s: %w", cfg.Dir, err)
+	}
+	return &FilesystemSink{cfg: cfg}, nil
+}
+
+func (s *FilesystemSink) Write(_ context.Context, a Artifact) error {
+	ts := a.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	dir := filepath.Join(s.cfg.Dir, a.Kind)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("sinks: create kind dir %s: %w", dir, err)
+	}
+
+	name := fmt.Sprintf("%s-%06d%s", ts.UTC().Format("20060102T150405.000000000"), s.seq.Add(1), extFor(a.Kind))
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, a.Data, 0o644); err != nil {
+		return fmt.Errorf("sinks: write artifact: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.prune(dir)
+}
+
+// prune removes the oldest files in dir once MaxBackups, MaxAge, or
+// MaxSizeMB is exceeded. Must be called with s.mu held.
+func (s *FilesystemSink) prune(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("sinks: list %s: %w", dir, err)
+	}
+
+	type file struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	files := make([]file, 0, len(entries))
+	var total int64
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, file{path: filepath.Join(dir, e.Name()), size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+
+	var cutoff time.Time
+	if s.cfg.MaxAge > 0 {
+		cutoff = time.Now().Add(-s.cfg.MaxAge)
+	}
+	maxBytes := int64(s.cfg.MaxSizeMB) * 1024 * 1024
+
+	for len(files) > 0 {
+		oldest := files[0]
+		overBackups := s.cfg.MaxBackups > 0 && len(files) > s.cfg.MaxBackups
+		overAge := !cutoff.IsZero() && oldest.modTime.Before(cutoff)
+		overSize := total > maxBytes
+		if !overBackups && !overAge && !overSize {
+			break
+		}
+		if err := os.Remove(oldest.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("sinks: prune %s: %w", oldest.path, err)
+		}
+		total -= oldest.size
+		files = files[1:]
+	}
+	return nil
+}
+
+func (s *FilesystemSink) Close() error { return nil }
+
+// extFor returns the file extension artifacts of kind are stored with.
+func extFor(kind string) string {
+	switch kind {
+	case "screen_frame":
+		return ".jpg"
+	case "transcript", "llm_output":
+		return ".txt"
+	default:
+		return ".bin"
+	}
+}
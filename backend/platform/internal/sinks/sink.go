@@ -0,0 +1,40 @@
+// Package sinks persists captured artifacts (screen frames, transcripts, LLM
+// outputs) for audit and debugging. The darwin screencapture backend, for
+// example, writes each frame to a temp file and deletes it immediately;
+// sinks give callers an optional, pluggable place to retain that data
+// instead.
+package sinks
+
+import (
+	"context"
+	"time"
+)
+
+// Artifact is one piece of captured data flowing through a Sink.
+type Artifact struct {
+	Kind      string // e.g. "screen_frame", "transcript", "llm_output"
+	Data      []byte
+	Timestamp time.Time
+}
+
+// Sink persists artifacts. Implementations must be safe for concurrent use.
+type Sink interface {
+	Write(ctx context.Context, artifact Artifact) error
+	Close() error
+}
+
+// NullSink discards every artifact. It's the default: retaining captured
+// screen/audio content is opt-in.
+type NullSink struct{}
+
+// NewNullSink returns a Sink that discards everything written to it.
+func NewNullSink() *NullSink { return &NullSink{} }
+
+func (NullSink) Write(context.Context, Artifact) error { return nil }
+func (NullSink) Close() error                          { return nil }
+
+var (
+	_ Sink = (*NullSink)(nil)
+	_ Sink = (*ConsoleSink)(nil)
+	_ Sink = (*FilesystemSink)(nil)
+)
@@ -0,0 +1,143 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNullSinkDiscards(t *testing.T) {
+	s := NewNullSink()
+	if err := s.Write(context.Background(), Artifact{Kind: "screen_frame", Data: []byte("x")}); err != nil {
+		t.Errorf("Write() = %v, want nil", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Errorf("Close() = %v, want nil", err)
+	}
+}
+
+func TestConsoleSinkWritesBase64(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewConsoleSink(&buf)
+
+	data := []byte("hello")
+	if err := s.Write(context.Background(), Artifact{Kind: "transcript", Data: data, Timestamp: time.Now()}); err != nil {
+		t.Fatalf("Write() = %v, want nil", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "kind=transcript") {
+		t.Errorf("output = %q, want it to contain kind=transcript", out)
+	}
+	if !strings.Contains(out, base64.StdEncoding.EncodeToString(data)) {
+		t.Errorf("output = %q, want base64 of %q", out, data)
+	}
+}
+
+func TestFilesystemSinkWritesFile(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFilesystemSink(FilesystemSinkConfig{Dir: dir})
+	if err != nil {
+		t.Fatalf("NewFilesystemSink() = %v, want nil", err)
+	}
+
+	if err := s.Write(context.Background(), Artifact{Kind: "screen_frame", Data: []byte("jpegdata")}); err != nil {
+		t.Fatalf("Write() = %v, want nil", err)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, "screen_frame"))
+	if err != nil {
+		t.Fatalf("ReadDir() = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("entries = %d, want 1", len(entries))
+	}
+	if filepath.Ext(entries[0].Name()) != ".jpg" {
+		t.Errorf("ext = %q, want .jpg", filepath.Ext(entries[0].Name()))
+	}
+}
+
+func TestFilesystemSinkPrunesByMaxBackups(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFilesystemSink(FilesystemSinkConfig{Dir: dir, MaxBackups: 2})
+	if err != nil {
+		t.Fatalf("NewFilesystemSink() = %v, want nil", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if err := s.Write(context.Background(), Artifact{Kind: "transcript", Data: []byte("t")}); err != nil {
+			t.Fatalf("Write() = %v, want nil", err)
+		}
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, "transcript"))
+	if err != nil {
+		t.Fatalf("ReadDir() = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("entries = %d, want 2 (MaxBackups)", len(entries))
+	}
+}
+
+func TestFilesystemSinkPrunesByMaxAge(t *testing.T) {
+	dir := t.TempDir()
+	kindDir := filepath.Join(dir, "transcript")
+	if err := os.MkdirAll(kindDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll() = %v", err)
+	}
+
+	stale := filepath.Join(kindDir, "stale.txt")
+	if err := os.WriteFile(stale, []byte("old"), 0o644); err != nil {
+		t.Fatalf("WriteFile() = %v", err)
+	}
+	old := time.Now().Add(-48 * time.Hour)
+	if err := os.Chtimes(stale, old, old); err != nil {
+		t.Fatalf("Chtimes() = %v", err)
+	}
+
+	s, err := NewFilesystemSink(FilesystemSinkConfig{Dir: dir, MaxAge: time.Hour})
+	if err != nil {
+		t.Fatalf("NewFilesystemSink() = %v, want nil", err)
+	}
+	if err := s.Write(context.Background(), Artifact{Kind: "transcript", Data: []byte("new")}); err != nil {
+		t.Fatalf("Write() = %v, want nil", err)
+	}
+
+	entries, err := os.ReadDir(kindDir)
+	if err != nil {
+		t.Fatalf("ReadDir() = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("entries = %d, want 1 (stale file pruned)", len(entries))
+	}
+}
+
+func TestFilesystemSinkRequiresDir(t *testing.T) {
+	if _, err := NewFilesystemSink(FilesystemSinkConfig{}); err == nil {
+		t.Error("NewFilesystemSink() with empty Dir = nil, want error")
+	}
+}
+
+func TestNewFallsBackToNullOnUnknownType(t *testing.T) {
+	s := New(Config{Type: "nonsense"})
+	if _, ok := s.(*NullSink); !ok {
+		t.Errorf("New() with unknown type = %T, want *NullSink", s)
+	}
+}
+
+func TestNewBuildsConfiguredTypes(t *testing.T) {
+	if _, ok := New(Config{Type: "console"}).(*ConsoleSink); !ok {
+		t.Error("New(console) did not return a *ConsoleSink")
+	}
+	if _, ok := New(Config{Type: "null"}).(*NullSink); !ok {
+		t.Error("New(null) did not return a *NullSink")
+	}
+	if _, ok := New(Config{Type: "filesystem", Dir: t.TempDir()}).(*FilesystemSink); !ok {
+		t.Error("New(filesystem) did not return a *FilesystemSink")
+	}
+}
@@ -0,0 +1,254 @@
+// Package trace - async batch processor for shipping spans to an Exporter
+// without blocking callers or hammering a struggling collector.
+package trace
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+// Exporter ships a batch of completed spans to an external collector in one
+// call, so a BatchProcessor can amortize network round trips across many
+// spans instead of one request per span (see SpanExporter for the
+// single-span equivalent used directly by Span.End()).
+type Exporter interface {
+	ExportSpans(ctx context.Context, spans []*Span) error
+}
+
+// BatchConfig tunes BatchProcessor's queueing and flush behavior.
+type BatchConfig struct {
+	MaxQueueSize  int           // ring buffer capacity; oldest span is dropped once full
+	BatchSize     int           // flush early once this many spans are queued
+	FlushInterval time.Duration // otherwise flush on this cadence
+}
+
+// DefaultBatchConfig returns conservative defaults suitable for a single
+// collector endpoint.
+func DefaultBatchConfig() BatchConfig {
+	return BatchConfig{
+		MaxQueueSize:  2048,
+		BatchSize:     256,
+		FlushInterval: 5 * time.Second,
+	}
+}
+
+// BatchStats is a snapshot of a BatchProcessor's lifetime counters.
+type BatchStats struct {
+	Queued   int64
+	Exported int64
+	Dropped  int64
+	Failed   int64
+}
+
+// BatchProcessor buffers spans in a ring buffer and flushes them to an
+// Exporter in batches, either once BatchSize is queued or FlushInterval
+// elapses, whichever comes first. It implements SpanExporter itself, so it
+// can be installed via SetExporter (or more conveniently RegisterExporter)
+// as a drop-in for Span.End()'s per-span export call. Once the ring buffer
+// is full, the oldest queued span is dropped to make room rather than
+// blocking the caller or growing without bound.
+type BatchProcessor struct {
+	exporter Exporter
+	cfg      BatchConfig
+
+	mu   sync.Mutex
+	ring *ringBuffer
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+
+	queued   atomic.Int64
+	exported atomic.Int64
+	dropped  atomic.Int64
+	failed   atomic.Int64
+}
+
+// NewBatchProcessor creates a BatchProcessor that flushes to exporter
+// according to cfg. Zero-valued fields in cfg fall back to
+// DefaultBatchConfig. Call Start to begin the background flush loop.
+func NewBatchProcessor(exporter Exporter, cfg BatchConfig) *BatchProcessor {
+	def := DefaultBatchConfig()
+	if cfg.MaxQueueSize <= 0 {
+		cfg.MaxQueueSize = def.MaxQueueSize
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = def.BatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = def.FlushInterval
+	}
+	return &BatchProcessor{
+		exporter: exporter,
+		cfg:      cfg,
+		ring:     newRingBuffer(cfg.MaxQueueSize),
+	}
+}
+
+// Start begins the background flush loop. A no-op if already started.
+func (p *BatchProcessor) Start() {
+	p.mu.Lock()
+	if p.stopCh != nil {
+		p.mu.Unlock()
+		return
+	}
+	stopCh := make(chan struct{})
+	p.stopCh = stopCh
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.flushLoop(stopCh)
+}
+
+// Stop ends the flush loop and flushes whatever is still queued.
+func (p *BatchProcessor) Stop() {
+	p.mu.Lock()
+	stopCh := p.stopCh
+	p.stopCh = nil
+	p.mu.Unlock()
+	if stopCh == nil {
+		return
+	}
+	close(stopCh)
+	p.wg.Wait()
+	p.flush(context.Background())
+}
+
+// Export enqueues span for the next batch. It satisfies SpanExporter and
+// never blocks: once the ring buffer is at MaxQueueSize, the oldest queued
+// span is dropped to make room.
+func (p *BatchProcessor) Export(_ context.Context, span *Span) error {
+	p.mu.Lock()
+	dropped := p.ring.push(span)
+	full := p.ring.count >= p.cfg.BatchSize
+	p.mu.Unlock()
+
+	p.queued.Add(1)
+	if dropped {
+		p.dropped.Add(1)
+	}
+	if full {
+		go p.flush(context.Background())
+	}
+	return nil
+}
+
+// Stats returns a snapshot of the processor's lifetime counters.
+func (p *BatchProcessor) Stats() BatchStats {
+	return BatchStats{
+		Queued:   p.queued.Load(),
+		Exported: p.exported.Load(),
+		Dropped:  p.dropped.Load(),
+		Failed:   p.failed.Load(),
+	}
+}
+
+func (p *BatchProcessor) flushLoop(stopCh chan struct{}) {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			p.flush(context.Background())
+		}
+	}
+}
+
+func (p *BatchProcessor) flush(ctx context.Context) {
+	p.mu.Lock()
+	batch := p.ring.drain(p.cfg.BatchSize)
+	p.mu.Unlock()
+	if len(batch) == 0 {
+		return
+	}
+
+	if err := p.exporter.ExportSpans(ctx, batch); err != nil {
+		p.failed.Add(int64(len(batch)))
+		slog.Debug("span batch export failed", "batch_size", len(batch), "error", err)
+		return
+	}
+	p.exported.Add(int64(len(batch)))
+}
+
+// RegisterExporter builds a BatchProcessor around exporter using cfg, starts
+// its flush loop, and installs it as the package's current exporter (see
+// SetExporter). The returned processor can be stopped and inspected via
+// Stats independently of the exporter it wraps.
+func RegisterExporter(exporter Exporter, cfg BatchConfig) *BatchProcessor {
+	p := NewBatchProcessor(exporter, cfg)
+	p.Start()
+	SetExporter(p)
+	return p
+}
+
+// ringBuffer is a fixed-capacity FIFO queue of spans. Pushing past capacity
+// overwrites the oldest element instead of growing, so BatchProcessor's
+// memory use stays bounded under sustained pressure from a slow or down
+// collector.
+type ringBuffer struct {
+	buf   []*Span
+	head  int
+	count int
+}
+
+func newRingBuffer(size int) *ringBuffer {
+	return &ringBuffer{buf: make([]*Span, size)}
+}
+
+// push adds s to the buffer, reporting whether it overwrote (dropped) the
+// oldest element because the buffer was already full.
+func (r *ringBuffer) push(s *Span) bool {
+	idx := (r.head + r.count) % len(r.buf)
+	r.buf[idx] = s
+	if r.count == len(r.buf) {
+		r.head = (r.head + 1) % len(r.buf)
+		return true
+	}
+	r.count++
+	return false
+}
+
+// drain removes and returns up to max queued spans in FIFO order. max <= 0
+// drains everything queued.
+func (r *ringBuffer) drain(max int) []*Span {
+	n := r.count
+	if max > 0 && n > max {
+		n = max
+	}
+	out := make([]*Span, n)
+	for i := 0; i < n; i++ {
+		out[i] = r.buf[(r.head+i)%len(r.buf)]
+	}
+	r.head = (r.head + n) % len(r.buf)
+	r.count -= n
+	return out
+}
+
+// BreakerExporter wraps an Exporter with a circuit breaker, so a collector
+// that's down or timing out gets fewer, faster-failing requests instead of
+// every batch blocking on it.
+type BreakerExporter struct {
+	exporter Exporter
+	breaker  *resilience.Breaker
+}
+
+// NewBreakerExporter wraps exporter with a breaker configured by cfg.
+func NewBreakerExporter(exporter Exporter, cfg resilience.Config) *BreakerExporter {
+	return &BreakerExporter{exporter: exporter, breaker: resilience.New(cfg)}
+}
+
+// ExportSpans runs the wrapped exporter's ExportSpans behind the breaker.
+func (b *BreakerExporter) ExportSpans(ctx context.Context, spans []*Span) error {
+	return b.breaker.Execute(func() error { return b.exporter.ExportSpans(ctx, spans) })
+}
+
+// State reports the wrapped breaker's current state.
+func (b *BreakerExporter) State() resilience.State { return b.breaker.State() }
@@ -0,0 +1,123 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GriffinCanCode/good-listener/backend/platform/internal/resilience"
+)
+
+type fakeBatchExporter struct {
+	mu    sync.Mutex
+	batch [][]*Span
+	err   error
+}
+
+func (f *fakeBatchExporter) ExportSpans(ctx context.Context, spans []*Span) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.batch = append(f.batch, spans)
+	return nil
+}
+
+func (f *fakeBatchExporter) batchCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.batch)
+}
+
+func TestRingBufferDropsOldestWhenFull(t *testing.T) {
+	r := newRingBuffer(2)
+	s1, s2, s3 := &Span{Name: "a"}, &Span{Name: "b"}, &Span{Name: "c"}
+
+	if dropped := r.push(s1); dropped {
+		t.Error("first push should not drop")
+	}
+	if dropped := r.push(s2); dropped {
+		t.Error("second push should not drop")
+	}
+	if dropped := r.push(s3); !dropped {
+		t.Error("third push should drop the oldest")
+	}
+
+	got := r.drain(0)
+	if len(got) != 2 || got[0] != s2 || got[1] != s3 {
+		t.Errorf("drain() = %v, want [b, c]", got)
+	}
+}
+
+func TestBatchProcessorFlushesOnBatchSize(t *testing.T) {
+	fake := &fakeBatchExporter{}
+	p := NewBatchProcessor(fake, BatchConfig{MaxQueueSize: 10, BatchSize: 2, FlushInterval: time.Hour})
+
+	_ = p.Export(context.Background(), &Span{Name: "a"})
+	_ = p.Export(context.Background(), &Span{Name: "b"})
+
+	deadline := time.Now().Add(time.Second)
+	for fake.batchCount() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if fake.batchCount() != 1 {
+		t.Fatalf("expected 1 flushed batch, got %d", fake.batchCount())
+	}
+
+	stats := p.Stats()
+	if stats.Queued != 2 || stats.Exported != 2 {
+		t.Errorf("Stats() = %+v, want Queued=2 Exported=2", stats)
+	}
+}
+
+func TestBatchProcessorStopFlushesRemainder(t *testing.T) {
+	fake := &fakeBatchExporter{}
+	p := NewBatchProcessor(fake, BatchConfig{MaxQueueSize: 10, BatchSize: 10, FlushInterval: time.Hour})
+	p.Start()
+
+	_ = p.Export(context.Background(), &Span{Name: "a"})
+	p.Stop()
+
+	if fake.batchCount() != 1 {
+		t.Fatalf("expected Stop to flush the remaining span, got %d batches", fake.batchCount())
+	}
+}
+
+func TestBatchProcessorDropsOldestUnderPressure(t *testing.T) {
+	fake := &fakeBatchExporter{}
+	p := NewBatchProcessor(fake, BatchConfig{MaxQueueSize: 1, BatchSize: 100, FlushInterval: time.Hour})
+
+	_ = p.Export(context.Background(), &Span{Name: "a"})
+	_ = p.Export(context.Background(), &Span{Name: "b"})
+
+	stats := p.Stats()
+	if stats.Dropped != 1 {
+		t.Errorf("Stats().Dropped = %d, want 1", stats.Dropped)
+	}
+}
+
+func TestRegisterExporterInstallsProcessor(t *testing.T) {
+	fake := &fakeBatchExporter{}
+	p := RegisterExporter(fake, BatchConfig{MaxQueueSize: 10, BatchSize: 1, FlushInterval: time.Hour})
+	defer func() { p.Stop(); SetExporter(nil) }()
+
+	if currentExporter() != p {
+		t.Error("RegisterExporter should install the processor as the current exporter")
+	}
+}
+
+func TestBreakerExporterPropagatesFailures(t *testing.T) {
+	fake := &fakeBatchExporter{err: errors.New("collector down")}
+	be := NewBreakerExporter(fake, resilience.FastConfig())
+
+	for i := 0; i < 10; i++ {
+		_ = be.ExportSpans(context.Background(), []*Span{{Name: "a"}})
+	}
+
+	if be.State() != resilience.Open {
+		t.Errorf("State() = %v, want Open after repeated failures", be.State())
+	}
+}
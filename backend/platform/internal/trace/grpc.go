@@ -24,7 +24,9 @@ func StreamClientInterceptor() grpc.StreamClientInterceptor {
 	}
 }
 
-// injectMetadata adds trace context to outgoing gRPC metadata.
+// injectMetadata adds trace context to outgoing gRPC metadata: the
+// standard traceparent/tracestate headers, plus the legacy x-trace-id
+// headers when LegacyHeaders is set.
 func injectMetadata(ctx context.Context) context.Context {
 	tc, ok := FromContext(ctx)
 	if !ok {
@@ -39,11 +41,54 @@ func injectMetadata(ctx context.Context) context.Context {
 		md = md.Copy()
 	}
 
-	md.Set(TraceIDKey, tc.TraceID)
-	md.Set(SpanIDKey, tc.SpanID)
-	if tc.ParentSpanID != "" {
-		md.Set(ParentSpanIDKey, tc.ParentSpanID)
+	for k, v := range tc.ToMap() {
+		md.Set(k, v)
 	}
 
 	return metadata.NewOutgoingContext(ctx, md)
 }
+
+// UnaryServerInterceptor hydrates trace.Context from incoming gRPC
+// metadata (preferring traceparent, falling back to the legacy
+// x-trace-id headers) so handler sees a populated trace context.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(extractIncomingContext(ctx), req)
+	}
+}
+
+// StreamServerInterceptor is the streaming-call counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := &tracedServerStream{ServerStream: ss, ctx: extractIncomingContext(ss.Context())}
+		return handler(srv, wrapped)
+	}
+}
+
+// tracedServerStream overrides Context() to carry the extracted trace
+// context through the rest of a streaming call.
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedServerStream) Context() context.Context { return s.ctx }
+
+// extractIncomingContext reads trace context out of ctx's incoming gRPC
+// metadata, preferring the W3C traceparent/tracestate headers and falling
+// back to the legacy x-trace-id/x-span-id headers for older callers.
+func extractIncomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return WithContext(ctx, New())
+	}
+
+	m := make(map[string]string, len(md))
+	for _, key := range []string{TraceParentKey, TraceStateKey, TraceIDKey, SpanIDKey, ParentSpanIDKey} {
+		if vals := md.Get(key); len(vals) > 0 {
+			m[key] = vals[0]
+		}
+	}
+	return WithContext(ctx, FromMap(m))
+}
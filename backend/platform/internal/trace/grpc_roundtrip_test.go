@@ -0,0 +1,80 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpctesting "google.golang.org/grpc/reflection/grpc_testing"
+)
+
+// searchServer records the trace context (and a log line produced through
+// it) the server-side interceptor attached to the incoming call, so the
+// test can confirm a client's trace.Context survives the wire unmodified.
+type searchServer struct {
+	grpctesting.UnimplementedSearchServiceServer
+	seen Context
+}
+
+func (s *searchServer) Search(ctx context.Context, _ *grpctesting.SearchRequest) (*grpctesting.SearchResponse, error) {
+	s.seen, _ = FromContext(ctx)
+	Logger(ctx).Info("handling search request")
+	return &grpctesting.SearchResponse{}, nil
+}
+
+// TestTraceContextSurvivesGRPCRoundTrip wires UnaryClientInterceptor and
+// UnaryServerInterceptor into a real client/server pair (rather than
+// invoking the interceptor functions directly) and checks that the
+// server handler sees the same trace the client set, and that a log
+// emitted from within the handler carries it too.
+func TestTraceContextSurvivesGRPCRoundTrip(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	srv := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor()))
+	handler := &searchServer{}
+	grpctesting.RegisterSearchServiceServer(srv, handler)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(
+		lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	var logBuf bytes.Buffer
+	prevDefault := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logBuf, nil)))
+	t.Cleanup(func() { slog.SetDefault(prevDefault) })
+
+	tc := New()
+	ctx := WithContext(context.Background(), tc)
+
+	client := grpctesting.NewSearchServiceClient(conn)
+	if _, err := client.Search(ctx, &grpctesting.SearchRequest{}); err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+
+	if handler.seen.TraceID != tc.TraceID {
+		t.Errorf("server saw TraceID %q, want %q", handler.seen.TraceID, tc.TraceID)
+	}
+	if handler.seen.ParentSpanID != tc.SpanID {
+		t.Errorf("server saw ParentSpanID %q, want client's SpanID %q", handler.seen.ParentSpanID, tc.SpanID)
+	}
+
+	if got := logBuf.String(); !strings.Contains(got, tc.TraceID) {
+		t.Errorf("handler log output = %q, want it to contain trace_id %q", got, tc.TraceID)
+	}
+}
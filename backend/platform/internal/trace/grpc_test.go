@@ -0,0 +1,81 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptorExtractsTraceParent(t *testing.T) {
+	parent := New()
+	md := metadata.Pairs(TraceParentKey, parent.FormatTraceParent())
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	var got Context
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, _ = FromContext(ctx)
+		return nil, nil
+	}
+
+	interceptor := UnaryServerInterceptor()
+	if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatalf("interceptor: %v", err)
+	}
+
+	if got.TraceID != parent.TraceID {
+		t.Errorf("TraceID = %q, want %q", got.TraceID, parent.TraceID)
+	}
+	if got.ParentSpanID != parent.SpanID {
+		t.Errorf("ParentSpanID = %q, want %q", got.ParentSpanID, parent.SpanID)
+	}
+}
+
+func TestUnaryServerInterceptorFallsBackToLegacyHeaders(t *testing.T) {
+	md := metadata.Pairs(TraceIDKey, "abcd1234abcd1234abcd1234abcd1234")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	var got Context
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, _ = FromContext(ctx)
+		return nil, nil
+	}
+
+	interceptor := UnaryServerInterceptor()
+	if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatalf("interceptor: %v", err)
+	}
+
+	if got.TraceID != "abcd1234abcd1234abcd1234abcd1234" {
+		t.Errorf("TraceID = %q, want legacy header value", got.TraceID)
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func TestStreamServerInterceptorExtractsTraceParent(t *testing.T) {
+	parent := New()
+	md := metadata.Pairs(TraceParentKey, parent.FormatTraceParent())
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	var got Context
+	handler := func(srv any, ss grpc.ServerStream) error {
+		got, _ = FromContext(ss.Context())
+		return nil
+	}
+
+	interceptor := StreamServerInterceptor()
+	if err := interceptor(nil, &fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{}, handler); err != nil {
+		t.Fatalf("interceptor: %v", err)
+	}
+
+	if got.TraceID != parent.TraceID {
+		t.Errorf("TraceID = %q, want %q", got.TraceID, parent.TraceID)
+	}
+}
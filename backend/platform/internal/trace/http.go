@@ -15,12 +15,25 @@ func Middleware(next http.Handler) http.Handler {
 	})
 }
 
-// extractFromHeaders gets trace context from HTTP headers.
+// extractFromHeaders gets trace context from HTTP headers, preferring the
+// W3C traceparent/tracestate headers and falling back to the legacy
+// x-trace-id/x-span-id headers for older clients.
 func extractFromHeaders(r *http.Request) Context {
+	if parsed, ok := ParseTraceParent(r.Header.Get(TraceParentKey)); ok {
+		return Context{
+			TraceID:      parsed.TraceID,
+			SpanID:       generateSpanID(),
+			ParentSpanID: parsed.SpanID,
+			TraceState:   r.Header.Get(TraceStateKey),
+			Sampled:      parsed.Sampled,
+		}
+	}
+
 	tc := Context{
 		TraceID:      r.Header.Get(TraceIDKey),
 		ParentSpanID: r.Header.Get(SpanIDKey),
 		SpanID:       generateSpanID(),
+		Sampled:      true,
 	}
 	if tc.TraceID == "" {
 		tc.TraceID = generateTraceID()
@@ -0,0 +1,190 @@
+// Package trace - pluggable span export, with an OTLP/gRPC implementation.
+package trace
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// SpanExporter publishes completed spans to an external collector.
+type SpanExporter interface {
+	Export(ctx context.Context, span *Span) error
+}
+
+// noopExporter discards every span; it's the default until SetExporter is called.
+type noopExporter struct{}
+
+func (noopExporter) Export(context.Context, *Span) error { return nil }
+
+var (
+	exporterMu sync.RWMutex
+	exporter   SpanExporter = noopExporter{}
+)
+
+// SetExporter installs the exporter used by Span.End(). Passing nil restores
+// the no-op default.
+func SetExporter(e SpanExporter) {
+	exporterMu.Lock()
+	defer exporterMu.Unlock()
+	if e == nil {
+		e = noopExporter{}
+	}
+	exporter = e
+}
+
+func currentExporter() SpanExporter {
+	exporterMu.RLock()
+	defer exporterMu.RUnlock()
+	return exporter
+}
+
+// OTLPExporter publishes spans to an OTLP/gRPC collector such as the
+// OpenTelemetry Collector, Jaeger, or Tempo.
+type OTLPExporter struct {
+	client      coltracepb.TraceServiceClient
+	conn        *grpc.ClientConn
+	serviceName string
+}
+
+// NewOTLPExporter dials endpoint (e.g. "localhost:4317") and returns an
+// exporter that ships spans there. Call Close when done with it.
+func NewOTLPExporter(endpoint, serviceName string) (*OTLPExporter, error) {
+	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dialing otlp endpoint %s: %w", endpoint, err)
+	}
+	return &OTLPExporter{
+		client:      coltracepb.NewTraceServiceClient(conn),
+		conn:        conn,
+		serviceName: serviceName,
+	}, nil
+}
+
+// Export converts span into its OTLP wire representation and ships it to
+// the collector as a single-span request.
+func (e *OTLPExporter) Export(ctx context.Context, span *Span) error {
+	req := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{
+					stringAttr("service.name", e.serviceName),
+				},
+			},
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Spans: []*tracepb.Span{toOTLPSpan(span)},
+			}},
+		}},
+	}
+	if _, err := e.client.Export(ctx, req); err != nil {
+		return fmt.Errorf("exporting span %q: %w", span.Name, err)
+	}
+	return nil
+}
+
+// ExportSpans converts spans into their OTLP wire representation and ships
+// them to the collector as a single request, so a BatchProcessor can
+// amortize the round trip across many spans at once.
+func (e *OTLPExporter) ExportSpans(ctx context.Context, spans []*Span) error {
+	pbSpans := make([]*tracepb.Span, len(spans))
+	for i, span := range spans {
+		pbSpans[i] = toOTLPSpan(span)
+	}
+
+	req := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{
+					stringAttr("service.name", e.serviceName),
+				},
+			},
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Spans: pbSpans,
+			}},
+		}},
+	}
+	if _, err := e.client.Export(ctx, req); err != nil {
+		return fmt.Errorf("exporting %d spans: %w", len(spans), err)
+	}
+	return nil
+}
+
+// Close releases the underlying gRPC connection.
+func (e *OTLPExporter) Close() error {
+	return e.conn.Close()
+}
+
+func toOTLPSpan(span *Span) *tracepb.Span {
+	traceID, _ := hex.DecodeString(span.Ctx.TraceID)
+	spanID, _ := hex.DecodeString(span.Ctx.SpanID)
+	var parentID []byte
+	if span.Ctx.ParentSpanID != "" {
+		parentID, _ = hex.DecodeString(span.Ctx.ParentSpanID)
+	}
+
+	attrs := make([]*commonpb.KeyValue, 0, len(span.Attrs))
+	for k, v := range span.Attrs {
+		attrs = append(attrs, stringAttr(k, fmt.Sprint(v)))
+	}
+
+	return &tracepb.Span{
+		TraceId:           traceID,
+		SpanId:            spanID,
+		ParentSpanId:      parentID,
+		TraceState:        span.Ctx.TraceState,
+		Name:              span.Name,
+		Kind:              tracepb.Span_SPAN_KIND_INTERNAL,
+		StartTimeUnixNano: uint64(span.StartTime.UnixNano()),
+		EndTimeUnixNano:   uint64(span.EndTime.UnixNano()),
+		Attributes:        attrs,
+		Events:            toOTLPEvents(span.Events),
+		Status:            toOTLPStatus(span.Status, span.StatusDescription),
+	}
+}
+
+func toOTLPEvents(events []SpanEvent) []*tracepb.Span_Event {
+	if len(events) == 0 {
+		return nil
+	}
+	out := make([]*tracepb.Span_Event, len(events))
+	for i, e := range events {
+		attrs := make([]*commonpb.KeyValue, 0, len(e.Attrs))
+		for k, v := range e.Attrs {
+			attrs = append(attrs, stringAttr(k, fmt.Sprint(v)))
+		}
+		out[i] = &tracepb.Span_Event{
+			TimeUnixNano: uint64(e.Time.UnixNano()),
+			Name:         e.Name,
+			Attributes:   attrs,
+		}
+	}
+	return out
+}
+
+func toOTLPStatus(code StatusCode, description string) *tracepb.Status {
+	if code == StatusUnset {
+		return nil
+	}
+	pbCode := tracepb.Status_STATUS_CODE_UNSET
+	if code == StatusOk {
+		pbCode = tracepb.Status_STATUS_CODE_OK
+	} else if code == StatusError {
+		pbCode = tracepb.Status_STATUS_CODE_ERROR
+	}
+	return &tracepb.Status{Code: pbCode, Message: description}
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
@@ -0,0 +1,89 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errTest = errors.New("boom")
+
+type fakeExporter struct {
+	spans []*Span
+}
+
+func (f *fakeExporter) Export(ctx context.Context, span *Span) error {
+	f.spans = append(f.spans, span)
+	return nil
+}
+
+func TestSetExporterDefaultsToNoop(t *testing.T) {
+	SetExporter(nil)
+	if _, ok := currentExporter().(noopExporter); !ok {
+		t.Error("nil exporter should fall back to noopExporter")
+	}
+}
+
+func TestSpanEndExportsToCurrentExporter(t *testing.T) {
+	fake := &fakeExporter{}
+	SetExporter(fake)
+	defer SetExporter(nil)
+
+	ctx := context.Background()
+	_, span := StartSpan(ctx, "exported_span")
+	span.End()
+
+	// End() exports asynchronously; give it a moment to land.
+	for i := 0; i < 100 && len(fake.spans) == 0; i++ {
+		<-time.After(time.Millisecond)
+	}
+	if len(fake.spans) != 1 {
+		t.Fatalf("expected 1 exported span, got %d", len(fake.spans))
+	}
+	if fake.spans[0].Name != "exported_span" {
+		t.Errorf("exported span name = %q, want exported_span", fake.spans[0].Name)
+	}
+}
+
+func TestToOTLPSpanMapsFields(t *testing.T) {
+	ctx := context.Background()
+	_, span := StartSpan(ctx, "mapped_span")
+	span.SetAttr("key", "value")
+	span.End()
+
+	pb := toOTLPSpan(span)
+	if pb.Name != "mapped_span" {
+		t.Errorf("Name = %q, want mapped_span", pb.Name)
+	}
+	if len(pb.TraceId) != 16 {
+		t.Errorf("TraceId should be 16 bytes, got %d", len(pb.TraceId))
+	}
+	if len(pb.SpanId) != 8 {
+		t.Errorf("SpanId should be 8 bytes, got %d", len(pb.SpanId))
+	}
+	if len(pb.Attributes) != 1 || pb.Attributes[0].Key != "key" {
+		t.Errorf("Attributes = %v, want one entry keyed %q", pb.Attributes, "key")
+	}
+}
+
+func TestToOTLPSpanMapsEventsAndStatus(t *testing.T) {
+	ctx := context.Background()
+	_, span := StartSpan(ctx, "errored_span")
+	span.RecordError(errTest)
+	span.End()
+
+	pb := toOTLPSpan(span)
+	if pb.Status == nil || pb.Status.Code != 2 { // STATUS_CODE_ERROR
+		t.Errorf("Status = %v, want code 2 (error)", pb.Status)
+	}
+	if len(pb.Events) != 1 || pb.Events[0].Name != "exception" {
+		t.Fatalf("Events = %v, want one exception event", pb.Events)
+	}
+}
+
+func TestToOTLPStatusNilWhenUnset(t *testing.T) {
+	if got := toOTLPStatus(StatusUnset, ""); got != nil {
+		t.Errorf("toOTLPStatus(StatusUnset) = %v, want nil", got)
+	}
+}
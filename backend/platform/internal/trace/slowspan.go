@@ -0,0 +1,31 @@
+package trace
+
+import (
+	"context"
+	"sync"
+)
+
+// SlowSpanHook is invoked (asynchronously, from Span.End) when a span
+// carrying WithSlowThreshold exceeds its threshold. The profiletrigger
+// package registers one to capture a CPU profile; ctx carries no trace
+// context of its own - callers that need the span's trace ID should read
+// span.Ctx.
+type SlowSpanHook func(ctx context.Context, span *Span)
+
+var (
+	slowSpanMu   sync.RWMutex
+	slowSpanHook SlowSpanHook
+)
+
+// SetSlowSpanHook installs the hook used by Span.End(). Passing nil disables it.
+func SetSlowSpanHook(fn SlowSpanHook) {
+	slowSpanMu.Lock()
+	defer slowSpanMu.Unlock()
+	slowSpanHook = fn
+}
+
+func currentSlowSpanHook() SlowSpanHook {
+	slowSpanMu.RLock()
+	defer slowSpanMu.RUnlock()
+	return slowSpanHook
+}
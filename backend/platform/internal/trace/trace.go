@@ -6,17 +6,41 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"fmt"
 	"log/slog"
+	"runtime/debug"
+	"sort"
+	"strings"
 	"time"
 )
 
-// Metadata keys for gRPC/HTTP propagation (W3C-style).
+// W3C Trace Context header names (https://www.w3.org/TR/trace-context/).
+const (
+	TraceParentKey = "traceparent"
+	TraceStateKey  = "tracestate"
+)
+
+// Legacy metadata keys, kept for HTTP clients that haven't moved to
+// traceparent yet; extractFromHeaders still falls back to these.
 const (
 	TraceIDKey      = "x-trace-id"
 	SpanIDKey       = "x-span-id"
 	ParentSpanIDKey = "x-parent-span-id"
 )
 
+// LegacyHeaders controls whether ToMap and the gRPC client interceptors
+// also emit the legacy x-trace-id/x-span-id/x-parent-span-id headers
+// alongside the standard traceparent/tracestate ones. Defaults to true so
+// callers that haven't moved to traceparent yet keep working; set to false
+// once nothing in the deployment still reads the legacy keys.
+var LegacyHeaders = true
+
+// traceParentVersion is the only version defined by the W3C spec so far.
+const traceParentVersion = "00"
+
+// sampledFlag marks a trace context as sampled in the traceparent flags byte.
+const sampledFlag = 0x01
+
 type ctxKey struct{}
 
 var traceCtxKey = ctxKey{}
@@ -26,6 +50,8 @@ type Context struct {
 	TraceID      string
 	SpanID       string
 	ParentSpanID string
+	TraceState   string
+	Sampled      bool
 }
 
 // New creates a new trace context with fresh IDs.
@@ -33,6 +59,7 @@ func New() Context {
 	return Context{
 		TraceID: generateTraceID(),
 		SpanID:  generateSpanID(),
+		Sampled: true,
 	}
 }
 
@@ -42,6 +69,8 @@ func NewChild(parent Context) Context {
 		TraceID:      parent.TraceID,
 		SpanID:       generateSpanID(),
 		ParentSpanID: parent.SpanID,
+		TraceState:   parent.TraceState,
+		Sampled:      parent.Sampled,
 	}
 }
 
@@ -79,29 +108,138 @@ func generateSpanID() string {
 	return hex.EncodeToString(b)
 }
 
-// ToMap exports context as string map for gRPC metadata.
+// FormatTraceParent renders c as a W3C traceparent header value:
+// "00-<32-hex-trace-id>-<16-hex-span-id>-<flags>".
+func (c Context) FormatTraceParent() string {
+	flags := byte(0)
+	if c.Sampled {
+		flags |= sampledFlag
+	}
+	return fmt.Sprintf("%s-%s-%s-%02x", traceParentVersion, c.TraceID, c.SpanID, flags)
+}
+
+// ParseTraceParent parses a W3C traceparent header value. The returned
+// Context's SpanID becomes ParentSpanID for the caller (via FromMap), not
+// used directly as a span ID.
+func ParseTraceParent(header string) (Context, bool) {
+	parts := strings.Split(header, "-")
+	if len(parts) != 4 {
+		return Context{}, false
+	}
+	version, traceID, spanID, flags := parts[0], parts[1], parts[2], parts[3]
+	if version != traceParentVersion || len(traceID) != 32 || len(spanID) != 16 || len(flags) != 2 {
+		return Context{}, false
+	}
+	if _, err := hex.DecodeString(traceID); err != nil {
+		return Context{}, false
+	}
+	if _, err := hex.DecodeString(spanID); err != nil {
+		return Context{}, false
+	}
+	flagBytes, err := hex.DecodeString(flags)
+	if err != nil {
+		return Context{}, false
+	}
+	return Context{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Sampled: flagBytes[0]&sampledFlag != 0,
+	}, true
+}
+
+// ToMap exports context as a string map keyed by the W3C header names, ready
+// to drop into gRPC metadata or HTTP headers. When LegacyHeaders is true
+// (the default), the legacy x-trace-id/x-span-id headers are also set so
+// callers that haven't moved to traceparent yet keep working.
 func (c Context) ToMap() map[string]string {
 	m := map[string]string{
-		TraceIDKey: c.TraceID,
-		SpanIDKey:  c.SpanID,
+		TraceParentKey: c.FormatTraceParent(),
 	}
-	if c.ParentSpanID != "" {
-		m[ParentSpanIDKey] = c.ParentSpanID
+	if c.TraceState != "" {
+		m[TraceStateKey] = c.TraceState
+	}
+	if LegacyHeaders {
+		m[TraceIDKey] = c.TraceID
+		m[SpanIDKey] = c.SpanID
+		if c.ParentSpanID != "" {
+			m[ParentSpanIDKey] = c.ParentSpanID
+		}
 	}
 	return m
 }
 
-// FromMap extracts context from string map.
+// FromMap extracts context from a string map keyed by the W3C header names,
+// preferring traceparent/tracestate when present and falling back to the
+// legacy x-trace-id/x-span-id keys for older callers. The caller's span ID
+// becomes this context's parent span ID; a new span ID is always generated
+// for the local side.
 func FromMap(m map[string]string) Context {
-	tc := Context{
-		TraceID:      m[TraceIDKey],
-		SpanID:       generateSpanID(), // Always new span
-		ParentSpanID: m[SpanIDKey],     // Caller's span becomes parent
+	if parsed, ok := ParseTraceParent(m[TraceParentKey]); ok {
+		return Context{
+			TraceID:      parsed.TraceID,
+			SpanID:       generateSpanID(),
+			ParentSpanID: parsed.SpanID,
+			TraceState:   m[TraceStateKey],
+			Sampled:      parsed.Sampled,
+		}
 	}
-	if tc.TraceID == "" {
-		tc.TraceID = generateTraceID()
+
+	if traceID := m[TraceIDKey]; traceID != "" {
+		return Context{
+			TraceID:      traceID,
+			SpanID:       generateSpanID(),
+			ParentSpanID: m[SpanIDKey],
+			Sampled:      true,
+		}
+	}
+
+	return Context{
+		TraceID: generateTraceID(),
+		SpanID:  generateSpanID(),
+		Sampled: true,
 	}
-	return tc
+}
+
+// ParseTraceState splits a W3C tracestate header value ("vendor1=value1,
+// vendor2=value2") into its key/value entries. Per spec, tracestate is
+// otherwise opaque and should be forwarded unmodified; this is only for
+// callers that need to inspect or update a single vendor's entry.
+func ParseTraceState(header string) map[string]string {
+	if header == "" {
+		return nil
+	}
+	entries := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			continue
+		}
+		entries[key] = value
+	}
+	return entries
+}
+
+// FormatTraceState renders entries as a W3C tracestate header value, in
+// sorted key order for deterministic output. The spec preserves the
+// caller's original ordering (most-recent vendor first); callers that need
+// that should keep the raw header string instead of round-tripping it
+// through this map.
+func FormatTraceState(entries map[string]string) string {
+	if len(entries) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + entries[k]
+	}
+	return strings.Join(pairs, ",")
 }
 
 // LogAttrs returns slog attributes for logging.
@@ -116,6 +254,35 @@ func (c Context) LogAttrs() []slog.Attr {
 	return attrs
 }
 
+// StatusCode mirrors OTel's span status: whether the operation the span
+// represents succeeded, failed, or was never explicitly judged.
+type StatusCode int
+
+const (
+	StatusUnset StatusCode = iota
+	StatusOk
+	StatusError
+)
+
+func (c StatusCode) String() string {
+	switch c {
+	case StatusOk:
+		return "ok"
+	case StatusError:
+		return "error"
+	default:
+		return "unset"
+	}
+}
+
+// SpanEvent is a timestamped annotation within a span, e.g. a log line or a
+// recorded exception (see Span.AddEvent and Span.RecordError).
+type SpanEvent struct {
+	Name  string
+	Time  time.Time
+	Attrs map[string]any
+}
+
 // Span represents a timed operation within a trace.
 type Span struct {
 	Name      string
@@ -123,10 +290,28 @@ type Span struct {
 	StartTime time.Time
 	EndTime   time.Time
 	Attrs     map[string]any
+	Events    []SpanEvent
+
+	Status            StatusCode
+	StatusDescription string
+}
+
+// SpanOption configures a Span at StartSpan time.
+type SpanOption func(*Span)
+
+// WithSlowThreshold marks the span as profile-worthy: if it runs longer than
+// d, End registers it with the package's current SlowSpanHook (see
+// SetSlowSpanHook), which the profiletrigger package uses to capture a CPU
+// profile.
+func WithSlowThreshold(d time.Duration) SpanOption {
+	return func(s *Span) { s.SetAttr(SlowThresholdAttr, d) }
 }
 
+// SlowThresholdAttr is the Span.Attrs key WithSlowThreshold sets.
+const SlowThresholdAttr = "slow_threshold"
+
 // StartSpan begins a new span.
-func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+func StartSpan(ctx context.Context, name string, opts ...SpanOption) (context.Context, *Span) {
 	parent, _ := FromContext(ctx)
 	tc := NewChild(parent)
 	if parent.TraceID == "" {
@@ -139,12 +324,30 @@ func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
 		StartTime: time.Now(),
 		Attrs:     make(map[string]any),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	return WithContext(ctx, tc), s
 }
 
-// End marks the span as complete.
+// End marks the span as complete and publishes it via the package's current
+// SpanExporter. Export runs in its own goroutine so callers never block on
+// it. If the span carries a SlowThresholdAttr and ran longer than it, the
+// current SlowSpanHook (if any) is also invoked, asynchronously.
 func (s *Span) End() {
 	s.EndTime = time.Now()
+	exp := currentExporter()
+	go func() {
+		if err := exp.Export(context.Background(), s); err != nil {
+			slog.Debug("span export failed", "span_name", s.Name, "error", err)
+		}
+	}()
+
+	if threshold, ok := s.Attrs[SlowThresholdAttr].(time.Duration); ok && s.Duration() > threshold {
+		if hook := currentSlowSpanHook(); hook != nil {
+			go hook(context.Background(), s)
+		}
+	}
 }
 
 // SetAttr sets a span attribute.
@@ -152,6 +355,34 @@ func (s *Span) SetAttr(key string, val any) {
 	s.Attrs[key] = val
 }
 
+// AddEvent appends a timestamped event to the span, e.g. a log line that
+// happened partway through the operation. attrs may be nil.
+func (s *Span) AddEvent(name string, attrs map[string]any) {
+	s.Events = append(s.Events, SpanEvent{Name: name, Time: time.Now(), Attrs: attrs})
+}
+
+// SetStatus sets the span's final status and an optional human-readable
+// description, following OTel's "set once, last write wins" convention.
+func (s *Span) SetStatus(code StatusCode, description string) {
+	s.Status = code
+	s.StatusDescription = description
+}
+
+// RecordError records err as an "exception" event carrying the standard
+// exception.type/exception.message/exception.stacktrace attributes, and
+// marks the span's status as Error.
+func (s *Span) RecordError(err error) {
+	if err == nil {
+		return
+	}
+	s.AddEvent("exception", map[string]any{
+		"exception.type":       fmt.Sprintf("%T", err),
+		"exception.message":    err.Error(),
+		"exception.stacktrace": string(debug.Stack()),
+	})
+	s.SetStatus(StatusError, err.Error())
+}
+
 // Duration returns span duration.
 func (s *Span) Duration() time.Duration {
 	if s.EndTime.IsZero() {
@@ -171,9 +402,26 @@ func (s *Span) LogValue() slog.Value {
 	if s.Ctx.ParentSpanID != "" {
 		attrs = append(attrs, slog.String("parent_span_id", s.Ctx.ParentSpanID))
 	}
+	if s.Status != StatusUnset {
+		attrs = append(attrs, slog.String("status", s.Status.String()))
+		if s.StatusDescription != "" {
+			attrs = append(attrs, slog.String("status_description", s.StatusDescription))
+		}
+	}
 	for k, v := range s.Attrs {
 		attrs = append(attrs, slog.Any(k, v))
 	}
+	if len(s.Events) > 0 {
+		eventAttrs := make([]slog.Attr, len(s.Events))
+		for i, e := range s.Events {
+			groupAttrs := []slog.Attr{slog.String("name", e.Name), slog.Time("time", e.Time)}
+			for k, v := range e.Attrs {
+				groupAttrs = append(groupAttrs, slog.Any(k, v))
+			}
+			eventAttrs[i] = slog.Attr{Key: fmt.Sprintf("event_%d", i), Value: slog.GroupValue(groupAttrs...)}
+		}
+		attrs = append(attrs, slog.Attr{Key: "events", Value: slog.GroupValue(eventAttrs...)})
+	}
 	return slog.GroupValue(attrs...)
 }
 
@@ -2,6 +2,8 @@ package trace
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -93,39 +95,89 @@ func TestEnsureContext(t *testing.T) {
 	_ = ctx2
 }
 
+func TestFormatTraceParent(t *testing.T) {
+	tc := Context{TraceID: strings.Repeat("a", 32), SpanID: strings.Repeat("b", 16), Sampled: true}
+	want := "00-" + strings.Repeat("a", 32) + "-" + strings.Repeat("b", 16) + "-01"
+	if got := tc.FormatTraceParent(); got != want {
+		t.Errorf("FormatTraceParent() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatTraceParentUnsampled(t *testing.T) {
+	tc := Context{TraceID: strings.Repeat("a", 32), SpanID: strings.Repeat("b", 16)}
+	want := "00-" + strings.Repeat("a", 32) + "-" + strings.Repeat("b", 16) + "-00"
+	if got := tc.FormatTraceParent(); got != want {
+		t.Errorf("FormatTraceParent() = %q, want %q", got, want)
+	}
+}
+
+func TestParseTraceParent(t *testing.T) {
+	header := "00-" + strings.Repeat("a", 32) + "-" + strings.Repeat("b", 16) + "-01"
+	tc, ok := ParseTraceParent(header)
+	if !ok {
+		t.Fatal("expected traceparent to parse")
+	}
+	if tc.TraceID != strings.Repeat("a", 32) {
+		t.Error("trace ID mismatch")
+	}
+	if tc.SpanID != strings.Repeat("b", 16) {
+		t.Error("span ID mismatch")
+	}
+	if !tc.Sampled {
+		t.Error("expected sampled flag to be set")
+	}
+}
+
+func TestParseTraceParentRejectsMalformed(t *testing.T) {
+	for _, header := range []string{
+		"",
+		"not-a-traceparent",
+		"01-" + strings.Repeat("a", 32) + "-" + strings.Repeat("b", 16) + "-01",
+		"00-tooshort-" + strings.Repeat("b", 16) + "-01",
+		"00-" + strings.Repeat("z", 32) + "-" + strings.Repeat("b", 16) + "-01",
+	} {
+		if _, ok := ParseTraceParent(header); ok {
+			t.Errorf("ParseTraceParent(%q) should have failed", header)
+		}
+	}
+}
+
 func TestToMap(t *testing.T) {
 	tc := Context{
-		TraceID:      "trace123",
-		SpanID:       "span456",
-		ParentSpanID: "parent789",
+		TraceID: strings.Repeat("a", 32),
+		SpanID:  strings.Repeat("b", 16),
+		Sampled: true,
 	}
 	m := tc.ToMap()
 
-	if m[TraceIDKey] != "trace123" {
-		t.Error("trace ID mismatch")
+	if m[TraceParentKey] != tc.FormatTraceParent() {
+		t.Error("traceparent mismatch")
 	}
-	if m[SpanIDKey] != "span456" {
-		t.Error("span ID mismatch")
+	if _, ok := m[TraceStateKey]; ok {
+		t.Error("tracestate should be absent when empty")
 	}
-	if m[ParentSpanIDKey] != "parent789" {
-		t.Error("parent span ID mismatch")
+}
+
+func TestToMapIncludesTraceState(t *testing.T) {
+	tc := Context{TraceID: strings.Repeat("a", 32), SpanID: strings.Repeat("b", 16), TraceState: "vendor=value"}
+	m := tc.ToMap()
+	if m[TraceStateKey] != "vendor=value" {
+		t.Error("tracestate mismatch")
 	}
 }
 
 func TestFromMap(t *testing.T) {
-	m := map[string]string{
-		TraceIDKey: "trace123",
-		SpanIDKey:  "span456",
-	}
+	caller := Context{TraceID: strings.Repeat("a", 32), SpanID: strings.Repeat("b", 16), Sampled: true}
+	m := caller.ToMap()
 	tc := FromMap(m)
 
-	if tc.TraceID != "trace123" {
+	if tc.TraceID != caller.TraceID {
 		t.Error("trace ID mismatch")
 	}
-	if tc.ParentSpanID != "span456" {
+	if tc.ParentSpanID != caller.SpanID {
 		t.Error("parent span should be caller's span")
 	}
-	if len(tc.SpanID) != 16 {
+	if len(tc.SpanID) != 16 || tc.SpanID == caller.SpanID {
 		t.Error("should generate new span ID")
 	}
 }
@@ -137,6 +189,69 @@ func TestFromMapGeneratesTrace(t *testing.T) {
 	}
 }
 
+func TestToMapIncludesLegacyHeaders(t *testing.T) {
+	tc := Context{TraceID: strings.Repeat("a", 32), SpanID: strings.Repeat("b", 16), ParentSpanID: strings.Repeat("c", 16)}
+	m := tc.ToMap()
+
+	if m[TraceIDKey] != tc.TraceID {
+		t.Error("legacy trace ID header mismatch")
+	}
+	if m[SpanIDKey] != tc.SpanID {
+		t.Error("legacy span ID header mismatch")
+	}
+	if m[ParentSpanIDKey] != tc.ParentSpanID {
+		t.Error("legacy parent span ID header mismatch")
+	}
+}
+
+func TestFromMapFallsBackToLegacyHeaders(t *testing.T) {
+	m := map[string]string{
+		TraceIDKey: strings.Repeat("a", 32),
+		SpanIDKey:  strings.Repeat("b", 16),
+	}
+	tc := FromMap(m)
+
+	if tc.TraceID != m[TraceIDKey] {
+		t.Error("trace ID should come from legacy header")
+	}
+	if tc.ParentSpanID != m[SpanIDKey] {
+		t.Error("parent span should be legacy caller span ID")
+	}
+}
+
+func TestFromMapPrefersTraceParentOverLegacy(t *testing.T) {
+	caller := Context{TraceID: strings.Repeat("a", 32), SpanID: strings.Repeat("b", 16), Sampled: true}
+	m := caller.ToMap()
+	m[TraceIDKey] = strings.Repeat("f", 32) // stale legacy header, should be ignored
+
+	tc := FromMap(m)
+	if tc.TraceID != caller.TraceID {
+		t.Error("traceparent should win over legacy headers when both present")
+	}
+}
+
+func TestParseTraceState(t *testing.T) {
+	entries := ParseTraceState("vendor1=value1,vendor2=value2")
+	if entries["vendor1"] != "value1" || entries["vendor2"] != "value2" {
+		t.Errorf("entries = %v", entries)
+	}
+}
+
+func TestFormatTraceState(t *testing.T) {
+	got := FormatTraceState(map[string]string{"vendor2": "value2", "vendor1": "value1"})
+	want := "vendor1=value1,vendor2=value2"
+	if got != want {
+		t.Errorf("FormatTraceState() = %q, want %q", got, want)
+	}
+}
+
+func TestTraceStateRoundTrip(t *testing.T) {
+	original := "vendor1=value1,vendor2=value2"
+	if got := FormatTraceState(ParseTraceState(original)); got != original {
+		t.Errorf("round trip = %q, want %q", got, original)
+	}
+}
+
 func TestStartSpan(t *testing.T) {
 	ctx := context.Background()
 	ctx, span := StartSpan(ctx, "test_span")
@@ -176,6 +291,66 @@ func TestSpanNested(t *testing.T) {
 	_ = ctx
 }
 
+func TestAddEvent(t *testing.T) {
+	_, span := StartSpan(context.Background(), "test_span")
+	span.AddEvent("checkpoint", map[string]any{"step": 1})
+
+	if len(span.Events) != 1 {
+		t.Fatalf("Events = %v, want 1 event", span.Events)
+	}
+	if span.Events[0].Name != "checkpoint" {
+		t.Errorf("event name = %q, want checkpoint", span.Events[0].Name)
+	}
+	if span.Events[0].Attrs["step"] != 1 {
+		t.Errorf("event attrs = %v, want step=1", span.Events[0].Attrs)
+	}
+	if span.Events[0].Time.IsZero() {
+		t.Error("event should have a timestamp")
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	_, span := StartSpan(context.Background(), "test_span")
+	span.SetStatus(StatusOk, "all good")
+
+	if span.Status != StatusOk {
+		t.Errorf("Status = %v, want StatusOk", span.Status)
+	}
+	if span.StatusDescription != "all good" {
+		t.Errorf("StatusDescription = %q, want %q", span.StatusDescription, "all good")
+	}
+}
+
+func TestRecordError(t *testing.T) {
+	_, span := StartSpan(context.Background(), "test_span")
+	span.RecordError(errors.New("boom"))
+
+	if span.Status != StatusError {
+		t.Errorf("Status = %v, want StatusError", span.Status)
+	}
+	if span.StatusDescription != "boom" {
+		t.Errorf("StatusDescription = %q, want %q", span.StatusDescription, "boom")
+	}
+	if len(span.Events) != 1 || span.Events[0].Name != "exception" {
+		t.Fatalf("Events = %v, want one exception event", span.Events)
+	}
+	if span.Events[0].Attrs["exception.message"] != "boom" {
+		t.Errorf("exception.message = %v, want boom", span.Events[0].Attrs["exception.message"])
+	}
+	if span.Events[0].Attrs["exception.stacktrace"] == "" {
+		t.Error("exception.stacktrace should not be empty")
+	}
+}
+
+func TestRecordErrorNilIsNoop(t *testing.T) {
+	_, span := StartSpan(context.Background(), "test_span")
+	span.RecordError(nil)
+
+	if span.Status != StatusUnset || len(span.Events) != 0 {
+		t.Error("RecordError(nil) should not modify the span")
+	}
+}
+
 func TestLogger(t *testing.T) {
 	tc := New()
 	ctx := WithContext(context.Background(), tc)
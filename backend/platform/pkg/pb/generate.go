@@ -0,0 +1,12 @@
+// Package pb holds the generated protobuf/gRPC types for the inference
+// service contract defined in proto/*.proto (cognition, transcription, vad,
+// ocr, llm, memory) - shared, byte-for-byte, across the Python inference
+// server and this Go client.
+//
+// Run `go generate ./pkg/pb/...` (requires protoc on PATH; protoc-gen-go and
+// protoc-gen-go-grpc are tracked as `tool` dependencies in go.mod and are
+// invoked via `go tool`) to regenerate *.pb.go and *_grpc.pb.go from
+// proto/*.proto after editing the schema.
+package pb
+
+//go:generate protoc --proto_path=proto --plugin=protoc-gen-go=$GOBIN/protoc-gen-go --plugin=protoc-gen-go-grpc=$GOBIN/protoc-gen-go-grpc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative proto/cognition.proto proto/transcription.proto proto/vad.proto proto/ocr.proto proto/llm.proto proto/memory.proto
@@ -3,9 +3,7 @@ package tests
 
 import (
 	"context"
-	"encoding/binary"
 	"fmt"
-	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -15,8 +13,12 @@ import (
 	pb "github.com/GriffinCanCode/good-listener/backend/platform/pkg/pb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/GriffinCanCode/good-listener/backend/tests/internal/asr"
 )
 
+const audioFixtureManifest = "fixtures/audio/manifest.json"
+
 const (
 	inferenceAddr  = "localhost:50051"
 	startupTimeout = 30 * time.Second
@@ -176,22 +178,37 @@ func TestE2E_VADService(t *testing.T) {
 
 func TestE2E_TranscriptionService(t *testing.T) {
 	client := pb.NewTranscriptionServiceClient(grpcConn)
-	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
-	defer cancel()
 
-	// Create 1 second of silence audio
-	audio := makeAudioBytes(16000) // 1 second at 16kHz
-
-	resp, err := client.Transcribe(ctx, &pb.TranscribeRequest{
-		AudioData:  audio,
-		SampleRate: 16000,
-	})
+	fixtures, err := asr.LoadManifest(audioFixtureManifest)
 	if err != nil {
-		t.Fatalf("Transcribe failed: %v", err)
+		t.Fatalf("loading audio fixtures: %v", err)
 	}
 
-	t.Logf("Transcription response: text=%q, confidence=%.3f", resp.Text, resp.Confidence)
-	// Silence should produce empty or near-empty transcription
+	for _, f := range fixtures {
+		t.Run(f.Name, func(t *testing.T) {
+			audio, err := f.LoadAudio()
+			if err != nil {
+				t.Fatalf("loading fixture audio: %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+			defer cancel()
+
+			resp, err := client.Transcribe(ctx, &pb.TranscribeRequest{
+				AudioData:  audio,
+				SampleRate: 16000,
+			})
+			if err != nil {
+				t.Fatalf("Transcribe failed: %v", err)
+			}
+
+			wer := asr.WordErrorRate(f.Expected, resp.Text)
+			t.Logf("%s: expected=%q got=%q wer=%.3f confidence=%.3f", f.Name, f.Expected, resp.Text, wer, resp.Confidence)
+			if threshold := f.Threshold(); wer > threshold {
+				t.Errorf("%s: WER %.3f exceeds threshold %.3f (expected=%q got=%q)", f.Name, wer, threshold, f.Expected, resp.Text)
+			}
+		})
+	}
 }
 
 func TestE2E_OCRService(t *testing.T) {
@@ -342,14 +359,27 @@ func TestE2E_FullFlow(t *testing.T) {
 	t.Logf("Step 1 (VAD): speech_prob=%.3f ✓", vadResp.SpeechProbability)
 
 	// Step 2: Transcription
+	fixtures, err := asr.LoadManifest(audioFixtureManifest)
+	if err != nil {
+		t.Fatalf("Step 2 (Transcription) loading audio fixtures: %v", err)
+	}
+	silence := fixtures[0]
+	audio, err := silence.LoadAudio()
+	if err != nil {
+		t.Fatalf("Step 2 (Transcription) loading fixture audio: %v", err)
+	}
+
 	transcribeClient := pb.NewTranscriptionServiceClient(grpcConn)
 	transcribeResp, err := transcribeClient.Transcribe(ctx, &pb.TranscribeRequest{
-		AudioData:  makeAudioBytes(8000), // 0.5 sec
+		AudioData:  audio,
 		SampleRate: 16000,
 	})
 	if err != nil {
 		t.Fatalf("Step 2 (Transcription) failed: %v", err)
 	}
+	if wer := asr.WordErrorRate(silence.Expected, transcribeResp.Text); wer > silence.Threshold() {
+		t.Fatalf("Step 2 (Transcription): WER %.3f exceeds threshold %.3f (expected=%q got=%q)", wer, silence.Threshold(), silence.Expected, transcribeResp.Text)
+	}
 	t.Logf("Step 2 (Transcription): text=%q ✓", transcribeResp.Text)
 
 	// Step 3: Memory Storage
@@ -390,15 +420,6 @@ func TestE2E_FullFlow(t *testing.T) {
 // Helpers
 // =============================================================================
 
-func makeAudioBytes(samples int) []byte {
-	buf := make([]byte, samples*4)
-	for i := 0; i < samples; i++ {
-		// Generate silence (0.0 float32)
-		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(0.0))
-	}
-	return buf
-}
-
 func min(a, b int) int {
 	if a < b {
 		return a
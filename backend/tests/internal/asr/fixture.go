@@ -0,0 +1,119 @@
+package asr
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+// DefaultWERThreshold is the word error rate a fixture must meet or beat
+// when its manifest entry doesn't specify one.
+const DefaultWERThreshold = 0.15
+
+// Fixture describes one golden audio clip: the transcript it should
+// produce and the WER threshold its transcription must meet.
+type Fixture struct {
+	Name         string  `json:"name"`
+	WAVFile      string  `json:"wav_file"`
+	Expected     string  `json:"expected_transcript"`
+	WERThreshold float64 `json:"wer_threshold"`
+
+	// path is the WAVFile resolved against the manifest's directory.
+	path string
+}
+
+// Threshold returns f's configured WER threshold, or DefaultWERThreshold
+// if the manifest didn't set one.
+func (f Fixture) Threshold() float64 {
+	if f.WERThreshold > 0 {
+		return f.WERThreshold
+	}
+	return DefaultWERThreshold
+}
+
+// LoadManifest reads the fixture list at manifestPath (e.g.
+// "fixtures/audio/manifest.json"), resolving each entry's WAVFile relative
+// to the manifest's own directory so callers can load it regardless of
+// their working directory.
+func LoadManifest(manifestPath string) ([]Fixture, error) {
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading fixture manifest %s: %w", manifestPath, err)
+	}
+
+	var fixtures []Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("decoding fixture manifest %s: %w", manifestPath, err)
+	}
+
+	dir := filepath.Dir(manifestPath)
+	for i := range fixtures {
+		fixtures[i].path = filepath.Join(dir, fixtures[i].WAVFile)
+	}
+	return fixtures, nil
+}
+
+// LoadAudio decodes f's WAV clip into the little-endian float32 []byte
+// format the Transcribe RPC expects.
+func (f Fixture) LoadAudio() ([]byte, error) {
+	return loadWAVAsFloat32LE(f.path)
+}
+
+// loadWAVAsFloat32LE reads a 16-bit PCM mono WAV file and returns its
+// samples as little-endian float32 bytes, normalized the same way the
+// platform's own capture backends convert int16 PCM (see
+// internal/audio/wasapi_windows.go).
+func loadWAVAsFloat32LE(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading wav file %s: %w", path, err)
+	}
+
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("%s is not a RIFF/WAVE file", path)
+	}
+
+	var bitsPerSample, numChannels uint16
+	var pcm []byte
+	for offset := 12; offset+8 <= len(data); {
+		chunkID := string(data[offset : offset+4])
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4 : offset+8]))
+		body := offset + 8
+		if body+chunkSize > len(data) {
+			break
+		}
+
+		switch chunkID {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, fmt.Errorf("%s: fmt chunk too small", path)
+			}
+			numChannels = binary.LittleEndian.Uint16(data[body+2 : body+4])
+			bitsPerSample = binary.LittleEndian.Uint16(data[body+14 : body+16])
+		case "data":
+			pcm = data[body : body+chunkSize]
+		}
+
+		offset = body + chunkSize + chunkSize%2 // chunks are word-aligned
+	}
+
+	if pcm == nil {
+		return nil, fmt.Errorf("%s: no data chunk found", path)
+	}
+	if bitsPerSample != 16 {
+		return nil, fmt.Errorf("%s: only 16-bit PCM WAV is supported, got %d-bit", path, bitsPerSample)
+	}
+	if numChannels != 1 {
+		return nil, fmt.Errorf("%s: only mono WAV is supported, got %d channels", path, numChannels)
+	}
+
+	out := make([]byte, (len(pcm)/2)*4)
+	for i := 0; i+1 < len(pcm); i += 2 {
+		sample := float32(int16(binary.LittleEndian.Uint16(pcm[i:]))) / 32768.0
+		binary.LittleEndian.PutUint32(out[(i/2)*4:], math.Float32bits(sample))
+	}
+	return out, nil
+}
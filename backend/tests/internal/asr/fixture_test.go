@@ -0,0 +1,38 @@
+package asr
+
+import "testing"
+
+func TestLoadManifest(t *testing.T) {
+	fixtures, err := LoadManifest("../../fixtures/audio/manifest.json")
+	if err != nil {
+		t.Fatalf("LoadManifest: %v", err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("expected at least one fixture")
+	}
+
+	for _, f := range fixtures {
+		audio, err := f.LoadAudio()
+		if err != nil {
+			t.Fatalf("%s: LoadAudio: %v", f.Name, err)
+		}
+		if len(audio)%4 != 0 {
+			t.Errorf("%s: audio length %d is not a multiple of 4 (float32 LE)", f.Name, len(audio))
+		}
+		if len(audio) == 0 {
+			t.Errorf("%s: expected non-empty decoded audio", f.Name)
+		}
+	}
+}
+
+func TestFixtureThreshold(t *testing.T) {
+	f := Fixture{}
+	if got := f.Threshold(); got != DefaultWERThreshold {
+		t.Errorf("Threshold() with no override = %v, want %v", got, DefaultWERThreshold)
+	}
+
+	f.WERThreshold = 0.3
+	if got := f.Threshold(); got != 0.3 {
+		t.Errorf("Threshold() with override = %v, want 0.3", got)
+	}
+}
@@ -0,0 +1,49 @@
+// Package asr scores transcription accuracy and loads golden audio
+// fixtures for the E2E test suite.
+package asr
+
+import "strings"
+
+// WordErrorRate computes the Levenshtein-based word error rate of
+// hypothesis against reference: the minimum number of word substitutions,
+// insertions, and deletions needed to turn hypothesis into reference,
+// divided by the number of words in reference. Two empty strings are a
+// perfect match (0); a non-empty hypothesis against an empty reference is
+// fully wrong (1), since dividing by zero words isn't meaningful.
+func WordErrorRate(reference, hypothesis string) float64 {
+	ref := strings.Fields(reference)
+	hyp := strings.Fields(hypothesis)
+
+	if len(ref) == 0 {
+		if len(hyp) == 0 {
+			return 0
+		}
+		return 1
+	}
+
+	return float64(levenshteinWords(ref, hyp)) / float64(len(ref))
+}
+
+// levenshteinWords returns the edit distance between two word sequences
+// via the standard O(len(a)*len(b)) dynamic-programming table, rolled
+// across two rows since only the previous row is ever needed.
+func levenshteinWords(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				curr[j] = prev[j-1]
+			} else {
+				curr[j] = 1 + min(prev[j-1], min(prev[j], curr[j-1]))
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,28 @@
+package asr
+
+import "testing"
+
+func TestWordErrorRate(t *testing.T) {
+	tests := []struct {
+		name      string
+		reference string
+		hyp       string
+		want      float64
+	}{
+		{"exact match", "the quick brown fox", "the quick brown fox", 0},
+		{"both empty", "", "", 0},
+		{"hallucinated on silence", "", "thank you for watching", 1},
+		{"one substitution", "the quick brown fox", "the quick brown dog", 0.25},
+		{"one deletion", "the quick brown fox", "the quick fox", 0.25},
+		{"one insertion", "the quick brown fox", "the very quick brown fox", 0.25},
+		{"completely wrong", "hello world", "goodbye moon", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WordErrorRate(tt.reference, tt.hyp); got != tt.want {
+				t.Errorf("WordErrorRate(%q, %q) = %v, want %v", tt.reference, tt.hyp, got, tt.want)
+			}
+		})
+	}
+}